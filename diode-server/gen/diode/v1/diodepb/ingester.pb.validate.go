@@ -544,6 +544,40 @@ func (m *Interface) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetWirelessLans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InterfaceValidationError{
+						field:  fmt.Sprintf("WirelessLans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InterfaceValidationError{
+						field:  fmt.Sprintf("WirelessLans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InterfaceValidationError{
+					field:  fmt.Sprintf("WirelessLans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.Label != nil {
 
 		if l := utf8.RuneCountInString(m.GetLabel()); l < 1 || l > 64 {
@@ -2270,32 +2304,32 @@ var _ interface {
 	ErrorName() string
 } = VirtualDiskValidationError{}
 
-// Validate checks the field values on IPAddress with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *IPAddress) Validate() error {
+// Validate checks the field values on WirelessLANGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WirelessLANGroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IPAddress with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in IPAddressMultiError, or nil
-// if none found.
-func (m *IPAddress) ValidateAll() error {
+// ValidateAll checks the field values on WirelessLANGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WirelessLANGroupMultiError, or nil if none found.
+func (m *WirelessLANGroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IPAddress) validate(all bool) error {
+func (m *WirelessLANGroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if ip := net.ParseIP(m.GetAddress()); ip == nil {
-		err := IPAddressValidationError{
-			field:  "Address",
-			reason: "value must be a valid IP address",
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := WirelessLANGroupValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -2303,10 +2337,10 @@ func (m *IPAddress) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _IPAddress_Status_InLookup[m.GetStatus()]; !ok {
-		err := IPAddressValidationError{
-			field:  "Status",
-			reason: "value must be in list [active reserved deprecated dhcp slaac]",
+	if l := utf8.RuneCountInString(m.GetSlug()); l < 1 || l > 100 {
+		err := WirelessLANGroupValidationError{
+			field:  "Slug",
+			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -2314,10 +2348,10 @@ func (m *IPAddress) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _IPAddress_Role_InLookup[m.GetRole()]; !ok {
-		err := IPAddressValidationError{
-			field:  "Role",
-			reason: "value must be in list [loopback secondary anycast vip vrrp hsrp glbp carp]",
+	if !_WirelessLANGroup_Slug_Pattern.MatchString(m.GetSlug()) {
+		err := WirelessLANGroupValidationError{
+			field:  "Slug",
+			reason: "value does not match regex pattern \"^[-a-zA-Z0-9_]+$\"",
 		}
 		if !all {
 			return err
@@ -2332,7 +2366,7 @@ func (m *IPAddress) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, IPAddressValidationError{
+					errors = append(errors, WirelessLANGroupValidationError{
 						field:  fmt.Sprintf("Tags[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2340,7 +2374,7 @@ func (m *IPAddress) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, IPAddressValidationError{
+					errors = append(errors, WirelessLANGroupValidationError{
 						field:  fmt.Sprintf("Tags[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2349,7 +2383,7 @@ func (m *IPAddress) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return IPAddressValidationError{
+				return WirelessLANGroupValidationError{
 					field:  fmt.Sprintf("Tags[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2359,82 +2393,10 @@ func (m *IPAddress) validate(all bool) error {
 
 	}
 
-	switch v := m.AssignedObject.(type) {
-	case *IPAddress_Interface:
-		if v == nil {
-			err := IPAddressValidationError{
-				field:  "AssignedObject",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetInterface()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, IPAddressValidationError{
-						field:  "Interface",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, IPAddressValidationError{
-						field:  "Interface",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetInterface()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return IPAddressValidationError{
-					field:  "Interface",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
-	if m.DnsName != nil {
-
-		if utf8.RuneCountInString(m.GetDnsName()) > 255 {
-			err := IPAddressValidationError{
-				field:  "DnsName",
-				reason: "value length must be at most 255 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if !_IPAddress_DnsName_Pattern.MatchString(m.GetDnsName()) {
-			err := IPAddressValidationError{
-				field:  "DnsName",
-				reason: "value does not match regex pattern \"^([0-9A-Za-z_-]+|\\\\*)(\\\\.[0-9A-Za-z_-]+)*\\\\.?$\"",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if m.Description != nil {
 
 		if utf8.RuneCountInString(m.GetDescription()) > 200 {
-			err := IPAddressValidationError{
+			err := WirelessLANGroupValidationError{
 				field:  "Description",
 				reason: "value length must be at most 200 runes",
 			}
@@ -2446,23 +2408,20 @@ func (m *IPAddress) validate(all bool) error {
 
 	}
 
-	if m.Comments != nil {
-		// no validation rules for Comments
-	}
-
 	if len(errors) > 0 {
-		return IPAddressMultiError(errors)
+		return WirelessLANGroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// IPAddressMultiError is an error wrapping multiple validation errors returned
-// by IPAddress.ValidateAll() if the designated constraints aren't met.
-type IPAddressMultiError []error
+// WirelessLANGroupMultiError is an error wrapping multiple validation errors
+// returned by WirelessLANGroup.ValidateAll() if the designated constraints
+// aren't met.
+type WirelessLANGroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IPAddressMultiError) Error() string {
+func (m WirelessLANGroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2471,11 +2430,11 @@ func (m IPAddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IPAddressMultiError) AllErrors() []error { return m }
+func (m WirelessLANGroupMultiError) AllErrors() []error { return m }
 
-// IPAddressValidationError is the validation error returned by
-// IPAddress.Validate if the designated constraints aren't met.
-type IPAddressValidationError struct {
+// WirelessLANGroupValidationError is the validation error returned by
+// WirelessLANGroup.Validate if the designated constraints aren't met.
+type WirelessLANGroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2483,22 +2442,22 @@ type IPAddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e IPAddressValidationError) Field() string { return e.field }
+func (e WirelessLANGroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IPAddressValidationError) Reason() string { return e.reason }
+func (e WirelessLANGroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IPAddressValidationError) Cause() error { return e.cause }
+func (e WirelessLANGroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IPAddressValidationError) Key() bool { return e.key }
+func (e WirelessLANGroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IPAddressValidationError) ErrorName() string { return "IPAddressValidationError" }
+func (e WirelessLANGroupValidationError) ErrorName() string { return "WirelessLANGroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IPAddressValidationError) Error() string {
+func (e WirelessLANGroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2510,14 +2469,14 @@ func (e IPAddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIPAddress.%s: %s%s",
+		"invalid %sWirelessLANGroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IPAddressValidationError{}
+var _ error = WirelessLANGroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -2525,77 +2484,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IPAddressValidationError{}
+} = WirelessLANGroupValidationError{}
 
-var _IPAddress_Status_InLookup = map[string]struct{}{
-	"active":     {},
-	"reserved":   {},
-	"deprecated": {},
-	"dhcp":       {},
-	"slaac":      {},
-}
-
-var _IPAddress_Role_InLookup = map[string]struct{}{
-	"loopback":  {},
-	"secondary": {},
-	"anycast":   {},
-	"vip":       {},
-	"vrrp":      {},
-	"hsrp":      {},
-	"glbp":      {},
-	"carp":      {},
-}
-
-var _IPAddress_DnsName_Pattern = regexp.MustCompile("^([0-9A-Za-z_-]+|\\*)(\\.[0-9A-Za-z_-]+)*\\.?$")
+var _WirelessLANGroup_Slug_Pattern = regexp.MustCompile("^[-a-zA-Z0-9_]+$")
 
-// Validate checks the field values on DeviceType with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on WirelessLAN with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeviceType) Validate() error {
+func (m *WirelessLAN) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeviceType with the rules defined in
+// ValidateAll checks the field values on WirelessLAN with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeviceTypeMultiError, or
+// result is a list of violation errors wrapped in WirelessLANMultiError, or
 // nil if none found.
-func (m *DeviceType) ValidateAll() error {
+func (m *WirelessLAN) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeviceType) validate(all bool) error {
+func (m *WirelessLAN) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetModel()); l < 1 || l > 100 {
-		err := DeviceTypeValidationError{
-			field:  "Model",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetSlug()); l < 1 || l > 100 {
-		err := DeviceTypeValidationError{
-			field:  "Slug",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_DeviceType_Slug_Pattern.MatchString(m.GetSlug()) {
-		err := DeviceTypeValidationError{
-			field:  "Slug",
-			reason: "value does not match regex pattern \"^[-a-zA-Z0-9_]+$\"",
+	if l := utf8.RuneCountInString(m.GetSsid()); l < 1 || l > 32 {
+		err := WirelessLANValidationError{
+			field:  "Ssid",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -2604,34 +2522,67 @@ func (m *DeviceType) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetManufacturer()).(type) {
+		switch v := interface{}(m.GetGroup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeviceTypeValidationError{
-					field:  "Manufacturer",
+				errors = append(errors, WirelessLANValidationError{
+					field:  "Group",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeviceTypeValidationError{
-					field:  "Manufacturer",
+				errors = append(errors, WirelessLANValidationError{
+					field:  "Group",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeviceTypeValidationError{
-				field:  "Manufacturer",
+			return WirelessLANValidationError{
+				field:  "Group",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if _, ok := _WirelessLAN_Status_InLookup[m.GetStatus()]; !ok {
+		err := WirelessLANValidationError{
+			field:  "Status",
+			reason: "value must be in list [active reserved disabled deprecated]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WirelessLAN_AuthType_InLookup[m.GetAuthType()]; !ok {
+		err := WirelessLANValidationError{
+			field:  "AuthType",
+			reason: "value must be in list [open wep wpa-personal wpa-enterprise]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WirelessLAN_AuthCipher_InLookup[m.GetAuthCipher()]; !ok {
+		err := WirelessLANValidationError{
+			field:  "AuthCipher",
+			reason: "value must be in list [auto tkip aes]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	for idx, item := range m.GetTags() {
 		_, _ = idx, item
 
@@ -2639,7 +2590,7 @@ func (m *DeviceType) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DeviceTypeValidationError{
+					errors = append(errors, WirelessLANValidationError{
 						field:  fmt.Sprintf("Tags[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2647,7 +2598,7 @@ func (m *DeviceType) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DeviceTypeValidationError{
+					errors = append(errors, WirelessLANValidationError{
 						field:  fmt.Sprintf("Tags[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2656,7 +2607,7 @@ func (m *DeviceType) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DeviceTypeValidationError{
+				return WirelessLANValidationError{
 					field:  fmt.Sprintf("Tags[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2666,11 +2617,1246 @@ func (m *DeviceType) validate(all bool) error {
 
 	}
 
-	if m.Description != nil {
+	if m.AuthPsk != nil {
 
-		if utf8.RuneCountInString(m.GetDescription()) > 200 {
-			err := DeviceTypeValidationError{
-				field:  "Description",
+		if utf8.RuneCountInString(m.GetAuthPsk()) > 64 {
+			err := WirelessLANValidationError{
+				field:  "AuthPsk",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 200 {
+			err := WirelessLANValidationError{
+				field:  "Description",
+				reason: "value length must be at most 200 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Comments != nil {
+		// no validation rules for Comments
+	}
+
+	if len(errors) > 0 {
+		return WirelessLANMultiError(errors)
+	}
+
+	return nil
+}
+
+// WirelessLANMultiError is an error wrapping multiple validation errors
+// returned by WirelessLAN.ValidateAll() if the designated constraints aren't met.
+type WirelessLANMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WirelessLANMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WirelessLANMultiError) AllErrors() []error { return m }
+
+// WirelessLANValidationError is the validation error returned by
+// WirelessLAN.Validate if the designated constraints aren't met.
+type WirelessLANValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WirelessLANValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WirelessLANValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WirelessLANValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WirelessLANValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WirelessLANValidationError) ErrorName() string { return "WirelessLANValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WirelessLANValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWirelessLAN.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WirelessLANValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WirelessLANValidationError{}
+
+var _WirelessLAN_Status_InLookup = map[string]struct{}{
+	"active":     {},
+	"reserved":   {},
+	"disabled":   {},
+	"deprecated": {},
+}
+
+var _WirelessLAN_AuthType_InLookup = map[string]struct{}{
+	"open":           {},
+	"wep":            {},
+	"wpa-personal":   {},
+	"wpa-enterprise": {},
+}
+
+var _WirelessLAN_AuthCipher_InLookup = map[string]struct{}{
+	"auto": {},
+	"tkip": {},
+	"aes":  {},
+}
+
+// Validate checks the field values on WirelessLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WirelessLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WirelessLink with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WirelessLinkMultiError, or
+// nil if none found.
+func (m *WirelessLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WirelessLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInterfaceA() == nil {
+		err := WirelessLinkValidationError{
+			field:  "InterfaceA",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if a := m.GetInterfaceA(); a != nil {
+
+	}
+
+	if m.GetInterfaceB() == nil {
+		err := WirelessLinkValidationError{
+			field:  "InterfaceB",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if a := m.GetInterfaceB(); a != nil {
+
+	}
+
+	if _, ok := _WirelessLink_Status_InLookup[m.GetStatus()]; !ok {
+		err := WirelessLinkValidationError{
+			field:  "Status",
+			reason: "value must be in list [connected planned decommissioning]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WirelessLink_AuthType_InLookup[m.GetAuthType()]; !ok {
+		err := WirelessLinkValidationError{
+			field:  "AuthType",
+			reason: "value must be in list [open wep wpa-personal wpa-enterprise]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WirelessLink_AuthCipher_InLookup[m.GetAuthCipher()]; !ok {
+		err := WirelessLinkValidationError{
+			field:  "AuthCipher",
+			reason: "value must be in list [auto tkip aes]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WirelessLink_DistanceUnit_InLookup[m.GetDistanceUnit()]; !ok {
+		err := WirelessLinkValidationError{
+			field:  "DistanceUnit",
+			reason: "value must be in list [km m mi ft]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WirelessLinkValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WirelessLinkValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WirelessLinkValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Ssid != nil {
+
+		if utf8.RuneCountInString(m.GetSsid()) > 32 {
+			err := WirelessLinkValidationError{
+				field:  "Ssid",
+				reason: "value length must be at most 32 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AuthPsk != nil {
+
+		if utf8.RuneCountInString(m.GetAuthPsk()) > 64 {
+			err := WirelessLinkValidationError{
+				field:  "AuthPsk",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Distance != nil {
+
+		if m.GetDistance() < 0 {
+			err := WirelessLinkValidationError{
+				field:  "Distance",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 200 {
+			err := WirelessLinkValidationError{
+				field:  "Description",
+				reason: "value length must be at most 200 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Comments != nil {
+		// no validation rules for Comments
+	}
+
+	if len(errors) > 0 {
+		return WirelessLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// WirelessLinkMultiError is an error wrapping multiple validation errors
+// returned by WirelessLink.ValidateAll() if the designated constraints aren't met.
+type WirelessLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WirelessLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WirelessLinkMultiError) AllErrors() []error { return m }
+
+// WirelessLinkValidationError is the validation error returned by
+// WirelessLink.Validate if the designated constraints aren't met.
+type WirelessLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WirelessLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WirelessLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WirelessLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WirelessLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WirelessLinkValidationError) ErrorName() string { return "WirelessLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WirelessLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWirelessLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WirelessLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WirelessLinkValidationError{}
+
+var _WirelessLink_Status_InLookup = map[string]struct{}{
+	"connected":       {},
+	"planned":         {},
+	"decommissioning": {},
+}
+
+var _WirelessLink_AuthType_InLookup = map[string]struct{}{
+	"open":           {},
+	"wep":            {},
+	"wpa-personal":   {},
+	"wpa-enterprise": {},
+}
+
+var _WirelessLink_AuthCipher_InLookup = map[string]struct{}{
+	"auto": {},
+	"tkip": {},
+	"aes":  {},
+}
+
+var _WirelessLink_DistanceUnit_InLookup = map[string]struct{}{
+	"km": {},
+	"m":  {},
+	"mi": {},
+	"ft": {},
+}
+
+// Validate checks the field values on FHRPGroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FHRPGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FHRPGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FHRPGroupMultiError, or nil
+// if none found.
+func (m *FHRPGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FHRPGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _FHRPGroup_Protocol_InLookup[m.GetProtocol()]; !ok {
+		err := FHRPGroupValidationError{
+			field:  "Protocol",
+			reason: "value must be in list [vrrp2 vrrp3 carp clusterxl hsrp glbp other]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetGroupId(); val < 0 || val > 32767 {
+		err := FHRPGroupValidationError{
+			field:  "GroupId",
+			reason: "value must be inside range [0, 32767]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _FHRPGroup_AuthType_InLookup[m.GetAuthType()]; !ok {
+		err := FHRPGroupValidationError{
+			field:  "AuthType",
+			reason: "value must be in list [plaintext md5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetVirtualIps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FHRPGroupValidationError{
+						field:  fmt.Sprintf("VirtualIps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FHRPGroupValidationError{
+						field:  fmt.Sprintf("VirtualIps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FHRPGroupValidationError{
+					field:  fmt.Sprintf("VirtualIps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FHRPGroupValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FHRPGroupValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FHRPGroupValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Name != nil {
+
+		if utf8.RuneCountInString(m.GetName()) > 100 {
+			err := FHRPGroupValidationError{
+				field:  "Name",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AuthKey != nil {
+
+		if utf8.RuneCountInString(m.GetAuthKey()) > 255 {
+			err := FHRPGroupValidationError{
+				field:  "AuthKey",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 200 {
+			err := FHRPGroupValidationError{
+				field:  "Description",
+				reason: "value length must be at most 200 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Comments != nil {
+		// no validation rules for Comments
+	}
+
+	if len(errors) > 0 {
+		return FHRPGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// FHRPGroupMultiError is an error wrapping multiple validation errors returned
+// by FHRPGroup.ValidateAll() if the designated constraints aren't met.
+type FHRPGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FHRPGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FHRPGroupMultiError) AllErrors() []error { return m }
+
+// FHRPGroupValidationError is the validation error returned by
+// FHRPGroup.Validate if the designated constraints aren't met.
+type FHRPGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FHRPGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FHRPGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FHRPGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FHRPGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FHRPGroupValidationError) ErrorName() string { return "FHRPGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FHRPGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFHRPGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FHRPGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FHRPGroupValidationError{}
+
+var _FHRPGroup_Protocol_InLookup = map[string]struct{}{
+	"vrrp2":     {},
+	"vrrp3":     {},
+	"carp":      {},
+	"clusterxl": {},
+	"hsrp":      {},
+	"glbp":      {},
+	"other":     {},
+}
+
+var _FHRPGroup_AuthType_InLookup = map[string]struct{}{
+	"plaintext": {},
+	"md5":       {},
+}
+
+// Validate checks the field values on FHRPGroupAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FHRPGroupAssignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FHRPGroupAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FHRPGroupAssignmentMultiError, or nil if none found.
+func (m *FHRPGroupAssignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FHRPGroupAssignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroup() == nil {
+		err := FHRPGroupAssignmentValidationError{
+			field:  "Group",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if a := m.GetGroup(); a != nil {
+
+	}
+
+	if m.GetInterface() == nil {
+		err := FHRPGroupAssignmentValidationError{
+			field:  "Interface",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if a := m.GetInterface(); a != nil {
+
+	}
+
+	if m.Priority != nil {
+
+		if val := m.GetPriority(); val < 0 || val > 255 {
+			err := FHRPGroupAssignmentValidationError{
+				field:  "Priority",
+				reason: "value must be inside range [0, 255]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FHRPGroupAssignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// FHRPGroupAssignmentMultiError is an error wrapping multiple validation
+// errors returned by FHRPGroupAssignment.ValidateAll() if the designated
+// constraints aren't met.
+type FHRPGroupAssignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FHRPGroupAssignmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FHRPGroupAssignmentMultiError) AllErrors() []error { return m }
+
+// FHRPGroupAssignmentValidationError is the validation error returned by
+// FHRPGroupAssignment.Validate if the designated constraints aren't met.
+type FHRPGroupAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FHRPGroupAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FHRPGroupAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FHRPGroupAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FHRPGroupAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FHRPGroupAssignmentValidationError) ErrorName() string {
+	return "FHRPGroupAssignmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FHRPGroupAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFHRPGroupAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FHRPGroupAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FHRPGroupAssignmentValidationError{}
+
+// Validate checks the field values on IPAddress with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IPAddress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IPAddress with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IPAddressMultiError, or nil
+// if none found.
+func (m *IPAddress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IPAddress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if ip := net.ParseIP(m.GetAddress()); ip == nil {
+		err := IPAddressValidationError{
+			field:  "Address",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _IPAddress_Status_InLookup[m.GetStatus()]; !ok {
+		err := IPAddressValidationError{
+			field:  "Status",
+			reason: "value must be in list [active reserved deprecated dhcp slaac]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _IPAddress_Role_InLookup[m.GetRole()]; !ok {
+		err := IPAddressValidationError{
+			field:  "Role",
+			reason: "value must be in list [loopback secondary anycast vip vrrp hsrp glbp carp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IPAddressValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IPAddressValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IPAddressValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	switch v := m.AssignedObject.(type) {
+	case *IPAddress_Interface:
+		if v == nil {
+			err := IPAddressValidationError{
+				field:  "AssignedObject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInterface()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IPAddressValidationError{
+						field:  "Interface",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IPAddressValidationError{
+						field:  "Interface",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInterface()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IPAddressValidationError{
+					field:  "Interface",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.DnsName != nil {
+
+		if utf8.RuneCountInString(m.GetDnsName()) > 255 {
+			err := IPAddressValidationError{
+				field:  "DnsName",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_IPAddress_DnsName_Pattern.MatchString(m.GetDnsName()) {
+			err := IPAddressValidationError{
+				field:  "DnsName",
+				reason: "value does not match regex pattern \"^([0-9A-Za-z_-]+|\\\\*)(\\\\.[0-9A-Za-z_-]+)*\\\\.?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 200 {
+			err := IPAddressValidationError{
+				field:  "Description",
+				reason: "value length must be at most 200 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Comments != nil {
+		// no validation rules for Comments
+	}
+
+	if len(errors) > 0 {
+		return IPAddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// IPAddressMultiError is an error wrapping multiple validation errors returned
+// by IPAddress.ValidateAll() if the designated constraints aren't met.
+type IPAddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IPAddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IPAddressMultiError) AllErrors() []error { return m }
+
+// IPAddressValidationError is the validation error returned by
+// IPAddress.Validate if the designated constraints aren't met.
+type IPAddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IPAddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IPAddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IPAddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IPAddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IPAddressValidationError) ErrorName() string { return "IPAddressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IPAddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIPAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IPAddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IPAddressValidationError{}
+
+var _IPAddress_Status_InLookup = map[string]struct{}{
+	"active":     {},
+	"reserved":   {},
+	"deprecated": {},
+	"dhcp":       {},
+	"slaac":      {},
+}
+
+var _IPAddress_Role_InLookup = map[string]struct{}{
+	"loopback":  {},
+	"secondary": {},
+	"anycast":   {},
+	"vip":       {},
+	"vrrp":      {},
+	"hsrp":      {},
+	"glbp":      {},
+	"carp":      {},
+}
+
+var _IPAddress_DnsName_Pattern = regexp.MustCompile("^([0-9A-Za-z_-]+|\\*)(\\.[0-9A-Za-z_-]+)*\\.?$")
+
+// Validate checks the field values on DeviceType with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeviceType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceType with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeviceTypeMultiError, or
+// nil if none found.
+func (m *DeviceType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetModel()); l < 1 || l > 100 {
+		err := DeviceTypeValidationError{
+			field:  "Model",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSlug()); l < 1 || l > 100 {
+		err := DeviceTypeValidationError{
+			field:  "Slug",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeviceType_Slug_Pattern.MatchString(m.GetSlug()) {
+		err := DeviceTypeValidationError{
+			field:  "Slug",
+			reason: "value does not match regex pattern \"^[-a-zA-Z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeviceTypeValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeviceTypeValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeviceTypeValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeviceTypeValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeviceTypeValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeviceTypeValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 200 {
+			err := DeviceTypeValidationError{
+				field:  "Description",
 				reason: "value length must be at most 200 runes",
 			}
 			if !all {
@@ -4042,6 +5228,60 @@ func (m *Entity) validate(all bool) error {
 		}
 	}
 
+	if len(m.GetClearFields()) > 100 {
+		err := EntityValidationError{
+			field:  "ClearFields",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetClearFields() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := EntityValidationError{
+				field:  fmt.Sprintf("ClearFields[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRemoveTags()) > 100 {
+		err := EntityValidationError{
+			field:  "RemoveTags",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRemoveTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := EntityValidationError{
+				field:  fmt.Sprintf("RemoveTags[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	switch v := m.Entity.(type) {
 	case *Entity_Site:
 		if v == nil {
@@ -4156,17 +5396,222 @@ func (m *Entity) validate(all bool) error {
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntityValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Entity_Device:
+		if v == nil {
+			err := EntityValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDevice()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "Device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "Device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDevice()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntityValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Entity_DeviceRole:
+		if v == nil {
+			err := EntityValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeviceRole()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "DeviceRole",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "DeviceRole",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeviceRole()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntityValidationError{
+					field:  "DeviceRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Entity_DeviceType:
+		if v == nil {
+			err := EntityValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeviceType()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "DeviceType",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "DeviceType",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeviceType()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntityValidationError{
+					field:  "DeviceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Entity_Interface:
+		if v == nil {
+			err := EntityValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInterface()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "Interface",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "Interface",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInterface()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntityValidationError{
+					field:  "Interface",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Entity_IpAddress:
+		if v == nil {
+			err := EntityValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetIpAddress()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "IpAddress",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntityValidationError{
+						field:  "IpAddress",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetIpAddress()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "Manufacturer",
+					field:  "IpAddress",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_Device:
+	case *Entity_Prefix:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4179,11 +5624,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetDevice()).(type) {
+			switch v := interface{}(m.GetPrefix()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Device",
+						field:  "Prefix",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4191,23 +5636,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Device",
+						field:  "Prefix",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetDevice()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPrefix()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "Device",
+					field:  "Prefix",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_DeviceRole:
+	case *Entity_ClusterGroup:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4220,11 +5665,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetDeviceRole()).(type) {
+			switch v := interface{}(m.GetClusterGroup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "DeviceRole",
+						field:  "ClusterGroup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4232,23 +5677,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "DeviceRole",
+						field:  "ClusterGroup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetDeviceRole()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "DeviceRole",
+					field:  "ClusterGroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_DeviceType:
+	case *Entity_ClusterType:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4261,11 +5706,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetDeviceType()).(type) {
+			switch v := interface{}(m.GetClusterType()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "DeviceType",
+						field:  "ClusterType",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4273,23 +5718,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "DeviceType",
+						field:  "ClusterType",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetDeviceType()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetClusterType()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "DeviceType",
+					field:  "ClusterType",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_Interface:
+	case *Entity_Cluster:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4302,11 +5747,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetInterface()).(type) {
+			switch v := interface{}(m.GetCluster()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Interface",
+						field:  "Cluster",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4314,23 +5759,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Interface",
+						field:  "Cluster",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetInterface()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCluster()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "Interface",
+					field:  "Cluster",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_IpAddress:
+	case *Entity_VirtualMachine:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4343,11 +5788,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetIpAddress()).(type) {
+			switch v := interface{}(m.GetVirtualMachine()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "IpAddress",
+						field:  "VirtualMachine",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4355,23 +5800,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "IpAddress",
+						field:  "VirtualMachine",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetIpAddress()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetVirtualMachine()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "IpAddress",
+					field:  "VirtualMachine",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_Prefix:
+	case *Entity_Vminterface:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4384,11 +5829,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPrefix()).(type) {
+			switch v := interface{}(m.GetVminterface()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Prefix",
+						field:  "Vminterface",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4396,23 +5841,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Prefix",
+						field:  "Vminterface",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPrefix()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetVminterface()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "Prefix",
+					field:  "Vminterface",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_ClusterGroup:
+	case *Entity_VirtualDisk:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4425,11 +5870,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetClusterGroup()).(type) {
+			switch v := interface{}(m.GetVirtualDisk()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "ClusterGroup",
+						field:  "VirtualDisk",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4437,23 +5882,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "ClusterGroup",
+						field:  "VirtualDisk",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetVirtualDisk()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "ClusterGroup",
+					field:  "VirtualDisk",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_ClusterType:
+	case *Entity_WirelessLanGroup:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4466,11 +5911,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetClusterType()).(type) {
+			switch v := interface{}(m.GetWirelessLanGroup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "ClusterType",
+						field:  "WirelessLanGroup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4478,23 +5923,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "ClusterType",
+						field:  "WirelessLanGroup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetClusterType()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetWirelessLanGroup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "ClusterType",
+					field:  "WirelessLanGroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_Cluster:
+	case *Entity_WirelessLan:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4507,11 +5952,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetCluster()).(type) {
+			switch v := interface{}(m.GetWirelessLan()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Cluster",
+						field:  "WirelessLan",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4519,23 +5964,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Cluster",
+						field:  "WirelessLan",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCluster()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetWirelessLan()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "Cluster",
+					field:  "WirelessLan",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_VirtualMachine:
+	case *Entity_WirelessLink:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4548,11 +5993,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetVirtualMachine()).(type) {
+			switch v := interface{}(m.GetWirelessLink()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "VirtualMachine",
+						field:  "WirelessLink",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4560,23 +6005,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "VirtualMachine",
+						field:  "WirelessLink",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetVirtualMachine()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetWirelessLink()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "VirtualMachine",
+					field:  "WirelessLink",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_Vminterface:
+	case *Entity_FhrpGroup:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4589,11 +6034,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetVminterface()).(type) {
+			switch v := interface{}(m.GetFhrpGroup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Vminterface",
+						field:  "FhrpGroup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4601,23 +6046,23 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "Vminterface",
+						field:  "FhrpGroup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetVminterface()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFhrpGroup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "Vminterface",
+					field:  "FhrpGroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Entity_VirtualDisk:
+	case *Entity_FhrpGroupAssignment:
 		if v == nil {
 			err := EntityValidationError{
 				field:  "Entity",
@@ -4630,11 +6075,11 @@ func (m *Entity) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetVirtualDisk()).(type) {
+			switch v := interface{}(m.GetFhrpGroupAssignment()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "VirtualDisk",
+						field:  "FhrpGroupAssignment",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4642,16 +6087,16 @@ func (m *Entity) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, EntityValidationError{
-						field:  "VirtualDisk",
+						field:  "FhrpGroupAssignment",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetVirtualDisk()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFhrpGroupAssignment()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return EntityValidationError{
-					field:  "VirtualDisk",
+					field:  "FhrpGroupAssignment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4739,6 +6184,158 @@ var _ interface {
 	ErrorName() string
 } = EntityValidationError{}
 
+// Validate checks the field values on Snapshot with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Snapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Snapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SnapshotMultiError, or nil
+// if none found.
+func (m *Snapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Snapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = SnapshotValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetScope()); l < 1 || l > 255 {
+		err := SnapshotValidationError{
+			field:  "Scope",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBatchIndex() < 0 {
+		err := SnapshotValidationError{
+			field:  "BatchIndex",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBatchCount() < 1 {
+		err := SnapshotValidationError{
+			field:  "BatchCount",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Snapshot) _validateUuid(uuid string) error {
+	if matched := _ingester_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SnapshotMultiError is an error wrapping multiple validation errors returned
+// by Snapshot.ValidateAll() if the designated constraints aren't met.
+type SnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnapshotMultiError) AllErrors() []error { return m }
+
+// SnapshotValidationError is the validation error returned by
+// Snapshot.Validate if the designated constraints aren't met.
+type SnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnapshotValidationError) ErrorName() string { return "SnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnapshotValidationError{}
+
 // Validate checks the field values on IngestRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -4873,6 +6470,37 @@ func (m *IngestRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IngestRequestValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IngestRequestValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IngestRequestValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Transactional
+
 	if len(errors) > 0 {
 		return IngestRequestMultiError(errors)
 	}
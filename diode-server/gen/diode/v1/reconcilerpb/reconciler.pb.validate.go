@@ -604,6 +604,16 @@ func (m *IngestionMetrics) validate(all bool) error {
 
 	// no validation rules for NoChanges
 
+	// no validation rules for SkippedStale
+
+	// no validation rules for Filtered
+
+	// no validation rules for Reverted
+
+	// no validation rules for ObjectStateCacheHits
+
+	// no validation rules for ObjectStateCacheMisses
+
 	if len(errors) > 0 {
 		return IngestionMetricsMultiError(errors)
 	}
@@ -708,6 +718,44 @@ func (m *ChangeSet) validate(all bool) error {
 
 	// no validation rules for Data
 
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeSetValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeSetValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeSetValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RevertedByChangeSetId
+
+	// no validation rules for RevertsChangeSetId
+
 	if len(errors) > 0 {
 		return ChangeSetMultiError(errors)
 	}
@@ -785,146 +833,111 @@ var _ interface {
 	ErrorName() string
 } = ChangeSetValidationError{}
 
-// Validate checks the field values on IngestionLog with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *IngestionLog) Validate() error {
+func (m *Change) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IngestionLog with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in IngestionLogMultiError, or
-// nil if none found.
-func (m *IngestionLog) ValidateAll() error {
+// ValidateAll checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChangeMultiError, or nil if none found.
+func (m *Change) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IngestionLog) validate(all bool) error {
+func (m *Change) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for DataType
-
-	// no validation rules for State
-
-	// no validation rules for RequestId
-
-	// no validation rules for IngestionTs
-
-	// no validation rules for ProducerAppName
+	// no validation rules for ChangeId
 
-	// no validation rules for ProducerAppVersion
+	// no validation rules for ChangeType
 
-	// no validation rules for SdkName
+	// no validation rules for ObjectType
 
-	// no validation rules for SdkVersion
+	// no validation rules for ObjectId
 
 	if all {
-		switch v := interface{}(m.GetEntity()).(type) {
+		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, IngestionLogValidationError{
-					field:  "Entity",
+				errors = append(errors, ChangeValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, IngestionLogValidationError{
-					field:  "Entity",
+				errors = append(errors, ChangeValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return IngestionLogValidationError{
-				field:  "Entity",
+			return ChangeValidationError{
+				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetError()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, IngestionLogValidationError{
-					field:  "Error",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, IngestionLogValidationError{
-					field:  "Error",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return IngestionLogValidationError{
-				field:  "Error",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetDiff() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetChangeSet()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, IngestionLogValidationError{
-					field:  "ChangeSet",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeValidationError{
+						field:  fmt.Sprintf("Diff[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeValidationError{
+						field:  fmt.Sprintf("Diff[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, IngestionLogValidationError{
-					field:  "ChangeSet",
+				return ChangeValidationError{
+					field:  fmt.Sprintf("Diff[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetChangeSet()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return IngestionLogValidationError{
-				field:  "ChangeSet",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return IngestionLogMultiError(errors)
+		return ChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// IngestionLogMultiError is an error wrapping multiple validation errors
-// returned by IngestionLog.ValidateAll() if the designated constraints aren't met.
-type IngestionLogMultiError []error
+// ChangeMultiError is an error wrapping multiple validation errors returned by
+// Change.ValidateAll() if the designated constraints aren't met.
+type ChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IngestionLogMultiError) Error() string {
+func (m ChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -933,11 +946,11 @@ func (m IngestionLogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IngestionLogMultiError) AllErrors() []error { return m }
+func (m ChangeMultiError) AllErrors() []error { return m }
 
-// IngestionLogValidationError is the validation error returned by
-// IngestionLog.Validate if the designated constraints aren't met.
-type IngestionLogValidationError struct {
+// ChangeValidationError is the validation error returned by Change.Validate if
+// the designated constraints aren't met.
+type ChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -945,22 +958,22 @@ type IngestionLogValidationError struct {
 }
 
 // Field function returns field value.
-func (e IngestionLogValidationError) Field() string { return e.field }
+func (e ChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IngestionLogValidationError) Reason() string { return e.reason }
+func (e ChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IngestionLogValidationError) Cause() error { return e.cause }
+func (e ChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IngestionLogValidationError) Key() bool { return e.key }
+func (e ChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IngestionLogValidationError) ErrorName() string { return "IngestionLogValidationError" }
+func (e ChangeValidationError) ErrorName() string { return "ChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IngestionLogValidationError) Error() string {
+func (e ChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -972,14 +985,14 @@ func (e IngestionLogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIngestionLog.%s: %s%s",
+		"invalid %sChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IngestionLogValidationError{}
+var _ error = ChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -987,64 +1000,103 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IngestionLogValidationError{}
+} = ChangeValidationError{}
 
-// Validate checks the field values on RetrieveIngestionLogsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RetrieveIngestionLogsRequest) Validate() error {
+// Validate checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RetrieveIngestionLogsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RetrieveIngestionLogsRequestMultiError, or nil if none found.
-func (m *RetrieveIngestionLogsRequest) ValidateAll() error {
+// ValidateAll checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldChangeMultiError, or
+// nil if none found.
+func (m *FieldChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RetrieveIngestionLogsRequest) validate(all bool) error {
+func (m *FieldChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DataType
-
-	// no validation rules for RequestId
-
-	// no validation rules for IngestionTsStart
-
-	// no validation rules for IngestionTsEnd
-
-	// no validation rules for PageToken
-
-	// no validation rules for OnlyMetrics
+	// no validation rules for Field
 
-	if m.PageSize != nil {
-		// no validation rules for PageSize
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldChangeValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.State != nil {
-		// no validation rules for State
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldChangeValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return RetrieveIngestionLogsRequestMultiError(errors)
+		return FieldChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// RetrieveIngestionLogsRequestMultiError is an error wrapping multiple
-// validation errors returned by RetrieveIngestionLogsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RetrieveIngestionLogsRequestMultiError []error
+// FieldChangeMultiError is an error wrapping multiple validation errors
+// returned by FieldChange.ValidateAll() if the designated constraints aren't met.
+type FieldChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RetrieveIngestionLogsRequestMultiError) Error() string {
+func (m FieldChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1053,12 +1105,11 @@ func (m RetrieveIngestionLogsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RetrieveIngestionLogsRequestMultiError) AllErrors() []error { return m }
+func (m FieldChangeMultiError) AllErrors() []error { return m }
 
-// RetrieveIngestionLogsRequestValidationError is the validation error returned
-// by RetrieveIngestionLogsRequest.Validate if the designated constraints
-// aren't met.
-type RetrieveIngestionLogsRequestValidationError struct {
+// FieldChangeValidationError is the validation error returned by
+// FieldChange.Validate if the designated constraints aren't met.
+type FieldChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1066,24 +1117,22 @@ type RetrieveIngestionLogsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RetrieveIngestionLogsRequestValidationError) Field() string { return e.field }
+func (e FieldChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RetrieveIngestionLogsRequestValidationError) Reason() string { return e.reason }
+func (e FieldChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RetrieveIngestionLogsRequestValidationError) Cause() error { return e.cause }
+func (e FieldChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RetrieveIngestionLogsRequestValidationError) Key() bool { return e.key }
+func (e FieldChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RetrieveIngestionLogsRequestValidationError) ErrorName() string {
-	return "RetrieveIngestionLogsRequestValidationError"
-}
+func (e FieldChangeValidationError) ErrorName() string { return "FieldChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RetrieveIngestionLogsRequestValidationError) Error() string {
+func (e FieldChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1095,14 +1144,14 @@ func (e RetrieveIngestionLogsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRetrieveIngestionLogsRequest.%s: %s%s",
+		"invalid %sFieldChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RetrieveIngestionLogsRequestValidationError{}
+var _ error = FieldChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1110,47 +1159,1193 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RetrieveIngestionLogsRequestValidationError{}
+} = FieldChangeValidationError{}
 
-// Validate checks the field values on RetrieveIngestionLogsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RetrieveIngestionLogsResponse) Validate() error {
+// Validate checks the field values on IngestionLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IngestionLog) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RetrieveIngestionLogsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RetrieveIngestionLogsResponseMultiError, or nil if none found.
-func (m *RetrieveIngestionLogsResponse) ValidateAll() error {
-	return m.validate(true)
+// ValidateAll checks the field values on IngestionLog with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IngestionLogMultiError, or
+// nil if none found.
+func (m *IngestionLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestionLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DataType
+
+	// no validation rules for State
+
+	// no validation rules for RequestId
+
+	// no validation rules for IngestionTs
+
+	// no validation rules for ProducerAppName
+
+	// no validation rules for ProducerAppVersion
+
+	// no validation rules for SdkName
+
+	// no validation rules for SdkVersion
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IngestionLogValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IngestionLogValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IngestionLogValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IngestionLogValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IngestionLogValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IngestionLogValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetChangeSet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IngestionLogValidationError{
+					field:  "ChangeSet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IngestionLogValidationError{
+					field:  "ChangeSet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeSet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IngestionLogValidationError{
+				field:  "ChangeSet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FilterReason
+
+	if len(errors) > 0 {
+		return IngestionLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// IngestionLogMultiError is an error wrapping multiple validation errors
+// returned by IngestionLog.ValidateAll() if the designated constraints aren't met.
+type IngestionLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngestionLogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngestionLogMultiError) AllErrors() []error { return m }
+
+// IngestionLogValidationError is the validation error returned by
+// IngestionLog.Validate if the designated constraints aren't met.
+type IngestionLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngestionLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngestionLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngestionLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngestionLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngestionLogValidationError) ErrorName() string { return "IngestionLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IngestionLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngestionLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngestionLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngestionLogValidationError{}
+
+// Validate checks the field values on RetrieveIngestionLogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetrieveIngestionLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetrieveIngestionLogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetrieveIngestionLogsRequestMultiError, or nil if none found.
+func (m *RetrieveIngestionLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetrieveIngestionLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DataType
+
+	// no validation rules for RequestId
+
+	// no validation rules for IngestionTsStart
+
+	// no validation rules for IngestionTsEnd
+
+	// no validation rules for PageToken
+
+	// no validation rules for OnlyMetrics
+
+	// no validation rules for DecodeChangeSets
+
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RetrieveIngestionLogsRequestValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RetrieveIngestionLogsRequestValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RetrieveIngestionLogsRequestValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Search
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.State != nil {
+		// no validation rules for State
+	}
+
+	if len(errors) > 0 {
+		return RetrieveIngestionLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetrieveIngestionLogsRequestMultiError is an error wrapping multiple
+// validation errors returned by RetrieveIngestionLogsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RetrieveIngestionLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetrieveIngestionLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetrieveIngestionLogsRequestMultiError) AllErrors() []error { return m }
+
+// RetrieveIngestionLogsRequestValidationError is the validation error returned
+// by RetrieveIngestionLogsRequest.Validate if the designated constraints
+// aren't met.
+type RetrieveIngestionLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetrieveIngestionLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetrieveIngestionLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetrieveIngestionLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetrieveIngestionLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetrieveIngestionLogsRequestValidationError) ErrorName() string {
+	return "RetrieveIngestionLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetrieveIngestionLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetrieveIngestionLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetrieveIngestionLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetrieveIngestionLogsRequestValidationError{}
+
+// Validate checks the field values on IngestionLogFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IngestionLogFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngestionLogFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IngestionLogFilterMultiError, or nil if none found.
+func (m *IngestionLogFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestionLogFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Negate
+
+	if len(errors) > 0 {
+		return IngestionLogFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// IngestionLogFilterMultiError is an error wrapping multiple validation errors
+// returned by IngestionLogFilter.ValidateAll() if the designated constraints
+// aren't met.
+type IngestionLogFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngestionLogFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngestionLogFilterMultiError) AllErrors() []error { return m }
+
+// IngestionLogFilterValidationError is the validation error returned by
+// IngestionLogFilter.Validate if the designated constraints aren't met.
+type IngestionLogFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngestionLogFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngestionLogFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngestionLogFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngestionLogFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngestionLogFilterValidationError) ErrorName() string {
+	return "IngestionLogFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IngestionLogFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngestionLogFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngestionLogFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngestionLogFilterValidationError{}
+
+// Validate checks the field values on RetrieveIngestionLogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetrieveIngestionLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetrieveIngestionLogsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RetrieveIngestionLogsResponseMultiError, or nil if none found.
+func (m *RetrieveIngestionLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetrieveIngestionLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RetrieveIngestionLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RetrieveIngestionLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RetrieveIngestionLogsResponseValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetrics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetrieveIngestionLogsResponseValidationError{
+					field:  "Metrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetrieveIngestionLogsResponseValidationError{
+					field:  "Metrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetrics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetrieveIngestionLogsResponseValidationError{
+				field:  "Metrics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return RetrieveIngestionLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetrieveIngestionLogsResponseMultiError is an error wrapping multiple
+// validation errors returned by RetrieveIngestionLogsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RetrieveIngestionLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetrieveIngestionLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetrieveIngestionLogsResponseMultiError) AllErrors() []error { return m }
+
+// RetrieveIngestionLogsResponseValidationError is the validation error
+// returned by RetrieveIngestionLogsResponse.Validate if the designated
+// constraints aren't met.
+type RetrieveIngestionLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetrieveIngestionLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetrieveIngestionLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetrieveIngestionLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetrieveIngestionLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetrieveIngestionLogsResponseValidationError) ErrorName() string {
+	return "RetrieveIngestionLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetrieveIngestionLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetrieveIngestionLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetrieveIngestionLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetrieveIngestionLogsResponseValidationError{}
+
+// Validate checks the field values on WatchIngestionLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchIngestionLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchIngestionLogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchIngestionLogsRequestMultiError, or nil if none found.
+func (m *WatchIngestionLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchIngestionLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DataType
+
+	// no validation rules for RequestId
+
+	// no validation rules for IngestionTsStart
+
+	// no validation rules for IngestionTsEnd
+
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchIngestionLogsRequestValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchIngestionLogsRequestValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchIngestionLogsRequestValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Search
+
+	// no validation rules for DecodeChangeSets
+
+	if m.State != nil {
+		// no validation rules for State
+	}
+
+	if len(errors) > 0 {
+		return WatchIngestionLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchIngestionLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchIngestionLogsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type WatchIngestionLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchIngestionLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchIngestionLogsRequestMultiError) AllErrors() []error { return m }
+
+// WatchIngestionLogsRequestValidationError is the validation error returned by
+// WatchIngestionLogsRequest.Validate if the designated constraints aren't met.
+type WatchIngestionLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchIngestionLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchIngestionLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchIngestionLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchIngestionLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchIngestionLogsRequestValidationError) ErrorName() string {
+	return "WatchIngestionLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchIngestionLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchIngestionLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchIngestionLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchIngestionLogsRequestValidationError{}
+
+// Validate checks the field values on WatchIngestionLogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchIngestionLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchIngestionLogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchIngestionLogsResponseMultiError, or nil if none found.
+func (m *WatchIngestionLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchIngestionLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchIngestionLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchIngestionLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchIngestionLogsResponseValidationError{
+				field:  "Log",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchIngestionLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchIngestionLogsResponseMultiError is an error wrapping multiple
+// validation errors returned by WatchIngestionLogsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WatchIngestionLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchIngestionLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchIngestionLogsResponseMultiError) AllErrors() []error { return m }
+
+// WatchIngestionLogsResponseValidationError is the validation error returned
+// by WatchIngestionLogsResponse.Validate if the designated constraints aren't met.
+type WatchIngestionLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchIngestionLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchIngestionLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchIngestionLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchIngestionLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchIngestionLogsResponseValidationError) ErrorName() string {
+	return "WatchIngestionLogsResponseValidationError"
 }
 
-func (m *RetrieveIngestionLogsResponse) validate(all bool) error {
+// Error satisfies the builtin error interface
+func (e WatchIngestionLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchIngestionLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchIngestionLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchIngestionLogsResponseValidationError{}
+
+// Validate checks the field values on RevertChangeSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertChangeSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertChangeSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertChangeSetRequestMultiError, or nil if none found.
+func (m *RevertChangeSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertChangeSetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLogs() {
+	// no validation rules for ChangeSetId
+
+	// no validation rules for RequestId
+
+	// no validation rules for IngestionTsStart
+
+	// no validation rules for IngestionTsEnd
+
+	if len(errors) > 0 {
+		return RevertChangeSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertChangeSetRequestMultiError is an error wrapping multiple validation
+// errors returned by RevertChangeSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevertChangeSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertChangeSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertChangeSetRequestMultiError) AllErrors() []error { return m }
+
+// RevertChangeSetRequestValidationError is the validation error returned by
+// RevertChangeSetRequest.Validate if the designated constraints aren't met.
+type RevertChangeSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertChangeSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertChangeSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertChangeSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertChangeSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertChangeSetRequestValidationError) ErrorName() string {
+	return "RevertChangeSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertChangeSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertChangeSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertChangeSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertChangeSetRequestValidationError{}
+
+// Validate checks the field values on RevertedChangeSet with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevertedChangeSet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertedChangeSet with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertedChangeSetMultiError, or nil if none found.
+func (m *RevertedChangeSet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertedChangeSet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChangeSetId
+
+	// no validation rules for RevertChangeSetId
+
+	// no validation rules for Changes
+
+	if len(errors) > 0 {
+		return RevertedChangeSetMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertedChangeSetMultiError is an error wrapping multiple validation errors
+// returned by RevertedChangeSet.ValidateAll() if the designated constraints
+// aren't met.
+type RevertedChangeSetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertedChangeSetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertedChangeSetMultiError) AllErrors() []error { return m }
+
+// RevertedChangeSetValidationError is the validation error returned by
+// RevertedChangeSet.Validate if the designated constraints aren't met.
+type RevertedChangeSetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertedChangeSetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertedChangeSetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertedChangeSetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertedChangeSetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertedChangeSetValidationError) ErrorName() string {
+	return "RevertedChangeSetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertedChangeSetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertedChangeSet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertedChangeSetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertedChangeSetValidationError{}
+
+// Validate checks the field values on RevertChangeSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertChangeSetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertChangeSetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertChangeSetResponseMultiError, or nil if none found.
+func (m *RevertChangeSetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertChangeSetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevertedChangeSets() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RetrieveIngestionLogsResponseValidationError{
-						field:  fmt.Sprintf("Logs[%v]", idx),
+					errors = append(errors, RevertChangeSetResponseValidationError{
+						field:  fmt.Sprintf("RevertedChangeSets[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RetrieveIngestionLogsResponseValidationError{
-						field:  fmt.Sprintf("Logs[%v]", idx),
+					errors = append(errors, RevertChangeSetResponseValidationError{
+						field:  fmt.Sprintf("RevertedChangeSets[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1158,8 +2353,8 @@ func (m *RetrieveIngestionLogsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RetrieveIngestionLogsResponseValidationError{
-					field:  fmt.Sprintf("Logs[%v]", idx),
+				return RevertChangeSetResponseValidationError{
+					field:  fmt.Sprintf("RevertedChangeSets[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1169,50 +2364,48 @@ func (m *RetrieveIngestionLogsResponse) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetMetrics()).(type) {
+		switch v := interface{}(m.GetError()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RetrieveIngestionLogsResponseValidationError{
-					field:  "Metrics",
+				errors = append(errors, RevertChangeSetResponseValidationError{
+					field:  "Error",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RetrieveIngestionLogsResponseValidationError{
-					field:  "Metrics",
+				errors = append(errors, RevertChangeSetResponseValidationError{
+					field:  "Error",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetrics()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RetrieveIngestionLogsResponseValidationError{
-				field:  "Metrics",
+			return RevertChangeSetResponseValidationError{
+				field:  "Error",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return RetrieveIngestionLogsResponseMultiError(errors)
+		return RevertChangeSetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RetrieveIngestionLogsResponseMultiError is an error wrapping multiple
-// validation errors returned by RetrieveIngestionLogsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RetrieveIngestionLogsResponseMultiError []error
+// RevertChangeSetResponseMultiError is an error wrapping multiple validation
+// errors returned by RevertChangeSetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevertChangeSetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RetrieveIngestionLogsResponseMultiError) Error() string {
+func (m RevertChangeSetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1221,12 +2414,11 @@ func (m RetrieveIngestionLogsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RetrieveIngestionLogsResponseMultiError) AllErrors() []error { return m }
+func (m RevertChangeSetResponseMultiError) AllErrors() []error { return m }
 
-// RetrieveIngestionLogsResponseValidationError is the validation error
-// returned by RetrieveIngestionLogsResponse.Validate if the designated
-// constraints aren't met.
-type RetrieveIngestionLogsResponseValidationError struct {
+// RevertChangeSetResponseValidationError is the validation error returned by
+// RevertChangeSetResponse.Validate if the designated constraints aren't met.
+type RevertChangeSetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1234,24 +2426,24 @@ type RetrieveIngestionLogsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RetrieveIngestionLogsResponseValidationError) Field() string { return e.field }
+func (e RevertChangeSetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RetrieveIngestionLogsResponseValidationError) Reason() string { return e.reason }
+func (e RevertChangeSetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RetrieveIngestionLogsResponseValidationError) Cause() error { return e.cause }
+func (e RevertChangeSetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RetrieveIngestionLogsResponseValidationError) Key() bool { return e.key }
+func (e RevertChangeSetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RetrieveIngestionLogsResponseValidationError) ErrorName() string {
-	return "RetrieveIngestionLogsResponseValidationError"
+func (e RevertChangeSetResponseValidationError) ErrorName() string {
+	return "RevertChangeSetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RetrieveIngestionLogsResponseValidationError) Error() string {
+func (e RevertChangeSetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1263,14 +2455,14 @@ func (e RetrieveIngestionLogsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRetrieveIngestionLogsResponse.%s: %s%s",
+		"invalid %sRevertChangeSetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RetrieveIngestionLogsResponseValidationError{}
+var _ error = RevertChangeSetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1278,7 +2470,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RetrieveIngestionLogsResponseValidationError{}
+} = RevertChangeSetResponseValidationError{}
 
 // Validate checks the field values on IngestionError_Details with the rules
 // defined in the proto definition for this message. If any rules are
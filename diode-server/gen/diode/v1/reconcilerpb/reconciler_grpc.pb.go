@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	ReconcilerService_RetrieveIngestionDataSources_FullMethodName = "/diode.v1.ReconcilerService/RetrieveIngestionDataSources"
 	ReconcilerService_RetrieveIngestionLogs_FullMethodName        = "/diode.v1.ReconcilerService/RetrieveIngestionLogs"
+	ReconcilerService_RevertChangeSet_FullMethodName              = "/diode.v1.ReconcilerService/RevertChangeSet"
+	ReconcilerService_WatchIngestionLogs_FullMethodName           = "/diode.v1.ReconcilerService/WatchIngestionLogs"
 )
 
 // ReconcilerServiceClient is the client API for ReconcilerService service.
@@ -31,6 +33,10 @@ type ReconcilerServiceClient interface {
 	RetrieveIngestionDataSources(ctx context.Context, in *RetrieveIngestionDataSourcesRequest, opts ...grpc.CallOption) (*RetrieveIngestionDataSourcesResponse, error)
 	// Retrieves ingestion logs
 	RetrieveIngestionLogs(ctx context.Context, in *RetrieveIngestionLogsRequest, opts ...grpc.CallOption) (*RetrieveIngestionLogsResponse, error)
+	// Reverts applied change sets
+	RevertChangeSet(ctx context.Context, in *RevertChangeSetRequest, opts ...grpc.CallOption) (*RevertChangeSetResponse, error)
+	// Streams the ingestion logs written, new or changed, matching the filters of the request
+	WatchIngestionLogs(ctx context.Context, in *WatchIngestionLogsRequest, opts ...grpc.CallOption) (ReconcilerService_WatchIngestionLogsClient, error)
 }
 
 type reconcilerServiceClient struct {
@@ -59,6 +65,47 @@ func (c *reconcilerServiceClient) RetrieveIngestionLogs(ctx context.Context, in
 	return out, nil
 }
 
+func (c *reconcilerServiceClient) RevertChangeSet(ctx context.Context, in *RevertChangeSetRequest, opts ...grpc.CallOption) (*RevertChangeSetResponse, error) {
+	out := new(RevertChangeSetResponse)
+	err := c.cc.Invoke(ctx, ReconcilerService_RevertChangeSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reconcilerServiceClient) WatchIngestionLogs(ctx context.Context, in *WatchIngestionLogsRequest, opts ...grpc.CallOption) (ReconcilerService_WatchIngestionLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReconcilerService_ServiceDesc.Streams[0], ReconcilerService_WatchIngestionLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reconcilerServiceWatchIngestionLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReconcilerService_WatchIngestionLogsClient interface {
+	Recv() (*WatchIngestionLogsResponse, error)
+	grpc.ClientStream
+}
+
+type reconcilerServiceWatchIngestionLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *reconcilerServiceWatchIngestionLogsClient) Recv() (*WatchIngestionLogsResponse, error) {
+	m := new(WatchIngestionLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ReconcilerServiceServer is the server API for ReconcilerService service.
 // All implementations must embed UnimplementedReconcilerServiceServer
 // for forward compatibility
@@ -67,6 +114,10 @@ type ReconcilerServiceServer interface {
 	RetrieveIngestionDataSources(context.Context, *RetrieveIngestionDataSourcesRequest) (*RetrieveIngestionDataSourcesResponse, error)
 	// Retrieves ingestion logs
 	RetrieveIngestionLogs(context.Context, *RetrieveIngestionLogsRequest) (*RetrieveIngestionLogsResponse, error)
+	// Reverts applied change sets
+	RevertChangeSet(context.Context, *RevertChangeSetRequest) (*RevertChangeSetResponse, error)
+	// Streams the ingestion logs written, new or changed, matching the filters of the request
+	WatchIngestionLogs(*WatchIngestionLogsRequest, ReconcilerService_WatchIngestionLogsServer) error
 	mustEmbedUnimplementedReconcilerServiceServer()
 }
 
@@ -80,6 +131,12 @@ func (UnimplementedReconcilerServiceServer) RetrieveIngestionDataSources(context
 func (UnimplementedReconcilerServiceServer) RetrieveIngestionLogs(context.Context, *RetrieveIngestionLogsRequest) (*RetrieveIngestionLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveIngestionLogs not implemented")
 }
+func (UnimplementedReconcilerServiceServer) RevertChangeSet(context.Context, *RevertChangeSetRequest) (*RevertChangeSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertChangeSet not implemented")
+}
+func (UnimplementedReconcilerServiceServer) WatchIngestionLogs(*WatchIngestionLogsRequest, ReconcilerService_WatchIngestionLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchIngestionLogs not implemented")
+}
 func (UnimplementedReconcilerServiceServer) mustEmbedUnimplementedReconcilerServiceServer() {}
 
 // UnsafeReconcilerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +186,45 @@ func _ReconcilerService_RetrieveIngestionLogs_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReconcilerService_RevertChangeSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertChangeSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilerServiceServer).RevertChangeSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReconcilerService_RevertChangeSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilerServiceServer).RevertChangeSet(ctx, req.(*RevertChangeSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReconcilerService_WatchIngestionLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchIngestionLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReconcilerServiceServer).WatchIngestionLogs(m, &reconcilerServiceWatchIngestionLogsServer{stream})
+}
+
+type ReconcilerService_WatchIngestionLogsServer interface {
+	Send(*WatchIngestionLogsResponse) error
+	grpc.ServerStream
+}
+
+type reconcilerServiceWatchIngestionLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *reconcilerServiceWatchIngestionLogsServer) Send(m *WatchIngestionLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ReconcilerService_ServiceDesc is the grpc.ServiceDesc for ReconcilerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,7 +240,17 @@ var ReconcilerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveIngestionLogs",
 			Handler:    _ReconcilerService_RetrieveIngestionLogs_Handler,
 		},
+		{
+			MethodName: "RevertChangeSet",
+			Handler:    _ReconcilerService_RevertChangeSet_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchIngestionLogs",
+			Handler:       _ReconcilerService_WatchIngestionLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "diode/v1/reconciler.proto",
 }
@@ -213,6 +213,24 @@ func validateRequest(in *diodepb.IngestRequest) error {
 		return fmt.Errorf("entities is empty")
 	}
 
+	if snapshot := in.GetSnapshot(); snapshot != nil {
+		if snapshot.GetId() == "" {
+			return fmt.Errorf("snapshot id is empty")
+		}
+
+		if snapshot.GetScope() == "" {
+			return fmt.Errorf("snapshot scope is empty")
+		}
+
+		if snapshot.GetBatchCount() < 1 {
+			return fmt.Errorf("snapshot batch count must be at least 1")
+		}
+
+		if snapshot.GetBatchIndex() < 0 || snapshot.GetBatchIndex() >= snapshot.GetBatchCount() {
+			return fmt.Errorf("snapshot batch index is out of range")
+		}
+	}
+
 	return nil
 }
 
@@ -303,6 +303,86 @@ func TestIngest(t *testing.T) {
 			errorMessage: "",
 			hasError:     false,
 		},
+		{
+			name: "missing snapshot scope",
+			request: &pb.IngestRequest{
+				Id:                 "test-id",
+				ProducerAppName:    "test-app",
+				ProducerAppVersion: "1.0",
+				SdkName:            "test-sdk",
+				SdkVersion:         "1.0",
+				Entities: []*pb.Entity{
+					{
+						Entity: &pb.Entity_Site{
+							Site: &pb.Site{
+								Name: "test-site-name",
+							},
+						},
+					},
+				},
+				Snapshot: &pb.Snapshot{
+					Id:         "2e2d7f4c-0c34-4d6b-9f55-5c4b0b0a7a91",
+					BatchIndex: 0,
+					BatchCount: 1,
+				},
+			},
+			errorMessage: "snapshot scope is empty",
+			hasError:     true,
+		},
+		{
+			name: "snapshot batch index out of range",
+			request: &pb.IngestRequest{
+				Id:                 "test-id",
+				ProducerAppName:    "test-app",
+				ProducerAppVersion: "1.0",
+				SdkName:            "test-sdk",
+				SdkVersion:         "1.0",
+				Entities: []*pb.Entity{
+					{
+						Entity: &pb.Entity_Site{
+							Site: &pb.Site{
+								Name: "test-site-name",
+							},
+						},
+					},
+				},
+				Snapshot: &pb.Snapshot{
+					Id:         "2e2d7f4c-0c34-4d6b-9f55-5c4b0b0a7a91",
+					Scope:      "cluster-01",
+					BatchIndex: 2,
+					BatchCount: 2,
+				},
+			},
+			errorMessage: "snapshot batch index is out of range",
+			hasError:     true,
+		},
+		{
+			name: "valid snapshot request",
+			request: &pb.IngestRequest{
+				Id:                 "test-id",
+				ProducerAppName:    "test-app",
+				ProducerAppVersion: "1.0",
+				SdkName:            "test-sdk",
+				SdkVersion:         "1.0",
+				Entities: []*pb.Entity{
+					{
+						Entity: &pb.Entity_Site{
+							Site: &pb.Site{
+								Name: "test-site-name",
+							},
+						},
+					},
+				},
+				Snapshot: &pb.Snapshot{
+					Id:         "2e2d7f4c-0c34-4d6b-9f55-5c4b0b0a7a91",
+					Scope:      "cluster-01",
+					BatchIndex: 1,
+					BatchCount: 2,
+				},
+			},
+			errorMessage: "",
+			hasError:     false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
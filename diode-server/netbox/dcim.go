@@ -3,6 +3,8 @@ package netbox
 import (
 	"errors"
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
 )
@@ -14,6 +16,9 @@ var (
 	// ErrInvalidInterfaceMode is returned when the interface mode is invalid
 	ErrInvalidInterfaceMode = errors.New("invalid interface mode")
 
+	// ErrInvalidMACAddress is returned when the MAC address is invalid
+	ErrInvalidMACAddress = errors.New("invalid MAC address")
+
 	// DefaultInterfaceType is the default interface type
 	DefaultInterfaceType = "other"
 
@@ -181,6 +186,10 @@ type DcimDevice struct {
 	PrimaryIPv6 *IpamIPAddress    `json:"primary_ip6,omitempty" mapstructure:"primary_ip6"`
 	Comments    *string           `json:"comments,omitempty"`
 	Tags        []*Tag            `json:"tags,omitempty"`
+
+	// DeviceFQDN and PrimaryIPAddress only match the device to an existing one, they're not NetBox fields
+	DeviceFQDN       *string `json:"-" mapstructure:"-" hash:"ignore"`
+	PrimaryIPAddress *string `json:"-" mapstructure:"-" hash:"ignore"`
 }
 
 // DcimDeviceStatus represents a DCIM device status
@@ -370,6 +379,9 @@ func FromProtoDevice(devicePb *diodepb.Device) *DcimDevice {
 		PrimaryIPv6: nil,
 		Comments:    devicePb.Comments,
 		Tags:        FromProtoTags(devicePb.Tags),
+
+		DeviceFQDN:       devicePb.DeviceFqdn,
+		PrimaryIPAddress: primaryIPAddress(devicePb.PrimaryIp4, devicePb.PrimaryIp6),
 	}
 }
 
@@ -504,21 +516,22 @@ func FromProtoPlatform(platformPb *diodepb.Platform) *DcimPlatform {
 
 // DcimInterface represents a DCIM interface
 type DcimInterface struct {
-	ID            int         `json:"id,omitempty"`
-	Device        *DcimDevice `json:"device,omitempty"`
-	Name          string      `json:"name,omitempty"`
-	Label         *string     `json:"label,omitempty"`
-	Type          *string     `json:"type,omitempty"`
-	Enabled       *bool       `json:"enabled,omitempty"`
-	MTU           *int        `json:"mtu,omitempty"`
-	MACAddress    *string     `json:"mac_address,omitempty" mapstructure:"mac_address,omitempty"`
-	Speed         *int        `json:"speed,omitempty"`
-	WWN           *string     `json:"wwn,omitempty"`
-	MgmtOnly      *bool       `json:"mgmt_only,omitempty" mapstructure:"mgmt_only,omitempty"`
-	Description   *string     `json:"description,omitempty"`
-	MarkConnected *bool       `json:"mark_connected,omitempty" mapstructure:"mark_connected,omitempty"`
-	Mode          *string     `json:"mode,omitempty"`
-	Tags          []*Tag      `json:"tags,omitempty"`
+	ID            int            `json:"id,omitempty"`
+	Device        *DcimDevice    `json:"device,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	Label         *string        `json:"label,omitempty"`
+	Type          *string        `json:"type,omitempty"`
+	Enabled       *bool          `json:"enabled,omitempty"`
+	MTU           *int           `json:"mtu,omitempty"`
+	MACAddress    *string        `json:"mac_address,omitempty" mapstructure:"mac_address,omitempty"`
+	Speed         *int           `json:"speed,omitempty"`
+	WWN           *string        `json:"wwn,omitempty"`
+	MgmtOnly      *bool          `json:"mgmt_only,omitempty" mapstructure:"mgmt_only,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	MarkConnected *bool          `json:"mark_connected,omitempty" mapstructure:"mark_connected,omitempty"`
+	Mode          *string        `json:"mode,omitempty"`
+	WirelessLANs  []*WirelessLAN `json:"wireless_lans,omitempty" mapstructure:"wireless_lans,omitempty"`
+	Tags          []*Tag         `json:"tags,omitempty"`
 }
 
 func validateInterfaceType(t string) bool {
@@ -534,6 +547,38 @@ func validateInterfaceMode(m string) bool {
 	return ok
 }
 
+// normaliseMACAddress validates a MAC address and returns it in its canonical form (upper case, colon separated),
+// e.g. "aabb.ccdd.eeff", "aa-bb-cc-dd-ee-ff" and "aabbccddeeff" are all normalised to "AA:BB:CC:DD:EE:FF"
+func normaliseMACAddress(mac string) (string, error) {
+	mac = strings.TrimSpace(mac)
+	if len(mac) == 12 && !strings.ContainsAny(mac, ":-.") {
+		parts := make([]string, 0, 6)
+		for i := 0; i < len(mac); i += 2 {
+			parts = append(parts, mac[i:i+2])
+		}
+		mac = strings.Join(parts, ":")
+	}
+
+	hw, err := net.ParseMAC(mac)
+	if err != nil || len(hw) != 6 {
+		return "", ErrInvalidMACAddress
+	}
+
+	return strings.ToUpper(hw.String()), nil
+}
+
+// normaliseMACAddressPtr normalises an optional MAC address
+func normaliseMACAddressPtr(mac *string) (*string, error) {
+	if mac == nil {
+		return nil, nil
+	}
+	normalised, err := normaliseMACAddress(*mac)
+	if err != nil {
+		return nil, err
+	}
+	return &normalised, nil
+}
+
 // Validate checks if the DCIM interface is valid
 func (i *DcimInterface) Validate() error {
 	if i.Type != nil && !validateInterfaceType(*i.Type) {
@@ -542,6 +587,19 @@ func (i *DcimInterface) Validate() error {
 	if i.Mode != nil && !validateInterfaceMode(*i.Mode) {
 		return ErrInvalidInterfaceMode
 	}
+	if i.MACAddress != nil {
+		if _, err := normaliseMACAddress(*i.MACAddress); err != nil {
+			return err
+		}
+	}
+	for _, w := range i.WirelessLANs {
+		if w == nil {
+			continue
+		}
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -592,6 +650,7 @@ func FromProtoInterface(interfacePb *diodepb.Interface) *DcimInterface {
 		Description:   interfacePb.Description,
 		MarkConnected: interfacePb.MarkConnected,
 		Mode:          mode,
+		WirelessLANs:  FromProtoWirelessLANs(interfacePb.WirelessLans),
 		Tags:          FromProtoTags(interfacePb.Tags),
 	}
 }
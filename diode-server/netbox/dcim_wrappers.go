@@ -153,6 +153,17 @@ func (dw *DcimDeviceDataWrapper) ObjectStateQueryParams() map[string]string {
 	return params
 }
 
+// MatchingObjectStateQueryParams returns the query parameters of each key of the matching strategy in order, skipping
+// keys the device has no value for
+func (dw *DcimDeviceDataWrapper) MatchingObjectStateQueryParams() []MatchingQueryParams {
+	return dw.matchingQueryParams(dw.ObjectStateQueryParams(), map[MatchingKey]*string{
+		MatchingKeySerial:     dw.Device.Serial,
+		MatchingKeyAssetTag:   dw.Device.AssetTag,
+		MatchingKeyDeviceFQDN: dw.Device.DeviceFQDN,
+		MatchingKeyPrimaryIP:  dw.Device.PrimaryIPAddress,
+	})
+}
+
 // ID returns the ID of the data
 func (dw *DcimDeviceDataWrapper) ID() int {
 	return dw.Device.ID
@@ -195,7 +206,12 @@ func (dw *DcimDeviceDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 		}
 
 		dw.Device.ID = intended.Device.ID
-		dw.Device.Name = intended.Device.Name
+		dw.enforceFieldOwnership(dw.Device, intended.Device)
+
+		// keep the ingested name of a device matched by another key, so it gets renamed
+		if dw.matchedByName() {
+			dw.Device.Name = intended.Device.Name
+		}
 
 		if dw.Device.Status == nil || *dw.Device.Status == "" {
 			dw.Device.Status = intended.Device.Status
@@ -365,7 +381,7 @@ func (dw *DcimDeviceDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 
 		dw.objectsToReconcile = append(dw.objectsToReconcile, roleObjectsToReconcile...)
 
-		tagsToMerge := mergeTags(dw.Device.Tags, intended.Device.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Device.Tags, intended.Device.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Device.Tags = tagsToMerge
@@ -377,11 +393,17 @@ func (dw *DcimDeviceDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 			}
 		}
 
+		dw.clearFields(dw.Device, intended.Device)
+
+		dw.diffFields(dw.Device, intended.Device)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.Device, nil)
+
 		dw.SetDefaults()
 
 		siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
@@ -466,7 +488,7 @@ func (dw *DcimDeviceDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 
 		dw.objectsToReconcile = append(dw.objectsToReconcile, roleObjectsToReconcile...)
 
-		tagsToMerge := mergeTags(dw.Device.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Device.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Device.Tags = tagsToMerge
@@ -614,6 +636,7 @@ func (dw *DcimDeviceRoleDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 
 	if intended != nil {
 		dw.DeviceRole.ID = intended.DeviceRole.ID
+		dw.enforceFieldOwnership(dw.DeviceRole, intended.DeviceRole)
 		dw.DeviceRole.Name = intended.DeviceRole.Name
 		dw.DeviceRole.Slug = intended.DeviceRole.Slug
 
@@ -625,7 +648,7 @@ func (dw *DcimDeviceRoleDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 			dw.DeviceRole.Description = intended.DeviceRole.Description
 		}
 
-		tagsToMerge := mergeTags(dw.DeviceRole.Tags, intended.DeviceRole.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.DeviceRole.Tags, intended.DeviceRole.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.DeviceRole.Tags = tagsToMerge
@@ -637,14 +660,20 @@ func (dw *DcimDeviceRoleDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 			}
 		}
 
+		dw.clearFields(dw.DeviceRole, intended.DeviceRole)
+
+		dw.diffFields(dw.DeviceRole, intended.DeviceRole)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.DeviceRole, nil)
+
 		dw.SetDefaults()
 
-		tagsToMerge := mergeTags(dw.DeviceRole.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.DeviceRole.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.DeviceRole.Tags = tagsToMerge
@@ -818,6 +847,7 @@ func (dw *DcimDeviceTypeDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 		}
 
 		dw.DeviceType.ID = intended.DeviceType.ID
+		dw.enforceFieldOwnership(dw.DeviceType, intended.DeviceType)
 		dw.DeviceType.Model = intended.DeviceType.Model
 		dw.DeviceType.Slug = intended.DeviceType.Slug
 
@@ -865,7 +895,7 @@ func (dw *DcimDeviceTypeDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 
 		dw.DeviceType.Manufacturer = manufacturer
 
-		tagsToMerge := mergeTags(dw.DeviceType.Tags, intended.DeviceType.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.DeviceType.Tags, intended.DeviceType.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.DeviceType.Tags = tagsToMerge
@@ -879,11 +909,17 @@ func (dw *DcimDeviceTypeDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 
 		dw.objectsToReconcile = append(dw.objectsToReconcile, manufacturerObjectsToReconcile...)
 
+		dw.clearFields(dw.DeviceType, intended.DeviceType)
+
+		dw.diffFields(dw.DeviceType, intended.DeviceType)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.DeviceType, nil)
+
 		manufacturerObjectsToReconcile, manufacturerErr := actualManufacturer.Patch(intendedManufacturer, intendedNestedObjects)
 		if manufacturerErr != nil {
 			return nil, manufacturerErr
@@ -902,7 +938,7 @@ func (dw *DcimDeviceTypeDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 		}
 		dw.DeviceType.Manufacturer = manufacturer
 
-		tagsToMerge := mergeTags(dw.DeviceType.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.DeviceType.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.DeviceType.Tags = tagsToMerge
@@ -928,10 +964,56 @@ func (dw *DcimDeviceTypeDataWrapper) Patch(cmp ComparableData, intendedNestedObj
 // SetDefaults sets the default values for the device type
 func (dw *DcimDeviceTypeDataWrapper) SetDefaults() {}
 
+// MatchDeviceTypeLibrary sets the canonical manufacturer, model, slug and part number of the library device type
+// matching the reported model, keeping the device type as reported when none does
+func (dw *DcimDeviceTypeDataWrapper) MatchDeviceTypeLibrary(lib *DeviceTypeLibrary) {
+	if dw.DeviceType == nil || dw.placeholder {
+		return
+	}
+
+	var manufacturer *DcimManufacturerDataWrapper
+	for _, obj := range dw.nestedObjects {
+		if mw, ok := obj.(*DcimManufacturerDataWrapper); ok && mw.Manufacturer == dw.DeviceType.Manufacturer {
+			manufacturer = mw
+		}
+	}
+
+	var manufacturerName string
+	if dw.DeviceType.Manufacturer != nil && (manufacturer == nil || !manufacturer.placeholder) {
+		manufacturerName = dw.DeviceType.Manufacturer.Name
+	}
+
+	entry := lib.Match(manufacturerName, dw.DeviceType.Model)
+	if entry == nil {
+		return
+	}
+
+	dw.DeviceType.Model = entry.Model
+	dw.DeviceType.Slug = entry.Slug
+	if dw.DeviceType.PartNumber == nil && entry.PartNumber != "" {
+		partNumber := entry.PartNumber
+		dw.DeviceType.PartNumber = &partNumber
+	}
+
+	// the manufacturer is updated in place as its data wrapper, a placeholder when not reported, shares it
+	if dw.DeviceType.Manufacturer != nil {
+		dw.DeviceType.Manufacturer.Name = entry.Manufacturer
+		dw.DeviceType.Manufacturer.Slug = entry.ManufacturerSlug
+	}
+	if manufacturer != nil {
+		manufacturer.placeholder = false
+	}
+}
+
 // DcimInterfaceDataWrapper represents a DCIM interface data wrapper
 type DcimInterfaceDataWrapper struct {
 	BaseDataWrapper
 	Interface *DcimInterface
+
+	typeRules InterfaceTypeRules
+
+	// originalName is the ingested name of an interface renamed per its naming convention without ingested label
+	originalName string
 }
 
 func (*DcimInterfaceDataWrapper) comparableData() {}
@@ -971,6 +1053,11 @@ func (dw *DcimInterfaceDataWrapper) Normalise() {
 	if dw.IsValid() && dw.Interface.Tags != nil && len(dw.Interface.Tags) == 0 {
 		dw.Interface.Tags = nil
 	}
+	if dw.Interface != nil {
+		if macAddress, err := normaliseMACAddressPtr(dw.Interface.MACAddress); err == nil {
+			dw.Interface.MACAddress = macAddress
+		}
+	}
 	dw.intended = true
 }
 
@@ -995,6 +1082,12 @@ func (dw *DcimInterfaceDataWrapper) NestedObjects() ([]ComparableData, error) {
 		dw.placeholder = true
 	}
 
+	macAddress, err := normaliseMACAddressPtr(dw.Interface.MACAddress)
+	if err != nil {
+		return nil, err
+	}
+	dw.Interface.MACAddress = macAddress
+
 	device := DcimDeviceDataWrapper{Device: dw.Interface.Device, BaseDataWrapper: BaseDataWrapper{placeholder: dw.placeholder, hasParent: true, intended: dw.intended}}
 
 	do, err := device.NestedObjects()
@@ -1006,6 +1099,27 @@ func (dw *DcimInterfaceDataWrapper) NestedObjects() ([]ComparableData, error) {
 
 	dw.Interface.Device = device.Device
 
+	if dw.Interface.WirelessLANs != nil {
+		wirelessLANs := make([]*WirelessLAN, 0)
+		for _, w := range dw.Interface.WirelessLANs {
+			if w == nil || w.SSID == "" {
+				continue
+			}
+
+			wirelessLAN := WirelessLANDataWrapper{WirelessLAN: w, BaseDataWrapper: BaseDataWrapper{hasParent: true, intended: dw.intended}}
+
+			wo, err := wirelessLAN.NestedObjects()
+			if err != nil {
+				return nil, err
+			}
+
+			objects = append(objects, wo...)
+
+			wirelessLANs = append(wirelessLANs, wirelessLAN.WirelessLAN)
+		}
+		dw.Interface.WirelessLANs = wirelessLANs
+	}
+
 	if dw.Interface.Tags != nil {
 		for _, t := range dw.Interface.Tags {
 			if t.Slug == "" {
@@ -1042,6 +1156,59 @@ func (dw *DcimInterfaceDataWrapper) ObjectStateQueryParams() map[string]string {
 	return params
 }
 
+// FallbackObjectStateQueryParams returns the query parameters needed to retrieve its object state by MAC address
+func (dw *DcimInterfaceDataWrapper) FallbackObjectStateQueryParams() map[string]string {
+	if dw.Interface.MACAddress == nil {
+		return nil
+	}
+	params := map[string]string{
+		"mac_address": *dw.Interface.MACAddress,
+	}
+	if dw.Interface.Device != nil {
+		params["device__name"] = dw.Interface.Device.Name
+
+		if dw.Interface.Device.Site != nil {
+			params["device__site__name"] = dw.Interface.Device.Site.Name
+		}
+	}
+	return params
+}
+
+// NormaliseInterfaceName canonicalises the interface name per the naming convention of the platform or manufacturer
+// of its device, the ingested name being preserved as its label when patched if it differs and no label is ingested
+// nor set in NetBox
+func (dw *DcimInterfaceDataWrapper) NormaliseInterfaceName(naming *InterfaceNaming) {
+	if dw.Interface == nil {
+		return
+	}
+
+	var platform, manufacturer string
+	if dw.Interface.Device != nil {
+		platform, manufacturer = platformAndManufacturerNames(dw.Interface.Device.Platform, dw.Interface.Device.DeviceType)
+	}
+
+	name := naming.Convention(platform, manufacturer).CanonicalName(dw.Interface.Name)
+	if name == dw.Interface.Name {
+		return
+	}
+
+	if dw.Interface.Label == nil {
+		dw.originalName = dw.Interface.Name
+	}
+	dw.Interface.Name = name
+}
+
+// labelWithOriginalName labels an interface renamed per its naming convention with its ingested name, unless it has a
+// label or its label is never touched
+func (dw *DcimInterfaceDataWrapper) labelWithOriginalName() {
+	if dw.originalName == "" || (dw.Interface.Label != nil && *dw.Interface.Label != "") || dw.fieldOwnership["label"] == FieldOwnershipNeverTouch {
+		return
+	}
+
+	label := dw.originalName
+	dw.Interface.Label = &label
+}
+
 // ID returns the ID of the data
 func (dw *DcimInterfaceDataWrapper) ID() int {
 	return dw.Interface.ID
@@ -1058,6 +1225,16 @@ func (dw *DcimInterfaceDataWrapper) hash() string {
 	return slug.Make(fmt.Sprintf("%s-%s-%s", dw.Interface.Name, deviceName, siteName))
 }
 
+// matchesByMACAddress returns true if the intended interface has the same MAC address and belongs to the same device,
+// i.e. it's the same interface under a different name
+func (dw *DcimInterfaceDataWrapper) matchesByMACAddress(intended *DcimInterfaceDataWrapper) bool {
+	if dw.Interface.MACAddress == nil || intended.Interface.MACAddress == nil || *dw.Interface.MACAddress != *intended.Interface.MACAddress {
+		return false
+	}
+	renamed := &DcimInterfaceDataWrapper{Interface: &DcimInterface{Name: intended.Interface.Name, Device: dw.Interface.Device}}
+	return renamed.hash() == intended.hash()
+}
+
 // Patch creates patches between the actual, intended and current data
 func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
 	intended, ok := cmp.(*DcimInterfaceDataWrapper)
@@ -1075,7 +1252,7 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 
 	reconciliationRequired := true
 
-	if intended != nil && dw.hash() == intended.hash() {
+	if intended != nil && (dw.hash() == intended.hash() || dw.matchesByMACAddress(intended)) {
 		currentNestedObjectsMap := make(map[string]ComparableData)
 		currentNestedObjects, err := intended.NestedObjects()
 		if err != nil {
@@ -1085,8 +1262,18 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 			currentNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
 		}
 
+		// keep the ingested name of an interface matched by MAC address, so it gets renamed
+		if dw.hash() == intended.hash() {
+			dw.Interface.Name = intended.Interface.Name
+		}
 		dw.Interface.ID = intended.Interface.ID
-		dw.Interface.Name = intended.Interface.Name
+
+		// replace the default type of interfaces created before their type could be inferred
+		if dw.Interface.Type == nil && (intended.Interface.Type == nil || *intended.Interface.Type == DefaultInterfaceType) {
+			dw.Interface.Type = dw.typeRules.Infer(dw.Interface.Name, dw.Interface.Speed, dw.Interface.MTU)
+		}
+
+		dw.enforceFieldOwnership(dw.Interface, intended.Interface)
 
 		if actualDevice.IsPlaceholder() && intended.Interface.Device != nil {
 			intendedDevice = extractFromObjectsMap(currentNestedObjectsMap, fmt.Sprintf("%p", intended.Interface.Device))
@@ -1125,6 +1312,7 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 		if dw.Interface.Label == nil {
 			dw.Interface.Label = intended.Interface.Label
 		}
+		dw.labelWithOriginalName()
 
 		if dw.Interface.Type == nil {
 			dw.Interface.Type = intended.Interface.Type
@@ -1166,7 +1354,17 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 			dw.Interface.Mode = intended.Interface.Mode
 		}
 
-		tagsToMerge := mergeTags(dw.Interface.Tags, intended.Interface.Tags, intendedNestedObjects)
+		wirelessLANs, intendedWirelessLANs, wirelessLANsObjectsToReconcile, err := patchWirelessLANs(dw.Interface.WirelessLANs, intended.Interface.WirelessLANs, actualNestedObjectsMap, intendedNestedObjects)
+		if err != nil {
+			return nil, err
+		}
+
+		dw.Interface.WirelessLANs = wirelessLANs
+		intended.Interface.WirelessLANs = intendedWirelessLANs
+
+		dw.objectsToReconcile = append(dw.objectsToReconcile, wirelessLANsObjectsToReconcile...)
+
+		tagsToMerge := dw.mergeTags(dw.Interface.Tags, intended.Interface.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Interface.Tags = tagsToMerge
@@ -1178,12 +1376,19 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 			}
 		}
 
+		dw.clearFields(dw.Interface, intended.Interface)
+
+		dw.diffFields(dw.Interface, intended.Interface)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.Interface, nil)
+
 		dw.SetDefaults()
+		dw.labelWithOriginalName()
 
 		deviceObjectsToReconcile, deviceErr := actualDevice.Patch(intendedDevice, intendedNestedObjects)
 		if deviceErr != nil {
@@ -1203,7 +1408,16 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 		}
 		dw.Interface.Device = device
 
-		tagsToMerge := mergeTags(dw.Interface.Tags, nil, intendedNestedObjects)
+		wirelessLANs, _, wirelessLANsObjectsToReconcile, err := patchWirelessLANs(dw.Interface.WirelessLANs, nil, actualNestedObjectsMap, intendedNestedObjects)
+		if err != nil {
+			return nil, err
+		}
+
+		dw.Interface.WirelessLANs = wirelessLANs
+
+		dw.objectsToReconcile = append(dw.objectsToReconcile, wirelessLANsObjectsToReconcile...)
+
+		tagsToMerge := dw.mergeTags(dw.Interface.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Interface.Tags = tagsToMerge
@@ -1228,11 +1442,19 @@ func (dw *DcimInterfaceDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 
 // SetDefaults sets the default values for the interface
 func (dw *DcimInterfaceDataWrapper) SetDefaults() {
+	if dw.Interface.Type == nil {
+		dw.Interface.Type = dw.typeRules.Infer(dw.Interface.Name, dw.Interface.Speed, dw.Interface.MTU)
+	}
 	if dw.Interface.Type == nil {
 		dw.Interface.Type = &DefaultInterfaceType
 	}
 }
 
+// SetInterfaceTypeRules sets the rules inferring the interface type when not ingested
+func (dw *DcimInterfaceDataWrapper) SetInterfaceTypeRules(rules InterfaceTypeRules) {
+	dw.typeRules = rules
+}
+
 // DcimManufacturerDataWrapper represents a DCIM manufacturer data wrapper
 type DcimManufacturerDataWrapper struct {
 	BaseDataWrapper
@@ -1342,6 +1564,7 @@ func (dw *DcimManufacturerDataWrapper) Patch(cmp ComparableData, intendedNestedO
 
 	if intended != nil {
 		dw.Manufacturer.ID = intended.Manufacturer.ID
+		dw.enforceFieldOwnership(dw.Manufacturer, intended.Manufacturer)
 		dw.Manufacturer.Name = intended.Manufacturer.Name
 		dw.Manufacturer.Slug = intended.Manufacturer.Slug
 
@@ -1349,18 +1572,24 @@ func (dw *DcimManufacturerDataWrapper) Patch(cmp ComparableData, intendedNestedO
 			dw.Manufacturer.Description = intended.Manufacturer.Description
 		}
 
-		tagsToMerge := mergeTags(dw.Manufacturer.Tags, intended.Manufacturer.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Manufacturer.Tags, intended.Manufacturer.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Manufacturer.Tags = tagsToMerge
 		}
 
+		dw.clearFields(dw.Manufacturer, intended.Manufacturer)
+
+		dw.diffFields(dw.Manufacturer, intended.Manufacturer)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
-		tagsToMerge := mergeTags(dw.Manufacturer.Tags, nil, intendedNestedObjects)
+		dw.enforceFieldOwnership(dw.Manufacturer, nil)
+
+		tagsToMerge := dw.mergeTags(dw.Manufacturer.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Manufacturer.Tags = tagsToMerge
@@ -1562,6 +1791,7 @@ func (dw *DcimPlatformDataWrapper) Patch(cmp ComparableData, intendedNestedObjec
 		}
 
 		dw.Platform.ID = intended.Platform.ID
+		dw.enforceFieldOwnership(dw.Platform, intended.Platform)
 		dw.Platform.Name = intended.Platform.Name
 		dw.Platform.Slug = intended.Platform.Slug
 
@@ -1616,7 +1846,7 @@ func (dw *DcimPlatformDataWrapper) Patch(cmp ComparableData, intendedNestedObjec
 			dw.Platform.Description = intended.Platform.Description
 		}
 
-		tagsToMerge := mergeTags(dw.Platform.Tags, intended.Platform.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Platform.Tags, intended.Platform.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Platform.Tags = tagsToMerge
@@ -1628,11 +1858,17 @@ func (dw *DcimPlatformDataWrapper) Patch(cmp ComparableData, intendedNestedObjec
 			}
 		}
 
+		dw.clearFields(dw.Platform, intended.Platform)
+
+		dw.diffFields(dw.Platform, intended.Platform)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.Platform, nil)
+
 		if actualManufacturer != nil {
 			manufacturerObjectsToReconcile, manufacturerErr := actualManufacturer.Patch(intendedManufacturer, intendedNestedObjects)
 			if manufacturerErr != nil {
@@ -1655,7 +1891,7 @@ func (dw *DcimPlatformDataWrapper) Patch(cmp ComparableData, intendedNestedObjec
 			dw.objectsToReconcile = append(dw.objectsToReconcile, manufacturerObjectsToReconcile...)
 		}
 
-		tagsToMerge := mergeTags(dw.Platform.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Platform.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Platform.Tags = tagsToMerge
@@ -1792,6 +2028,7 @@ func (dw *DcimSiteDataWrapper) Patch(cmp ComparableData, intendedNestedObjects m
 
 	if intended != nil {
 		dw.Site.ID = intended.Site.ID
+		dw.enforceFieldOwnership(dw.Site, intended.Site)
 		dw.Site.Name = intended.Site.Name
 		dw.Site.Slug = intended.Site.Slug
 
@@ -1815,7 +2052,7 @@ func (dw *DcimSiteDataWrapper) Patch(cmp ComparableData, intendedNestedObjects m
 			dw.Site.Comments = intended.Site.Comments
 		}
 
-		tagsToMerge := mergeTags(dw.Site.Tags, intended.Site.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Site.Tags, intended.Site.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Site.Tags = tagsToMerge
@@ -1827,14 +2064,20 @@ func (dw *DcimSiteDataWrapper) Patch(cmp ComparableData, intendedNestedObjects m
 			}
 		}
 
+		dw.clearFields(dw.Site, intended.Site)
+
+		dw.diffFields(dw.Site, intended.Site)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.Site, nil)
+
 		dw.SetDefaults()
 
-		tagsToMerge := mergeTags(dw.Site.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Site.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Site.Tags = tagsToMerge
@@ -1862,3 +2105,35 @@ func (dw *DcimSiteDataWrapper) SetDefaults() {
 		dw.Site.Status = &status
 	}
 }
+
+// patchNestedInterface patches an interface nested in another object and returns the interface to reference from it
+func patchNestedInterface(actual ComparableData, intended ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, *DcimInterface, error) {
+	objectsToReconcile, err := actual.Patch(intended, intendedNestedObjects)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interf, err := copyData(actual.Data().(*DcimInterface))
+	if err != nil {
+		return nil, nil, err
+	}
+	interf.Tags = nil
+
+	if !actual.HasChanged() {
+		interf = nestedInterfaceRef(actual.Data().(*DcimInterface))
+	}
+
+	return objectsToReconcile, interf, nil
+}
+
+func nestedInterfaceRef(interf *DcimInterface) *DcimInterface {
+	ref := &DcimInterface{
+		ID: interf.ID,
+	}
+	if interf.Device != nil {
+		ref.Device = &DcimDevice{
+			ID: interf.Device.ID,
+		}
+	}
+	return ref
+}
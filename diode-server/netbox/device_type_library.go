@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gosimple/slug"
+)
+
+// DeviceTypeLibraryEntry is a device type of a device type library, e.g. the NetBox community devicetype-library
+type DeviceTypeLibraryEntry struct {
+	Manufacturer     string
+	ManufacturerSlug string
+	Model            string
+	Slug             string
+	PartNumber       string
+}
+
+// DeviceTypeLibrary matches reported device type models and part numbers to the canonical device types of a library
+type DeviceTypeLibrary struct {
+	entries []*DeviceTypeLibraryEntry
+
+	// keys are models, part numbers and slugs normalised by deviceTypeLibraryKey
+	byKey map[string][]*DeviceTypeLibraryEntry
+
+	// keys are part numbers without their leading segment (e.g. C3850-48P for WS-C3850-48P)
+	byShortPartNumber map[string][]*DeviceTypeLibraryEntry
+}
+
+// DeviceTypeLibraryMatcher is implemented by data wrappers of device types which can be mapped to the canonical
+// device type of a library
+type DeviceTypeLibraryMatcher interface {
+	// MatchDeviceTypeLibrary sets the canonical manufacturer, model, slug and part number of the library device type
+	// matching the device type, if any
+	MatchDeviceTypeLibrary(*DeviceTypeLibrary)
+}
+
+// NewDeviceTypeLibrary creates a device type library, slugs of entries without one are made from their manufacturer
+// and model, manufacturer slugs from their manufacturer
+func NewDeviceTypeLibrary(entries []DeviceTypeLibraryEntry) *DeviceTypeLibrary {
+	lib := &DeviceTypeLibrary{
+		byKey:             make(map[string][]*DeviceTypeLibraryEntry),
+		byShortPartNumber: make(map[string][]*DeviceTypeLibraryEntry),
+	}
+
+	for i := range entries {
+		entry := entries[i]
+		if entry.Manufacturer == "" || entry.Model == "" {
+			continue
+		}
+		if entry.ManufacturerSlug == "" {
+			entry.ManufacturerSlug = slug.Make(entry.Manufacturer)
+		}
+		if entry.Slug == "" {
+			entry.Slug = entry.ManufacturerSlug + "-" + slug.Make(entry.Model)
+		}
+		lib.entries = append(lib.entries, &entry)
+
+		keys := []string{entry.Model, entry.PartNumber, entry.Slug, strings.TrimPrefix(entry.Slug, entry.ManufacturerSlug+"-")}
+		for _, key := range keys {
+			lib.byKey[deviceTypeLibraryKey(key)] = appendEntry(lib.byKey[deviceTypeLibraryKey(key)], &entry)
+		}
+
+		if _, shortPartNumber, ok := strings.Cut(entry.PartNumber, "-"); ok {
+			key := deviceTypeLibraryKey(shortPartNumber)
+			lib.byShortPartNumber[key] = appendEntry(lib.byShortPartNumber[key], &entry)
+		}
+	}
+
+	delete(lib.byKey, "")
+	delete(lib.byShortPartNumber, "")
+
+	return lib
+}
+
+// Len returns the number of device types of the library
+func (lib *DeviceTypeLibrary) Len() int {
+	return len(lib.entries)
+}
+
+// Match returns the library device type of a reported model or part number, of the given manufacturer unless empty,
+// nil if none or several device types match
+func (lib *DeviceTypeLibrary) Match(manufacturer string, model string) *DeviceTypeLibraryEntry {
+	if lib == nil {
+		return nil
+	}
+
+	keys := []string{deviceTypeLibraryKey(model)}
+	if manufacturerKey := deviceTypeLibraryKey(manufacturer); manufacturerKey != "" && strings.HasPrefix(keys[0], manufacturerKey) {
+		// e.g. "Cisco WS-C3850-48P"
+		keys = append(keys, strings.TrimPrefix(keys[0], manufacturerKey))
+	}
+
+	for _, index := range []map[string][]*DeviceTypeLibraryEntry{lib.byKey, lib.byShortPartNumber} {
+		for _, key := range keys {
+			if entry := onlyEntryOf(index[key], manufacturer); entry != nil {
+				return entry
+			}
+		}
+	}
+
+	return nil
+}
+
+func onlyEntryOf(entries []*DeviceTypeLibraryEntry, manufacturer string) *DeviceTypeLibraryEntry {
+	var match *DeviceTypeLibraryEntry
+	for _, entry := range entries {
+		if manufacturer != "" && deviceTypeLibraryKey(entry.Manufacturer) != deviceTypeLibraryKey(manufacturer) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = entry
+	}
+	return match
+}
+
+func appendEntry(entries []*DeviceTypeLibraryEntry, entry *DeviceTypeLibraryEntry) []*DeviceTypeLibraryEntry {
+	for _, e := range entries {
+		if e == entry {
+			return entries
+		}
+	}
+	return append(entries, entry)
+}
+
+// deviceTypeLibraryKey normalises a model, part number or slug to its lower cased letters and digits, e.g.
+// "Catalyst 3850-48P" and "catalyst-3850-48p" are both normalised to "catalyst385048p"
+func deviceTypeLibraryKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
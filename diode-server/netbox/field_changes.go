@@ -0,0 +1,118 @@
+package netbox
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+)
+
+// FieldChange is a changed field of an object, named as in the NetBox API, with its JSON values before and after the
+// change, null when not set. Nested objects found in NetBox are referenced by their ID and tags by their names.
+type FieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// FieldDiffer is implemented by data wrappers recording the fields changed when patched
+type FieldDiffer interface {
+	// ChangedFields returns the fields changed when patched with their values before and after
+	ChangedFields() []FieldChange
+}
+
+// ChangedFields returns the fields changed when patched with their values before and after
+func (bw *BaseDataWrapper) ChangedFields() []FieldChange {
+	return bw.changedFields
+}
+
+// diffFields records the fields of the patched object (actual) changed from the object in NetBox (intended)
+func (bw *BaseDataWrapper) diffFields(actual any, intended any) {
+	bw.changedFields = DiffFields(intended, actual)
+}
+
+// DiffFields returns the fields changed from an object (before) to another (after) of the same type, before being nil
+// for an object to create
+func DiffFields(before any, after any) []FieldChange {
+	av := reflect.ValueOf(after)
+	if av.Kind() != reflect.Pointer || av.IsNil() {
+		return nil
+	}
+	av = av.Elem()
+
+	var bv reflect.Value
+	if v := reflect.ValueOf(before); v.Kind() == reflect.Pointer && !v.IsNil() {
+		bv = v.Elem()
+	}
+
+	var changes []FieldChange
+	for i := 0; i < av.NumField(); i++ {
+		name := FieldName(av.Type().Field(i))
+		if name == "id" || name == "-" {
+			continue
+		}
+
+		var beforeValue json.RawMessage
+		if bv.IsValid() {
+			beforeValue = fieldValue(name, bv.Field(i))
+		}
+		afterValue := fieldValue(name, av.Field(i))
+
+		if slices.Equal(beforeValue, afterValue) {
+			continue
+		}
+
+		changes = append(changes, FieldChange{Field: name, Before: beforeValue, After: afterValue})
+	}
+
+	return changes
+}
+
+// fieldValue returns the JSON value of a field, nil if not set, nested objects being replaced by references to their
+// IDs and tags by their sorted names
+func fieldValue(name string, v reflect.Value) json.RawMessage {
+	if emptyValue(v) && v.Kind() != reflect.Bool {
+		return nil
+	}
+
+	if tags, ok := v.Interface().([]*Tag); ok && name == TagsFieldName {
+		names := make([]string, 0, len(tags))
+		for _, t := range tags {
+			names = append(names, t.Name)
+		}
+		slices.Sort(names)
+		b, _ := json.Marshal(names)
+		return b
+	}
+
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil
+	}
+
+	// re-encoding the decoded value sorts the keys of objects
+	b, _ = json.Marshal(ObjectReference(value))
+	return b
+}
+
+// ObjectReference returns a reference to the ID of a nested object decoded from JSON, looking for nested objects in the
+// values of objects without ID (e.g. assigned objects)
+func ObjectReference(value any) any {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	if id, ok := obj["id"]; ok {
+		return map[string]any{"id": id}
+	}
+
+	for k, v := range obj {
+		obj[k] = ObjectReference(v)
+	}
+	return obj
+}
@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// TagsFieldName is the name of the tags field of objects in the NetBox API
+const TagsFieldName = "tags"
+
+// nullableTextFields are the text fields NetBox stores as null rather than as an empty string when cleared
+var nullableTextFields = map[string]struct{}{
+	"asset_tag":   {},
+	"mac_address": {},
+	"wwn":         {},
+}
+
+// clearableFields are the optional fields of each object type, named as in the NetBox API, which can be cleared on top
+// of tags. Fields NetBox requires (e.g. the type of an interface) or defaults when not set (e.g. statuses and booleans)
+// aren't.
+var clearableFields = map[string][]string{
+	DcimDeviceObjectType:                   {"platform", "serial", "description", "asset_tag", "primary_ip4", "primary_ip6", "comments"},
+	DcimDeviceRoleObjectType:               {"description"},
+	DcimDeviceTypeObjectType:               {"description", "comments", "part_number"},
+	DcimInterfaceObjectType:                {"label", "mtu", "mac_address", "speed", "wwn", "description", "mode"},
+	DcimManufacturerObjectType:             {"description"},
+	DcimPlatformObjectType:                 {"manufacturer", "description"},
+	DcimSiteObjectType:                     {"facility", "time_zone", "description", "comments"},
+	IpamIPAddressObjectType:                {"role", "dns_name", "description", "comments"},
+	IpamPrefixObjectType:                   {"site", "description", "comments"},
+	IpamFHRPGroupObjectType:                {"name", "auth_type", "auth_key", "description", "comments"},
+	VirtualizationClusterGroupObjectType:   {"description"},
+	VirtualizationClusterTypeObjectType:    {"description"},
+	VirtualizationClusterObjectType:        {"group", "site", "description"},
+	VirtualizationVirtualMachineObjectType: {"site", "cluster", "role", "device", "platform", "primary_ip4", "primary_ip6", "vcpus", "memory", "disk", "description", "comments"},
+	VirtualizationVMInterfaceObjectType:    {"mtu", "mac_address", "description"},
+	VirtualizationVirtualDiskObjectType:    {"description"},
+	WirelessLANObjectType:                  {"group", "auth_type", "auth_cipher", "auth_psk", "description", "comments"},
+	WirelessLANGroupObjectType:             {"description"},
+	WirelessLinkObjectType:                 {"ssid", "auth_type", "auth_cipher", "auth_psk", "distance", "distance_unit", "description", "comments"},
+}
+
+// FieldClearer is implemented by data wrappers clearing fields of their object when patched
+type FieldClearer interface {
+	// SetFieldsToClear sets the fields to clear, named as in the NetBox API, and the names of the tags to remove
+	SetFieldsToClear(fields []string, tags []string)
+
+	// ClearedFields returns the fields cleared when patched, text fields being emptied, tags removed and other fields
+	// (and text fields NetBox stores as null) set to nil
+	ClearedFields() []string
+}
+
+// SetFieldsToClear sets the fields to clear, named as in the NetBox API, and the names of the tags to remove
+func (bw *BaseDataWrapper) SetFieldsToClear(fields []string, tags []string) {
+	bw.fieldsToClear = fields
+	bw.tagsToRemove = tags
+}
+
+// ClearedFields returns the fields cleared when patched, text fields being emptied, tags removed and other fields set
+// to nil
+func (bw *BaseDataWrapper) ClearedFields() []string {
+	return bw.clearedFields
+}
+
+// clearFields clears the fields of actual to clear which are set in intended and removes the tags to remove, fields
+// the field ownership policy doesn't allow to be overwritten being left untouched
+func (bw *BaseDataWrapper) clearFields(actual any, intended any) {
+	if len(bw.fieldsToClear) == 0 && len(bw.tagsToRemove) == 0 {
+		return
+	}
+
+	av := reflect.ValueOf(actual).Elem()
+	iv := reflect.ValueOf(intended).Elem()
+
+	for i := 0; i < av.NumField(); i++ {
+		field := av.Type().Field(i)
+		name := FieldName(field)
+
+		if name == TagsFieldName {
+			bw.removeTags(av.Field(i))
+			continue
+		}
+
+		if !slices.Contains(bw.fieldsToClear, name) || field.Type.Kind() != reflect.Pointer || emptyValue(iv.Field(i)) {
+			continue
+		}
+
+		if ownership, ok := bw.fieldOwnership[name]; ok && ownership != FieldOwnershipAuthoritative {
+			if !slices.Contains(bw.skippedFields, name) {
+				bw.skippedFields = append(bw.skippedFields, name)
+			}
+			continue
+		}
+
+		if _, nullable := nullableTextFields[name]; !nullable && field.Type.Elem().Kind() == reflect.String {
+			av.Field(i).Set(reflect.New(field.Type.Elem()))
+		} else {
+			av.Field(i).Set(reflect.Zero(field.Type))
+		}
+		bw.clearedFields = append(bw.clearedFields, name)
+	}
+}
+
+// removeTags removes the tags to remove, or all of them when the tags are to clear
+func (bw *BaseDataWrapper) removeTags(v reflect.Value) {
+	tags, ok := v.Interface().([]*Tag)
+	if !ok || len(tags) == 0 {
+		return
+	}
+
+	clearTags := slices.Contains(bw.fieldsToClear, TagsFieldName)
+
+	kept := make([]*Tag, 0, len(tags))
+	for _, t := range tags {
+		if clearTags || slices.Contains(bw.tagsToRemove, t.Name) {
+			continue
+		}
+		kept = append(kept, t)
+	}
+	if len(kept) == len(tags) {
+		return
+	}
+
+	v.Set(reflect.ValueOf(kept))
+	if len(kept) == 0 {
+		bw.clearedFields = append(bw.clearedFields, TagsFieldName)
+	}
+}
+
+// ValidateFieldsToClear validates the fields to clear of an object type, only its clearable optional fields and tags
+// can be cleared
+func ValidateFieldsToClear(objectType string, fields []string) error {
+	dw, err := NewDataWrapper(objectType)
+	if err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(dw.Data()).Elem()
+
+	fieldTypes := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		// fields not sent to NetBox
+		if FieldName(t.Field(i)) == "-" {
+			continue
+		}
+		fieldTypes[FieldName(t.Field(i))] = t.Field(i).Type
+	}
+
+	for _, name := range fields {
+		fieldType, ok := fieldTypes[name]
+		if !ok || name == "id" {
+			return fmt.Errorf("unknown field %s of %s", name, objectType)
+		}
+
+		if name == TagsFieldName {
+			continue
+		}
+
+		if fieldType.Kind() != reflect.Pointer || !slices.Contains(clearableFields[objectType], name) {
+			return fmt.Errorf("field %s of %s is required and can't be cleared", name, objectType)
+		}
+	}
+
+	return nil
+}
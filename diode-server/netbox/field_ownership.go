@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldOwnership decides whether ingested values may overwrite a field of an object
+type FieldOwnership string
+
+const (
+	// FieldOwnershipAuthoritative lets ingested values overwrite the field, this is the default ownership
+	FieldOwnershipAuthoritative FieldOwnership = "authoritative"
+
+	// FieldOwnershipFillIfEmpty only sets the field when it's empty in NetBox
+	FieldOwnershipFillIfEmpty FieldOwnership = "fill_if_empty"
+
+	// FieldOwnershipNeverTouch never sets the field
+	FieldOwnershipNeverTouch FieldOwnership = "never_touch"
+)
+
+// FieldOwnershipPolicy maps fields of an object type, named as in the NetBox API, to their ownership
+type FieldOwnershipPolicy map[string]FieldOwnership
+
+// FieldOwnershipEnforcer is implemented by data wrappers enforcing a field ownership policy when patched
+type FieldOwnershipEnforcer interface {
+	// SetFieldOwnershipPolicy sets the field ownership policy
+	SetFieldOwnershipPolicy(FieldOwnershipPolicy)
+
+	// SkippedFields returns the fields left untouched because of the field ownership policy
+	SkippedFields() []string
+}
+
+// SetFieldOwnershipPolicy sets the field ownership policy
+func (bw *BaseDataWrapper) SetFieldOwnershipPolicy(policy FieldOwnershipPolicy) {
+	bw.fieldOwnership = policy
+}
+
+// SkippedFields returns the fields left untouched because of the field ownership policy
+func (bw *BaseDataWrapper) SkippedFields() []string {
+	return bw.skippedFields
+}
+
+// enforceFieldOwnership resets the fields of actual which the field ownership policy doesn't allow to be set to
+// their intended value, intended being nil when the object is to be created
+func (bw *BaseDataWrapper) enforceFieldOwnership(actual any, intended any) {
+	if len(bw.fieldOwnership) == 0 {
+		return
+	}
+
+	av := reflect.ValueOf(actual).Elem()
+
+	var iv reflect.Value
+	if intendedValue := reflect.ValueOf(intended); intendedValue.Kind() == reflect.Pointer && !intendedValue.IsNil() {
+		iv = intendedValue.Elem()
+	}
+
+	for i := 0; i < av.NumField(); i++ {
+		field := av.Type().Field(i)
+
+		ownership, ok := bw.fieldOwnership[FieldName(field)]
+		if !ok || ownership == FieldOwnershipAuthoritative || !ownableField(field.Type) {
+			continue
+		}
+
+		current := reflect.Zero(field.Type)
+		if iv.IsValid() {
+			current = iv.Field(i)
+		}
+
+		if ownership == FieldOwnershipFillIfEmpty && emptyValue(current) {
+			continue
+		}
+
+		if reflect.DeepEqual(av.Field(i).Interface(), current.Interface()) {
+			continue
+		}
+
+		av.Field(i).Set(current)
+		bw.skippedFields = append(bw.skippedFields, FieldName(field))
+	}
+}
+
+// ValidateFieldOwnershipPolicy validates a field ownership policy for an object type
+func ValidateFieldOwnershipPolicy(objectType string, policy FieldOwnershipPolicy) error {
+	dw, err := NewDataWrapper(objectType)
+	if err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(dw.Data()).Elem()
+
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		// fields not sent to NetBox
+		if FieldName(t.Field(i)) == "-" {
+			continue
+		}
+		fields[FieldName(t.Field(i))] = t.Field(i).Type
+	}
+
+	for name, ownership := range policy {
+		switch ownership {
+		case FieldOwnershipAuthoritative, FieldOwnershipFillIfEmpty, FieldOwnershipNeverTouch:
+		default:
+			return fmt.Errorf("invalid ownership %q for field %s of %s", ownership, name, objectType)
+		}
+
+		fieldType, ok := fields[name]
+		if !ok || name == "id" {
+			return fmt.Errorf("unknown field %s of %s", name, objectType)
+		}
+
+		if !ownableField(fieldType) {
+			return fmt.Errorf("field %s of %s is a nested object and can't have an ownership", name, objectType)
+		}
+	}
+
+	return nil
+}
+
+// FieldName returns the JSON name of a struct field, its Go name if it has none
+func FieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// ownableField returns true for fields holding a value rather than a nested object
+func ownableField(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func emptyValue(v reflect.Value) bool {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	return v.IsZero()
+}
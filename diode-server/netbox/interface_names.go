@@ -0,0 +1,194 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// InterfaceNamingConvention is a vendor naming convention interface names are canonicalised to
+type InterfaceNamingConvention string
+
+const (
+	// InterfaceNamingCiscoIOS expands Cisco IOS, IOS-XE and IOS-XR abbreviations, e.g. Gi0/1 to GigabitEthernet0/1
+	InterfaceNamingCiscoIOS InterfaceNamingConvention = "cisco_ios"
+
+	// InterfaceNamingCiscoNXOS expands Cisco NX-OS abbreviations, e.g. Eth1/1 to Ethernet1/1
+	InterfaceNamingCiscoNXOS InterfaceNamingConvention = "cisco_nxos"
+
+	// InterfaceNamingJunos lower cases Junos interface types, e.g. GE-0/0/0 to ge-0/0/0
+	InterfaceNamingJunos InterfaceNamingConvention = "junos"
+
+	// InterfaceNamingAristaEOS expands Arista EOS abbreviations, e.g. Et1 to Ethernet1
+	InterfaceNamingAristaEOS InterfaceNamingConvention = "arista_eos"
+)
+
+// interfaceTypeNames maps the interface types of each naming convention, lower cased and abbreviated or not, to their
+// canonical name
+var interfaceTypeNames = map[InterfaceNamingConvention]map[string]string{
+	InterfaceNamingCiscoIOS: canonicalInterfaceTypeNames(map[string][]string{
+		"FastEthernet":         {"fa", "fas", "fast"},
+		"GigabitEthernet":      {"gi", "gig", "gige"},
+		"TwoGigabitEthernet":   {"tw", "two", "twogige"},
+		"FiveGigabitEthernet":  {"fi", "five", "fivegige"},
+		"TenGigabitEthernet":   {"te", "ten", "tengige"},
+		"TwentyFiveGigE":       {"twe", "twentyfivegigabitethernet"},
+		"FortyGigabitEthernet": {"fo", "forty", "fortygige"},
+		"HundredGigE":          {"hu", "hundredgigabitethernet"},
+		"Ethernet":             {"e", "et", "eth"},
+		"Port-channel":         {"po", "portchannel"},
+		"Bundle-Ether":         {"be"},
+		"Loopback":             {"lo", "loop"},
+		"Vlan":                 {"vl"},
+		"Tunnel":               {"tu", "tun"},
+		"Serial":               {"se", "ser"},
+		"MgmtEth":              {"mg"},
+		"BDI":                  {},
+		"nve":                  {},
+	}),
+	InterfaceNamingCiscoNXOS: canonicalInterfaceTypeNames(map[string][]string{
+		"Ethernet":     {"e", "et", "eth"},
+		"port-channel": {"po", "portchannel"},
+		"loopback":     {"lo"},
+		"Vlan":         {"vl"},
+		"Tunnel":       {"tu"},
+		"mgmt":         {"ma", "management"},
+		"nve":          {},
+	}),
+	InterfaceNamingJunos: canonicalInterfaceTypeNames(map[string][]string{
+		"fe":   {},
+		"ge":   {},
+		"xe":   {},
+		"et":   {},
+		"ae":   {},
+		"lo":   {},
+		"irb":  {},
+		"em":   {},
+		"fxp":  {},
+		"me":   {},
+		"vme":  {},
+		"reth": {},
+		"st":   {},
+		"gr":   {},
+		"ip":   {},
+	}),
+	InterfaceNamingAristaEOS: canonicalInterfaceTypeNames(map[string][]string{
+		"Ethernet":       {"e", "et", "eth"},
+		"Management":     {"ma", "mgmt"},
+		"Port-Channel":   {"po", "portchannel"},
+		"Loopback":       {"lo"},
+		"Vlan":           {"vl"},
+		"Vxlan":          {"vx"},
+		"Tunnel":         {"tu"},
+		"Recirc-Channel": {"rc"},
+	}),
+}
+
+func canonicalInterfaceTypeNames(abbreviations map[string][]string) map[string]string {
+	names := make(map[string]string)
+	for name, abbrs := range abbreviations {
+		names[strings.ToLower(name)] = name
+		for _, abbr := range abbrs {
+			names[abbr] = name
+		}
+	}
+	return names
+}
+
+// ValidateInterfaceNamingConvention validates an interface naming convention
+func ValidateInterfaceNamingConvention(convention InterfaceNamingConvention) error {
+	if _, ok := interfaceTypeNames[convention]; !ok {
+		return fmt.Errorf("unknown interface naming convention %q", convention)
+	}
+	return nil
+}
+
+// CanonicalName returns the canonical form of an interface name, the name itself if its interface type is unknown
+// to the naming convention, e.g. "gi0/1", "Gi 0/1" and "GigabitEthernet0/1" are all canonicalised to
+// "GigabitEthernet0/1" by the Cisco IOS naming convention
+func (c InterfaceNamingConvention) CanonicalName(name string) string {
+	names, ok := interfaceTypeNames[c]
+	if !ok {
+		return name
+	}
+
+	name = strings.TrimSpace(name)
+
+	// the interface type is the leading run of letters (and dashes, as in Port-channel), followed by its number,
+	// optionally separated by spaces or, in Junos names, a dash
+	i := strings.IndexFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && r != '-' })
+	if i <= 0 {
+		return name
+	}
+	interfaceType := strings.TrimRight(name[:i], "-")
+	separator := name[len(interfaceType):i]
+	number := strings.TrimLeft(name[i:], " ")
+	if number == "" || !unicode.IsDigit(rune(number[0])) {
+		return name
+	}
+
+	canonical, ok := names[strings.ToLower(interfaceType)]
+	if !ok {
+		return name
+	}
+
+	return canonical + separator + number
+}
+
+// InterfaceNaming selects the naming convention interface names are canonicalised to by the platform or manufacturer
+// of their device or virtual machine, matched by name case-insensitively, the platform taking precedence over the
+// manufacturer and the manufacturer over the default
+type InterfaceNaming struct {
+	Default       InterfaceNamingConvention
+	Platforms     map[string]InterfaceNamingConvention
+	Manufacturers map[string]InterfaceNamingConvention
+}
+
+// InterfaceNameNormaliser is implemented by data wrappers of interfaces whose names can be canonicalised
+type InterfaceNameNormaliser interface {
+	// NormaliseInterfaceName canonicalises the interface name, preserving the ingested name as its label when it
+	// differs, the interface has a label field and no label is ingested nor set in NetBox
+	NormaliseInterfaceName(*InterfaceNaming)
+}
+
+// Convention returns the naming convention of interfaces of a platform and manufacturer, empty if none applies
+func (n *InterfaceNaming) Convention(platform, manufacturer string) InterfaceNamingConvention {
+	if n == nil {
+		return ""
+	}
+	if convention, ok := lookupInterfaceNamingConvention(n.Platforms, platform); ok {
+		return convention
+	}
+	if convention, ok := lookupInterfaceNamingConvention(n.Manufacturers, manufacturer); ok {
+		return convention
+	}
+	return n.Default
+}
+
+func lookupInterfaceNamingConvention(conventions map[string]InterfaceNamingConvention, name string) (InterfaceNamingConvention, bool) {
+	if name == "" {
+		return "", false
+	}
+	for k, convention := range conventions {
+		if strings.EqualFold(k, name) {
+			return convention, true
+		}
+	}
+	return "", false
+}
+
+// platformAndManufacturerNames returns the names of a platform and of the manufacturer of a device type or, if unset,
+// of the platform, empty when unknown
+func platformAndManufacturerNames(platform *DcimPlatform, deviceType *DcimDeviceType) (string, string) {
+	var platformName, manufacturerName string
+	if platform != nil {
+		platformName = platform.Name
+		if platform.Manufacturer != nil {
+			manufacturerName = platform.Manufacturer.Name
+		}
+	}
+	if deviceType != nil && deviceType.Manufacturer != nil {
+		manufacturerName = deviceType.Manufacturer.Name
+	}
+	return platformName, manufacturerName
+}
@@ -0,0 +1,115 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// InterfaceTypeRule infers the type of interfaces matching its name pattern, speed (in Kbps) and MTU, criteria left
+// unset match any interface
+type InterfaceTypeRule struct {
+	Name  *regexp.Regexp
+	Speed *int
+	MTU   *int
+	Type  string
+}
+
+// InterfaceTypeRules are interface type rules tried in order, the first one matching an interface sets its type
+type InterfaceTypeRules []InterfaceTypeRule
+
+// InterfaceTypeInferrer is implemented by data wrappers of interfaces whose type can be inferred when not ingested
+type InterfaceTypeInferrer interface {
+	// SetInterfaceTypeRules sets the rules inferring the interface type
+	SetInterfaceTypeRules(InterfaceTypeRules)
+}
+
+// NewInterfaceTypeRule creates an interface type rule, the name pattern being a case-insensitive regular expression
+func NewInterfaceTypeRule(namePattern string, speed *int, mtu *int, interfaceType string) (InterfaceTypeRule, error) {
+	if !validateInterfaceType(interfaceType) {
+		return InterfaceTypeRule{}, fmt.Errorf("invalid interface type %q", interfaceType)
+	}
+
+	rule := InterfaceTypeRule{Speed: speed, MTU: mtu, Type: interfaceType}
+
+	if namePattern != "" {
+		name, err := regexp.Compile("(?i)" + namePattern)
+		if err != nil {
+			return InterfaceTypeRule{}, fmt.Errorf("invalid name pattern %q: %v", namePattern, err)
+		}
+		rule.Name = name
+	}
+
+	return rule, nil
+}
+
+func (r InterfaceTypeRule) matches(name string, speed *int, mtu *int) bool {
+	if r.Name != nil && !r.Name.MatchString(name) {
+		return false
+	}
+	if r.Speed != nil && (speed == nil || *speed != *r.Speed) {
+		return false
+	}
+	if r.MTU != nil && (mtu == nil || *mtu != *r.MTU) {
+		return false
+	}
+	return true
+}
+
+// Infer returns the type of the first rule matching an interface, nil if none does
+func (rules InterfaceTypeRules) Infer(name string, speed *int, mtu *int) *string {
+	for _, rule := range rules {
+		if rule.matches(name, speed, mtu) {
+			interfaceType := rule.Type
+			return &interfaceType
+		}
+	}
+	return nil
+}
+
+// DefaultInterfaceTypeRules returns the built-in interface type rules: sub-interfaces, aggregates, virtual and bridge
+// interfaces by name, then physical interfaces by the vendor name of their port type and finally by speed
+func DefaultInterfaceTypeRules() InterfaceTypeRules {
+	rules := make(InterfaceTypeRules, 0, len(defaultInterfaceTypeNameRules)+len(defaultInterfaceTypeSpeedRules))
+	for _, r := range defaultInterfaceTypeNameRules {
+		rules = append(rules, InterfaceTypeRule{Name: regexp.MustCompile("(?i)" + r.name), Type: r.interfaceType})
+	}
+	for _, r := range defaultInterfaceTypeSpeedRules {
+		speed := r.speed
+		rules = append(rules, InterfaceTypeRule{Speed: &speed, Type: r.interfaceType})
+	}
+	return rules
+}
+
+var defaultInterfaceTypeNameRules = []struct {
+	name          string
+	interfaceType string
+}{
+	{`\.\d+$`, "virtual"},
+	{`^(port-channel|portchannel|po|bundle-ether|be|ae|bond|team|lag)-?\d`, "lag"},
+	{`^(loopback|lo|vlan|vl|tunnel|tu|irb|bvi|bdi|nve|vxlan|vx|null|dialer|virtual-template|veth|dummy)-?\d*$`, "virtual"},
+	{`^(bridge|br|virbr)-?\d*$`, "bridge"},
+	{`^(fastethernet|fa)\d`, "100base-tx"},
+	{`^(gigabitethernet|gi)\d|^ge-\d`, "1000base-t"},
+	{`^(twogigabitethernet|tw)\d`, "2.5gbase-t"},
+	{`^(fivegigabitethernet|fi)\d`, "5gbase-t"},
+	{`^(tengigabitethernet|te)\d|^xe-\d`, "10gbase-x-sfpp"},
+	{`^(twentyfivegige|twe)\d`, "25gbase-x-sfp28"},
+	{`^(fortygigabitethernet|fo)\d`, "40gbase-x-qsfpp"},
+	{`^(hundredgige|hu)\d`, "100gbase-x-qsfp28"},
+	{`^(management|mgmteth|mgmt|ma|fxp|em|me)\d`, "1000base-t"},
+}
+
+var defaultInterfaceTypeSpeedRules = []struct {
+	speed         int
+	interfaceType string
+}{
+	{100_000, "100base-tx"},
+	{1_000_000, "1000base-t"},
+	{2_500_000, "2.5gbase-t"},
+	{5_000_000, "5gbase-t"},
+	{10_000_000, "10gbase-x-sfpp"},
+	{25_000_000, "25gbase-x-sfp28"},
+	{40_000_000, "40gbase-x-qsfpp"},
+	{100_000_000, "100gbase-x-qsfp28"},
+	{400_000_000, "400gbase-x-qsfpdd"},
+}
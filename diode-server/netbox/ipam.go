@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -18,6 +19,12 @@ const (
 
 	// IpamPrefixObjectType represents the IPAM Prefix object type
 	IpamPrefixObjectType = "ipam.prefix"
+
+	// IpamFHRPGroupObjectType represents the IPAM FHRP Group object type
+	IpamFHRPGroupObjectType = "ipam.fhrpgroup"
+
+	// IpamFHRPGroupAssignmentObjectType represents the IPAM FHRP Group Assignment object type
+	IpamFHRPGroupAssignmentObjectType = "ipam.fhrpgroupassignment"
 )
 
 var (
@@ -35,6 +42,21 @@ var (
 
 	// DefaultPrefixStatus is the default status for the IpamPrefix
 	DefaultPrefixStatus = "active"
+
+	// ErrInvalidFHRPGroupProtocol is returned when the FHRP group protocol is invalid
+	ErrInvalidFHRPGroupProtocol = errors.New("invalid FHRP group protocol")
+
+	// ErrInvalidFHRPGroupID is returned when the FHRP group ID is out of range
+	ErrInvalidFHRPGroupID = errors.New("invalid FHRP group ID")
+
+	// ErrInvalidFHRPGroupAuthType is returned when the FHRP group authentication type is invalid
+	ErrInvalidFHRPGroupAuthType = errors.New("invalid FHRP group authentication type")
+
+	// ErrInvalidFHRPGroupAssignmentPriority is returned when the FHRP group assignment priority is out of range
+	ErrInvalidFHRPGroupAssignmentPriority = errors.New("invalid FHRP group assignment priority")
+
+	// DefaultFHRPGroupAssignmentPriority is the default priority for an FHRP group assignment
+	DefaultFHRPGroupAssignmentPriority = 100
 )
 
 // IPAddressAssignedObject represents an assigned object for an IP address
@@ -49,6 +71,13 @@ type IPAddressInterface struct {
 
 func (*IPAddressInterface) ipAddressAssignedObject() {}
 
+// IPAddressFHRPGroup represents an assigned FHRP group for an IP address
+type IPAddressFHRPGroup struct {
+	FHRPGroup *IpamFHRPGroup `json:"fhrp_group,omitempty" mapstructure:"fhrp_group"`
+}
+
+func (*IPAddressFHRPGroup) ipAddressAssignedObject() {}
+
 // IpamIPAddress represents an IPAM IP address
 type IpamIPAddress struct {
 	ID             int                     `json:"id,omitempty"`
@@ -117,12 +146,19 @@ func IpamIPAddressAssignedObjectHookFunc() mapstructure.DecodeHookFunc {
 
 		if t.Implements(reflect.TypeOf((*IPAddressAssignedObject)(nil)).Elem()) {
 			for k := range data.(map[string]any) {
-				if strings.ToLower(k) == "interface" {
+				switch strings.ToLower(k) {
+				case "interface":
 					var ipInterface IPAddressInterface
 					if err := mapstructure.Decode(data, &ipInterface); err != nil {
 						return nil, fmt.Errorf("failed to decode ingest entity %w", err)
 					}
 					return &ipInterface, nil
+				case "fhrp_group":
+					var ipFHRPGroup IPAddressFHRPGroup
+					if err := mapstructure.Decode(data, &ipFHRPGroup); err != nil {
+						return nil, fmt.Errorf("failed to decode ingest entity %w", err)
+					}
+					return &ipFHRPGroup, nil
 				}
 			}
 		}
@@ -168,6 +204,17 @@ func FromProtoIPAddress(ipaddressPb *diodepb.IPAddress) *IpamIPAddress {
 	}
 }
 
+// primaryIPAddress returns the address of the primary IPv4 address, of the primary IPv6 address if there is none
+func primaryIPAddress(ip4Pb *diodepb.IPAddress, ip6Pb *diodepb.IPAddress) *string {
+	for _, ipPb := range []*diodepb.IPAddress{ip4Pb, ip6Pb} {
+		if ipPb.GetAddress() != "" {
+			address := ipPb.GetAddress()
+			return &address
+		}
+	}
+	return nil
+}
+
 // FromProtoIPAddressAssignedObject converts a diode IP address assigned object to an IPAM IP address assigned object
 func FromProtoIPAddressAssignedObject(assignedObjectPb any) IPAddressAssignedObject {
 	if assignedObjectPb == nil {
@@ -257,3 +304,154 @@ func FromProtoPrefix(prefixPb *diodepb.Prefix) *IpamPrefix {
 		Tags:         FromProtoTags(prefixPb.Tags),
 	}
 }
+
+// IpamFHRPGroup represents an IPAM FHRP Group
+type IpamFHRPGroup struct {
+	ID          int              `json:"id,omitempty"`
+	Protocol    string           `json:"protocol,omitempty"`
+	GroupID     *int             `json:"group_id,omitempty" mapstructure:"group_id"`
+	Name        *string          `json:"name,omitempty"`
+	AuthType    *string          `json:"auth_type,omitempty" mapstructure:"auth_type"`
+	AuthKey     *string          `json:"auth_key,omitempty" mapstructure:"auth_key"`
+	VirtualIPs  []*IpamIPAddress `json:"-" mapstructure:"-" hash:"ignore"`
+	Description *string          `json:"description,omitempty"`
+	Comments    *string          `json:"comments,omitempty"`
+	Tags        []*Tag           `json:"tags,omitempty"`
+}
+
+var fhrpGroupProtocolMap = map[string]struct{}{
+	"vrrp2":     {},
+	"vrrp3":     {},
+	"carp":      {},
+	"clusterxl": {},
+	"hsrp":      {},
+	"glbp":      {},
+	"other":     {},
+}
+
+var fhrpGroupAuthTypeMap = map[string]struct{}{
+	"plaintext": {},
+	"md5":       {},
+}
+
+func validateFHRPGroupProtocol(p string) bool {
+	_, ok := fhrpGroupProtocolMap[p]
+	return ok
+}
+
+func validateFHRPGroupAuthType(t string) bool {
+	_, ok := fhrpGroupAuthTypeMap[t]
+	return ok
+}
+
+func fhrpGroupIDString(groupID *int) string {
+	if groupID == nil {
+		return ""
+	}
+	return strconv.Itoa(*groupID)
+}
+
+// Validate checks if the IPAM FHRP group is valid
+func (g *IpamFHRPGroup) Validate() error {
+	if !validateFHRPGroupProtocol(g.Protocol) {
+		return ErrInvalidFHRPGroupProtocol
+	}
+	if g.GroupID != nil && (*g.GroupID < 0 || *g.GroupID > 32767) {
+		return ErrInvalidFHRPGroupID
+	}
+	if g.AuthType != nil && !validateFHRPGroupAuthType(*g.AuthType) {
+		return ErrInvalidFHRPGroupAuthType
+	}
+	for _, ip := range g.VirtualIPs {
+		if ip == nil {
+			continue
+		}
+		if err := ip.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FromProtoFHRPGroupEntity converts a diode FHRP group entity to an IPAM FHRP group
+func FromProtoFHRPGroupEntity(entity *diodepb.Entity) (*IpamFHRPGroup, error) {
+	if entity == nil || entity.GetFhrpGroup() == nil {
+		return nil, fmt.Errorf("entity is nil or not an FHRP group")
+	}
+
+	return FromProtoFHRPGroup(entity.GetFhrpGroup()), nil
+}
+
+// FromProtoFHRPGroup converts a diode FHRP group to an IPAM FHRP group
+func FromProtoFHRPGroup(fhrpGroupPb *diodepb.FHRPGroup) *IpamFHRPGroup {
+	if fhrpGroupPb == nil {
+		return nil
+	}
+
+	var authType *string
+	if fhrpGroupPb.AuthType != "" {
+		authType = &fhrpGroupPb.AuthType
+	}
+
+	groupID := int(fhrpGroupPb.GroupId)
+
+	var virtualIPs []*IpamIPAddress
+	for _, ipPb := range fhrpGroupPb.VirtualIps {
+		virtualIPs = append(virtualIPs, FromProtoIPAddress(ipPb))
+	}
+
+	return &IpamFHRPGroup{
+		Protocol:    fhrpGroupPb.Protocol,
+		GroupID:     &groupID,
+		Name:        fhrpGroupPb.Name,
+		AuthType:    authType,
+		AuthKey:     fhrpGroupPb.AuthKey,
+		VirtualIPs:  virtualIPs,
+		Description: fhrpGroupPb.Description,
+		Comments:    fhrpGroupPb.Comments,
+		Tags:        FromProtoTags(fhrpGroupPb.Tags),
+	}
+}
+
+// IpamFHRPGroupAssignment represents an IPAM FHRP Group Assignment
+type IpamFHRPGroupAssignment struct {
+	ID        int            `json:"id,omitempty"`
+	Group     *IpamFHRPGroup `json:"group,omitempty"`
+	Interface *DcimInterface `json:"interface,omitempty"`
+	Priority  *int           `json:"priority,omitempty"`
+}
+
+// Validate checks if the IPAM FHRP group assignment is valid
+func (a *IpamFHRPGroupAssignment) Validate() error {
+	if a.Priority != nil && (*a.Priority < 0 || *a.Priority > 255) {
+		return ErrInvalidFHRPGroupAssignmentPriority
+	}
+	if a.Group != nil {
+		if err := a.Group.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FromProtoFHRPGroupAssignmentEntity converts a diode FHRP group assignment entity to an IPAM FHRP group assignment
+func FromProtoFHRPGroupAssignmentEntity(entity *diodepb.Entity) (*IpamFHRPGroupAssignment, error) {
+	if entity == nil || entity.GetFhrpGroupAssignment() == nil {
+		return nil, fmt.Errorf("entity is nil or not an FHRP group assignment")
+	}
+
+	return FromProtoFHRPGroupAssignment(entity.GetFhrpGroupAssignment()), nil
+}
+
+// FromProtoFHRPGroupAssignment converts a diode FHRP group assignment to an IPAM FHRP group assignment
+func FromProtoFHRPGroupAssignment(assignmentPb *diodepb.FHRPGroupAssignment) *IpamFHRPGroupAssignment {
+	if assignmentPb == nil {
+		return nil
+	}
+
+	return &IpamFHRPGroupAssignment{
+		Group:     FromProtoFHRPGroup(assignmentPb.Group),
+		Interface: FromProtoInterface(assignmentPb.Interface),
+		Priority:  int32PtrToIntPtr(assignmentPb.Priority),
+	}
+}
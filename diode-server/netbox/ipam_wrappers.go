@@ -3,8 +3,10 @@ package netbox
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 
 	"github.com/gosimple/slug"
+	"github.com/jinzhu/copier"
 	"github.com/mitchellh/hashstructure/v2"
 
 	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
@@ -136,6 +138,12 @@ func (dw *IpamIPAddressDataWrapper) ObjectStateQueryParams() map[string]string {
 				}
 			}
 		}
+	case *IPAddressFHRPGroup:
+		ao := dw.IPAddress.AssignedObject.(*IPAddressFHRPGroup).FHRPGroup
+		if ao != nil {
+			params["fhrp_group__protocol"] = ao.Protocol
+			params["fhrp_group__group_id"] = fhrpGroupIDString(ao.GroupID)
+		}
 	}
 	return params
 }
@@ -160,6 +168,11 @@ func (dw *IpamIPAddressDataWrapper) hash() string {
 					}
 				}
 			}
+		case *IPAddressFHRPGroup:
+			ao := dw.IPAddress.AssignedObject.(*IPAddressFHRPGroup).FHRPGroup
+			if ao != nil {
+				return slug.Make(fmt.Sprintf("%s-%s-%s", dw.IPAddress.Address, ao.Protocol, fhrpGroupIDString(ao.GroupID)))
+			}
 		}
 	}
 	return slug.Make(fmt.Sprintf("%s-%s-%s-%s", dw.IPAddress.Address, interfaceName, deviceName, siteName))
@@ -202,6 +215,7 @@ func (dw *IpamIPAddressDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 		}
 
 		dw.IPAddress.ID = intended.IPAddress.ID
+		dw.enforceFieldOwnership(dw.IPAddress, intended.IPAddress)
 		dw.IPAddress.Address = intended.IPAddress.Address
 
 		if actualAssignedObject != nil {
@@ -273,7 +287,7 @@ func (dw *IpamIPAddressDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 			dw.IPAddress.Comments = intended.IPAddress.Comments
 		}
 
-		tagsToMerge := mergeTags(dw.IPAddress.Tags, intended.IPAddress.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.IPAddress.Tags, intended.IPAddress.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.IPAddress.Tags = tagsToMerge
@@ -285,11 +299,17 @@ func (dw *IpamIPAddressDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 			}
 		}
 
+		dw.clearFields(dw.IPAddress, intended.IPAddress)
+
+		dw.diffFields(dw.IPAddress, intended.IPAddress)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.IPAddress, nil)
+
 		dw.SetDefaults()
 
 		var objectsToReconcile []ComparableData
@@ -322,7 +342,7 @@ func (dw *IpamIPAddressDataWrapper) Patch(cmp ComparableData, intendedNestedObje
 			objectsToReconcile = append(objectsToReconcile, assignedObjectsToReconcile...)
 		}
 
-		tagsToMerge := mergeTags(dw.IPAddress.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.IPAddress.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.IPAddress.Tags = tagsToMerge
@@ -354,6 +374,52 @@ func (dw *IpamIPAddressDataWrapper) SetDefaults() {
 	}
 }
 
+// ParentPrefixDeriver is implemented by data wrappers of objects the parent prefix of which can be derived
+type ParentPrefixDeriver interface {
+	// ParentPrefix returns the parent prefix of the object with the given status unless nil, nil if none
+	ParentPrefix(status *string) (ComparableData, error)
+}
+
+// ParentPrefix returns the prefix containing the IP address derived from its mask (e.g. 10.20.30.0/24 for
+// 10.20.30.5/24), in the site of the device of its assigned interface and with the given status unless nil. Host
+// addresses (/32 and /128) have no parent prefix.
+func (dw *IpamIPAddressDataWrapper) ParentPrefix(status *string) (ComparableData, error) {
+	if dw.IPAddress == nil || dw.IPAddress.Address == "" {
+		return nil, nil
+	}
+
+	prefix, err := netip.ParsePrefix(dw.IPAddress.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP address %s: %v", dw.IPAddress.Address, err)
+	}
+	if prefix.IsSingleIP() {
+		return nil, nil
+	}
+
+	var site *DcimSite
+	if ao, ok := dw.IPAddress.AssignedObject.(*IPAddressInterface); ok && ao.Interface != nil && ao.Interface.Device != nil && ao.Interface.Device.Site != nil {
+		// the site is copied as the nested objects of the IP address and the prefix are patched separately
+		site = &DcimSite{}
+		if err := copier.CopyWithOption(site, ao.Interface.Device.Site, copier.Option{DeepCopy: true}); err != nil {
+			return nil, err
+		}
+		site.ID = 0
+	}
+
+	// the parent prefix is built as intended so that, like a prefix in NetBox, it is left without site rather than
+	// assigned the placeholder site when the IP address has none
+	parent := &IpamPrefixDataWrapper{
+		Prefix: &IpamPrefix{
+			Prefix: prefix.Masked().String(),
+			Site:   site,
+			Status: status,
+		},
+		BaseDataWrapper: BaseDataWrapper{intended: true},
+	}
+
+	return parent, nil
+}
+
 // IpamPrefixDataWrapper represents the IPAM Prefix data wrapper
 type IpamPrefixDataWrapper struct {
 	BaseDataWrapper
@@ -492,41 +558,50 @@ func (dw *IpamPrefixDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 		}
 
 		dw.Prefix.ID = intended.Prefix.ID
+		dw.enforceFieldOwnership(dw.Prefix, intended.Prefix)
 		dw.Prefix.Prefix = intended.Prefix.Prefix
 
-		if actualSite.IsPlaceholder() && intended.Prefix.Site != nil {
-			intendedSite = extractFromObjectsMap(currentNestedObjectsMap, fmt.Sprintf("%p", intended.Prefix.Site))
-		}
-
-		siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
-		if siteErr != nil {
-			return nil, siteErr
-		}
-
-		site, err := copyData(actualSite.Data().(*DcimSite))
-		if err != nil {
-			return nil, err
-		}
-		site.Tags = nil
+		// prefixes built without site (e.g. parent prefixes of unassigned IP addresses) keep the site of the prefix
+		if actualSite == nil {
+			if intended.Prefix.Site != nil {
+				intended.Prefix.Site = &DcimSite{ID: intended.Prefix.Site.ID}
+			}
+			dw.Prefix.Site = intended.Prefix.Site
+		} else {
+			if actualSite.IsPlaceholder() && intended.Prefix.Site != nil {
+				intendedSite = extractFromObjectsMap(currentNestedObjectsMap, fmt.Sprintf("%p", intended.Prefix.Site))
+			}
 
-		if !actualSite.HasChanged() {
-			site = &DcimSite{
-				ID: actualSite.ID(),
+			siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
+			if siteErr != nil {
+				return nil, siteErr
 			}
 
-			intendedSiteID := intendedSite.ID()
-			if intended.Prefix.Site != nil {
-				intendedSiteID = intended.Prefix.Site.ID
+			site, err := copyData(actualSite.Data().(*DcimSite))
+			if err != nil {
+				return nil, err
 			}
+			site.Tags = nil
 
-			intended.Prefix.Site = &DcimSite{
-				ID: intendedSiteID,
+			if !actualSite.HasChanged() {
+				site = &DcimSite{
+					ID: actualSite.ID(),
+				}
+
+				intendedSiteID := intendedSite.ID()
+				if intended.Prefix.Site != nil {
+					intendedSiteID = intended.Prefix.Site.ID
+				}
+
+				intended.Prefix.Site = &DcimSite{
+					ID: intendedSiteID,
+				}
 			}
-		}
 
-		dw.Prefix.Site = site
+			dw.Prefix.Site = site
 
-		dw.objectsToReconcile = append(dw.objectsToReconcile, siteObjectsToReconcile...)
+			dw.objectsToReconcile = append(dw.objectsToReconcile, siteObjectsToReconcile...)
+		}
 
 		if dw.Prefix.Status == nil {
 			dw.Prefix.Status = intended.Prefix.Status
@@ -540,7 +615,7 @@ func (dw *IpamPrefixDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 			dw.Prefix.Comments = intended.Prefix.Comments
 		}
 
-		tagsToMerge := mergeTags(dw.Prefix.Tags, intended.Prefix.Tags, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Prefix.Tags, intended.Prefix.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Prefix.Tags = tagsToMerge
@@ -552,34 +627,42 @@ func (dw *IpamPrefixDataWrapper) Patch(cmp ComparableData, intendedNestedObjects
 			}
 		}
 
+		dw.clearFields(dw.Prefix, intended.Prefix)
+
+		dw.diffFields(dw.Prefix, intended.Prefix)
+
 		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		dw.enforceFieldOwnership(dw.Prefix, nil)
+
 		dw.SetDefaults()
 
-		siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
-		if siteErr != nil {
-			return nil, siteErr
-		}
+		if actualSite != nil {
+			siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
+			if siteErr != nil {
+				return nil, siteErr
+			}
 
-		site, err := copyData(actualSite.Data().(*DcimSite))
-		if err != nil {
-			return nil, err
-		}
-		site.Tags = nil
+			site, err := copyData(actualSite.Data().(*DcimSite))
+			if err != nil {
+				return nil, err
+			}
+			site.Tags = nil
 
-		if !actualSite.HasChanged() {
-			site = &DcimSite{
-				ID: actualSite.ID(),
+			if !actualSite.HasChanged() {
+				site = &DcimSite{
+					ID: actualSite.ID(),
+				}
 			}
-		}
-		dw.Prefix.Site = site
+			dw.Prefix.Site = site
 
-		dw.objectsToReconcile = append(dw.objectsToReconcile, siteObjectsToReconcile...)
+			dw.objectsToReconcile = append(dw.objectsToReconcile, siteObjectsToReconcile...)
+		}
 
-		tagsToMerge := mergeTags(dw.Prefix.Tags, nil, intendedNestedObjects)
+		tagsToMerge := dw.mergeTags(dw.Prefix.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			dw.Prefix.Tags = tagsToMerge
@@ -606,3 +689,521 @@ func (dw *IpamPrefixDataWrapper) SetDefaults() {
 		dw.Prefix.Status = &DefaultPrefixStatus
 	}
 }
+
+// IpamFHRPGroupDataWrapper represents the IPAM FHRP group data wrapper
+type IpamFHRPGroupDataWrapper struct {
+	BaseDataWrapper
+	FHRPGroup *IpamFHRPGroup
+}
+
+func (*IpamFHRPGroupDataWrapper) comparableData() {}
+
+// FromProtoEntity sets the data from a proto entity
+func (dw *IpamFHRPGroupDataWrapper) FromProtoEntity(entity *diodepb.Entity) error {
+	fhrpGroup, err := FromProtoFHRPGroupEntity(entity)
+	if err != nil {
+		return err
+	}
+	dw.FHRPGroup = fhrpGroup
+	return nil
+}
+
+// Data returns the FHRP group
+func (dw *IpamFHRPGroupDataWrapper) Data() any {
+	return dw.FHRPGroup
+}
+
+// IsValid returns true if the FHRP group is not nil
+func (dw *IpamFHRPGroupDataWrapper) IsValid() bool {
+	if dw.FHRPGroup != nil && !dw.hasParent && dw.FHRPGroup.Protocol == "" {
+		dw.FHRPGroup = nil
+	}
+
+	if dw.FHRPGroup != nil {
+		if err := dw.FHRPGroup.Validate(); err != nil {
+			return false
+		}
+	}
+
+	return dw.FHRPGroup != nil
+}
+
+// Normalise normalises the data
+func (dw *IpamFHRPGroupDataWrapper) Normalise() {
+	if dw.IsValid() && dw.FHRPGroup.Tags != nil && len(dw.FHRPGroup.Tags) == 0 {
+		dw.FHRPGroup.Tags = nil
+	}
+	dw.intended = true
+}
+
+// NestedObjects returns all nested objects
+func (dw *IpamFHRPGroupDataWrapper) NestedObjects() ([]ComparableData, error) {
+	if len(dw.nestedObjects) > 0 {
+		return dw.nestedObjects, nil
+	}
+
+	objects := make([]ComparableData, 0)
+
+	if dw.FHRPGroup == nil {
+		return objects, nil
+	}
+
+	if dw.FHRPGroup.Tags != nil {
+		for _, t := range dw.FHRPGroup.Tags {
+			if t.Slug == "" {
+				t.Slug = slug.Make(t.Name)
+			}
+			objects = append(objects, &TagDataWrapper{Tag: t, hasParent: true})
+		}
+	}
+
+	// virtual IPs are only known from the ingested data, they are assigned to the group once it's reconciled
+	if !dw.intended {
+		virtualIPs := make([]*IpamIPAddress, 0)
+		for _, ip := range dw.FHRPGroup.VirtualIPs {
+			if ip == nil || ip.Address == "" {
+				continue
+			}
+
+			ip.AssignedObject = &IPAddressFHRPGroup{FHRPGroup: dw.FHRPGroup}
+
+			virtualIP := &IpamIPAddressDataWrapper{IPAddress: ip, BaseDataWrapper: BaseDataWrapper{hasParent: true}}
+
+			vo, err := virtualIP.NestedObjects()
+			if err != nil {
+				return nil, err
+			}
+
+			objects = append(objects, vo...)
+
+			virtualIPs = append(virtualIPs, virtualIP.IPAddress)
+		}
+		if len(virtualIPs) > 0 {
+			dw.FHRPGroup.VirtualIPs = virtualIPs
+		}
+	}
+
+	dw.nestedObjects = objects
+
+	objects = append(objects, dw)
+
+	return objects, nil
+}
+
+// DataType returns the data type
+func (dw *IpamFHRPGroupDataWrapper) DataType() string {
+	return IpamFHRPGroupObjectType
+}
+
+// ObjectStateQueryParams returns the query parameters needed to retrieve its object state
+func (dw *IpamFHRPGroupDataWrapper) ObjectStateQueryParams() map[string]string {
+	return map[string]string{
+		"protocol": dw.FHRPGroup.Protocol,
+		"group_id": fhrpGroupIDString(dw.FHRPGroup.GroupID),
+	}
+}
+
+// ID returns the ID of the data
+func (dw *IpamFHRPGroupDataWrapper) ID() int {
+	return dw.FHRPGroup.ID
+}
+
+// Patch creates patches between the actual, intended and current data
+func (dw *IpamFHRPGroupDataWrapper) Patch(cmp ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
+	intended, ok := cmp.(*IpamFHRPGroupDataWrapper)
+	if !ok && intended != nil {
+		return nil, errors.New("invalid data type")
+	}
+
+	actualNestedObjectsMap := make(map[string]ComparableData)
+	for _, obj := range dw.nestedObjects {
+		actualNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
+	}
+
+	reconciliationRequired := true
+
+	if intended != nil {
+		dw.FHRPGroup.ID = intended.FHRPGroup.ID
+		dw.enforceFieldOwnership(dw.FHRPGroup, intended.FHRPGroup)
+		dw.FHRPGroup.Protocol = intended.FHRPGroup.Protocol
+		dw.FHRPGroup.GroupID = intended.FHRPGroup.GroupID
+
+		if dw.FHRPGroup.Name == nil {
+			dw.FHRPGroup.Name = intended.FHRPGroup.Name
+		}
+
+		if dw.FHRPGroup.AuthType == nil {
+			dw.FHRPGroup.AuthType = intended.FHRPGroup.AuthType
+		}
+
+		if dw.FHRPGroup.AuthKey == nil {
+			dw.FHRPGroup.AuthKey = intended.FHRPGroup.AuthKey
+		}
+
+		if dw.FHRPGroup.Description == nil {
+			dw.FHRPGroup.Description = intended.FHRPGroup.Description
+		}
+
+		if dw.FHRPGroup.Comments == nil {
+			dw.FHRPGroup.Comments = intended.FHRPGroup.Comments
+		}
+
+		tagsToMerge := dw.mergeTags(dw.FHRPGroup.Tags, intended.FHRPGroup.Tags, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			dw.FHRPGroup.Tags = tagsToMerge
+		}
+
+		dw.clearFields(dw.FHRPGroup, intended.FHRPGroup)
+
+		dw.diffFields(dw.FHRPGroup, intended.FHRPGroup)
+
+		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
+		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
+
+		reconciliationRequired = actualHash != intendedHash
+	} else {
+		dw.enforceFieldOwnership(dw.FHRPGroup, nil)
+
+		dw.SetDefaults()
+
+		tagsToMerge := dw.mergeTags(dw.FHRPGroup.Tags, nil, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			dw.FHRPGroup.Tags = tagsToMerge
+		}
+	}
+
+	for _, t := range dw.FHRPGroup.Tags {
+		if t.ID == 0 {
+			dw.objectsToReconcile = append(dw.objectsToReconcile, &TagDataWrapper{Tag: t, hasParent: true})
+		}
+	}
+
+	if reconciliationRequired {
+		dw.hasChanged = true
+		dw.objectsToReconcile = append(dw.objectsToReconcile, dw)
+	}
+
+	// virtual IPs reference the group, so they are reconciled after it
+	virtualIPsToReconcile, err := dw.patchVirtualIPs(actualNestedObjectsMap, intendedNestedObjects)
+	if err != nil {
+		return nil, err
+	}
+	dw.objectsToReconcile = append(dw.objectsToReconcile, virtualIPsToReconcile...)
+
+	dedupObjectsToReconcile, err := dedupObjectsToReconcile(dw.objectsToReconcile)
+	if err != nil {
+		return nil, err
+	}
+	dw.objectsToReconcile = dedupObjectsToReconcile
+
+	return dw.objectsToReconcile, nil
+}
+
+// patchVirtualIPs patches the virtual IPs of the group and assigns them to it
+func (dw *IpamFHRPGroupDataWrapper) patchVirtualIPs(actualNestedObjectsMap map[string]ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
+	objectsToReconcile := make([]ComparableData, 0)
+
+	for _, ip := range dw.FHRPGroup.VirtualIPs {
+		key := fmt.Sprintf("%p", ip)
+		actualVirtualIP := extractFromObjectsMap(actualNestedObjectsMap, key)
+		if actualVirtualIP == nil {
+			continue
+		}
+
+		group, err := copyData(dw.FHRPGroup)
+		if err != nil {
+			return nil, err
+		}
+		group.VirtualIPs = nil
+		group.Tags = nil
+
+		if dw.FHRPGroup.ID > 0 {
+			group = fhrpGroupRef(dw.FHRPGroup)
+		}
+		ip.AssignedObject = &IPAddressFHRPGroup{FHRPGroup: group}
+
+		intendedVirtualIP := extractFromObjectsMap(intendedNestedObjects, key)
+		if intendedVirtualIP != nil {
+			if ao, ok := intendedVirtualIP.Data().(*IpamIPAddress).AssignedObject.(*IPAddressFHRPGroup); ok && ao.FHRPGroup != nil {
+				ao.FHRPGroup = fhrpGroupRef(ao.FHRPGroup)
+			}
+		}
+
+		virtualIPObjectsToReconcile, err := actualVirtualIP.Patch(intendedVirtualIP, intendedNestedObjects)
+		if err != nil {
+			return nil, err
+		}
+		objectsToReconcile = append(objectsToReconcile, virtualIPObjectsToReconcile...)
+	}
+
+	return objectsToReconcile, nil
+}
+
+// SetDefaults sets the default values for the FHRP group
+func (dw *IpamFHRPGroupDataWrapper) SetDefaults() {}
+
+func fhrpGroupRef(group *IpamFHRPGroup) *IpamFHRPGroup {
+	return &IpamFHRPGroup{
+		ID:       group.ID,
+		Protocol: group.Protocol,
+		GroupID:  group.GroupID,
+	}
+}
+
+// IpamFHRPGroupAssignmentDataWrapper represents the IPAM FHRP group assignment data wrapper
+type IpamFHRPGroupAssignmentDataWrapper struct {
+	BaseDataWrapper
+	FHRPGroupAssignment *IpamFHRPGroupAssignment
+}
+
+func (*IpamFHRPGroupAssignmentDataWrapper) comparableData() {}
+
+// FromProtoEntity sets the data from a proto entity
+func (dw *IpamFHRPGroupAssignmentDataWrapper) FromProtoEntity(entity *diodepb.Entity) error {
+	assignment, err := FromProtoFHRPGroupAssignmentEntity(entity)
+	if err != nil {
+		return err
+	}
+	dw.FHRPGroupAssignment = assignment
+	return nil
+}
+
+// Data returns the FHRP group assignment
+func (dw *IpamFHRPGroupAssignmentDataWrapper) Data() any {
+	return dw.FHRPGroupAssignment
+}
+
+// IsValid returns true if the FHRP group assignment is not nil
+func (dw *IpamFHRPGroupAssignmentDataWrapper) IsValid() bool {
+	if dw.FHRPGroupAssignment != nil && (dw.FHRPGroupAssignment.Group == nil || dw.FHRPGroupAssignment.Interface == nil) {
+		dw.FHRPGroupAssignment = nil
+	}
+
+	if dw.FHRPGroupAssignment != nil {
+		if err := dw.FHRPGroupAssignment.Validate(); err != nil {
+			return false
+		}
+	}
+
+	return dw.FHRPGroupAssignment != nil
+}
+
+// Normalise normalises the data
+func (dw *IpamFHRPGroupAssignmentDataWrapper) Normalise() {
+	dw.intended = true
+}
+
+// NestedObjects returns all nested objects
+func (dw *IpamFHRPGroupAssignmentDataWrapper) NestedObjects() ([]ComparableData, error) {
+	if len(dw.nestedObjects) > 0 {
+		return dw.nestedObjects, nil
+	}
+
+	objects := make([]ComparableData, 0)
+
+	if dw.FHRPGroupAssignment == nil {
+		return objects, nil
+	}
+
+	group := IpamFHRPGroupDataWrapper{FHRPGroup: dw.FHRPGroupAssignment.Group, BaseDataWrapper: BaseDataWrapper{placeholder: dw.placeholder, hasParent: true, intended: dw.intended}}
+
+	gro, err := group.NestedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	objects = append(objects, gro...)
+
+	dw.FHRPGroupAssignment.Group = group.FHRPGroup
+
+	interf := DcimInterfaceDataWrapper{Interface: dw.FHRPGroupAssignment.Interface, BaseDataWrapper: BaseDataWrapper{placeholder: dw.placeholder, hasParent: true, intended: dw.intended}}
+
+	io, err := interf.NestedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	objects = append(objects, io...)
+
+	dw.FHRPGroupAssignment.Interface = interf.Interface
+
+	dw.nestedObjects = objects
+
+	objects = append(objects, dw)
+
+	return objects, nil
+}
+
+// DataType returns the data type
+func (dw *IpamFHRPGroupAssignmentDataWrapper) DataType() string {
+	return IpamFHRPGroupAssignmentObjectType
+}
+
+// ObjectStateQueryParams returns the query parameters needed to retrieve its object state
+func (dw *IpamFHRPGroupAssignmentDataWrapper) ObjectStateQueryParams() map[string]string {
+	params := make(map[string]string)
+	if group := dw.FHRPGroupAssignment.Group; group != nil {
+		params["group__protocol"] = group.Protocol
+		params["group__group_id"] = fhrpGroupIDString(group.GroupID)
+	}
+	if interf := dw.FHRPGroupAssignment.Interface; interf != nil {
+		params["interface__name"] = interf.Name
+		if interf.Device != nil {
+			params["interface__device__name"] = interf.Device.Name
+			if interf.Device.Site != nil {
+				params["interface__device__site__name"] = interf.Device.Site.Name
+			}
+		}
+	}
+	return params
+}
+
+// ID returns the ID of the data
+func (dw *IpamFHRPGroupAssignmentDataWrapper) ID() int {
+	return dw.FHRPGroupAssignment.ID
+}
+
+func (dw *IpamFHRPGroupAssignmentDataWrapper) hash() string {
+	var protocol, groupID, interfaceHash string
+	if group := dw.FHRPGroupAssignment.Group; group != nil {
+		protocol = group.Protocol
+		groupID = fhrpGroupIDString(group.GroupID)
+	}
+	if interf := dw.FHRPGroupAssignment.Interface; interf != nil {
+		interfaceHash = (&DcimInterfaceDataWrapper{Interface: interf}).hash()
+	}
+	return slug.Make(fmt.Sprintf("%s-%s-%s", protocol, groupID, interfaceHash))
+}
+
+// Patch creates patches between the actual, intended and current data
+func (dw *IpamFHRPGroupAssignmentDataWrapper) Patch(cmp ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
+	intended, ok := cmp.(*IpamFHRPGroupAssignmentDataWrapper)
+	if !ok && intended != nil {
+		return nil, errors.New("invalid data type")
+	}
+
+	actualNestedObjectsMap := make(map[string]ComparableData)
+	for _, obj := range dw.nestedObjects {
+		actualNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
+	}
+
+	actualGroup := extractFromObjectsMap(actualNestedObjectsMap, fmt.Sprintf("%p", dw.FHRPGroupAssignment.Group))
+	intendedGroup := extractFromObjectsMap(intendedNestedObjects, fmt.Sprintf("%p", dw.FHRPGroupAssignment.Group))
+
+	actualInterface := extractFromObjectsMap(actualNestedObjectsMap, fmt.Sprintf("%p", dw.FHRPGroupAssignment.Interface))
+	intendedInterface := extractFromObjectsMap(intendedNestedObjects, fmt.Sprintf("%p", dw.FHRPGroupAssignment.Interface))
+
+	reconciliationRequired := true
+
+	if intended != nil && dw.hash() == intended.hash() {
+		dw.FHRPGroupAssignment.ID = intended.FHRPGroupAssignment.ID
+		dw.enforceFieldOwnership(dw.FHRPGroupAssignment, intended.FHRPGroupAssignment)
+
+		groupObjectsToReconcile, group, groupErr := patchFHRPGroupAssignmentGroup(actualGroup, intendedGroup, intendedNestedObjects)
+		if groupErr != nil {
+			return nil, groupErr
+		}
+
+		if !actualGroup.HasChanged() && intended.FHRPGroupAssignment.Group != nil {
+			intended.FHRPGroupAssignment.Group = &IpamFHRPGroup{
+				ID: intended.FHRPGroupAssignment.Group.ID,
+			}
+		}
+
+		dw.FHRPGroupAssignment.Group = group
+
+		dw.objectsToReconcile = append(dw.objectsToReconcile, groupObjectsToReconcile...)
+
+		interfaceObjectsToReconcile, interf, interfaceErr := patchNestedInterface(actualInterface, intendedInterface, intendedNestedObjects)
+		if interfaceErr != nil {
+			return nil, interfaceErr
+		}
+
+		if !actualInterface.HasChanged() && intended.FHRPGroupAssignment.Interface != nil {
+			intended.FHRPGroupAssignment.Interface = nestedInterfaceRef(intended.FHRPGroupAssignment.Interface)
+		}
+
+		dw.FHRPGroupAssignment.Interface = interf
+
+		dw.objectsToReconcile = append(dw.objectsToReconcile, interfaceObjectsToReconcile...)
+
+		if dw.FHRPGroupAssignment.Priority == nil {
+			dw.FHRPGroupAssignment.Priority = intended.FHRPGroupAssignment.Priority
+		}
+
+		dw.clearFields(dw.FHRPGroupAssignment, intended.FHRPGroupAssignment)
+
+		dw.diffFields(dw.FHRPGroupAssignment, intended.FHRPGroupAssignment)
+
+		actualHash, _ := hashstructure.Hash(dw.Data(), hashstructure.FormatV2, nil)
+		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
+
+		reconciliationRequired = actualHash != intendedHash
+	} else {
+		dw.enforceFieldOwnership(dw.FHRPGroupAssignment, nil)
+
+		dw.SetDefaults()
+
+		groupObjectsToReconcile, group, groupErr := patchFHRPGroupAssignmentGroup(actualGroup, intendedGroup, intendedNestedObjects)
+		if groupErr != nil {
+			return nil, groupErr
+		}
+		dw.FHRPGroupAssignment.Group = group
+
+		dw.objectsToReconcile = append(dw.objectsToReconcile, groupObjectsToReconcile...)
+
+		interfaceObjectsToReconcile, interf, interfaceErr := patchNestedInterface(actualInterface, intendedInterface, intendedNestedObjects)
+		if interfaceErr != nil {
+			return nil, interfaceErr
+		}
+		dw.FHRPGroupAssignment.Interface = interf
+
+		dw.objectsToReconcile = append(dw.objectsToReconcile, interfaceObjectsToReconcile...)
+	}
+
+	if reconciliationRequired {
+		dw.hasChanged = true
+		dw.objectsToReconcile = append(dw.objectsToReconcile, dw)
+	}
+
+	dedupObjectsToReconcile, err := dedupObjectsToReconcile(dw.objectsToReconcile)
+	if err != nil {
+		return nil, err
+	}
+	dw.objectsToReconcile = dedupObjectsToReconcile
+
+	return dw.objectsToReconcile, nil
+}
+
+// SetDefaults sets the default values for the FHRP group assignment
+func (dw *IpamFHRPGroupAssignmentDataWrapper) SetDefaults() {
+	if dw.FHRPGroupAssignment.Priority == nil {
+		priority := DefaultFHRPGroupAssignmentPriority
+		dw.FHRPGroupAssignment.Priority = &priority
+	}
+}
+
+// patchFHRPGroupAssignmentGroup patches the group of an FHRP group assignment and returns the group to reference from it
+func patchFHRPGroupAssignmentGroup(actual ComparableData, intended ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, *IpamFHRPGroup, error) {
+	objectsToReconcile, err := actual.Patch(intended, intendedNestedObjects)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group, err := copyData(actual.Data().(*IpamFHRPGroup))
+	if err != nil {
+		return nil, nil, err
+	}
+	group.VirtualIPs = nil
+	group.Tags = nil
+
+	if !actual.HasChanged() {
+		group = &IpamFHRPGroup{
+			ID: actual.ID(),
+		}
+	}
+
+	return objectsToReconcile, group, nil
+}
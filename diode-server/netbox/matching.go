@@ -0,0 +1,130 @@
+package netbox
+
+import (
+	"fmt"
+)
+
+// MatchingKey is a key matching an ingested object to an existing one in NetBox
+type MatchingKey string
+
+const (
+	// MatchingKeyName matches objects by name and site (or cluster), this is the default matching key
+	MatchingKeyName MatchingKey = "name"
+
+	// MatchingKeySerial matches devices by serial number
+	MatchingKeySerial MatchingKey = "serial"
+
+	// MatchingKeyAssetTag matches devices by asset tag
+	MatchingKeyAssetTag MatchingKey = "asset_tag"
+
+	// MatchingKeyDeviceFQDN matches devices by FQDN
+	MatchingKeyDeviceFQDN MatchingKey = "device_fqdn"
+
+	// MatchingKeyPrimaryIP matches devices and virtual machines by primary IP address
+	MatchingKeyPrimaryIP MatchingKey = "primary_ip"
+)
+
+// MatchingStrategy is an ordered chain of matching keys, the first key matching an existing object wins
+type MatchingStrategy []MatchingKey
+
+// MatchingQueryParams are the query parameters retrieving an object state by a matching key
+type MatchingQueryParams struct {
+	Key    MatchingKey
+	Params map[string]string
+}
+
+// ObjectMatcher is implemented by data wrappers which can be matched to existing objects by configurable keys
+type ObjectMatcher interface {
+	// SetMatchingStrategy sets the matching strategy
+	SetMatchingStrategy(MatchingStrategy)
+
+	// MatchingStrategy returns the matching strategy, empty if the object is matched by ObjectStateQueryParams
+	MatchingStrategy() MatchingStrategy
+
+	// SetMatchedBy sets the matching key the existing object was found by
+	SetMatchedBy(MatchingKey)
+
+	// MatchingObjectStateQueryParams returns the query parameters of each key of the matching strategy in order,
+	// skipping keys the object has no value for
+	MatchingObjectStateQueryParams() []MatchingQueryParams
+}
+
+// supportedMatchingKeys are the matching keys supported per object type
+var supportedMatchingKeys = map[string][]MatchingKey{
+	DcimDeviceObjectType:                   {MatchingKeyName, MatchingKeySerial, MatchingKeyAssetTag, MatchingKeyDeviceFQDN, MatchingKeyPrimaryIP},
+	VirtualizationVirtualMachineObjectType: {MatchingKeyName, MatchingKeyPrimaryIP},
+}
+
+// SetMatchingStrategy sets the matching strategy
+func (bw *BaseDataWrapper) SetMatchingStrategy(strategy MatchingStrategy) {
+	bw.matchingStrategy = strategy
+}
+
+// MatchingStrategy returns the matching strategy, empty if the object is matched by ObjectStateQueryParams
+func (bw *BaseDataWrapper) MatchingStrategy() MatchingStrategy {
+	return bw.matchingStrategy
+}
+
+// SetMatchedBy sets the matching key the existing object was found by
+func (bw *BaseDataWrapper) SetMatchedBy(key MatchingKey) {
+	bw.matchedBy = key
+}
+
+// matchedByName returns true if the existing object was found by its name, i.e. it's not renamed when patched
+func (bw *BaseDataWrapper) matchedByName() bool {
+	return bw.matchedBy == "" || bw.matchedBy == MatchingKeyName
+}
+
+// matchingQueryParams returns the query parameters of each key of the matching strategy, the ones of the name
+// being nameParams, skipping keys without a value
+func (bw *BaseDataWrapper) matchingQueryParams(nameParams map[string]string, values map[MatchingKey]*string) []MatchingQueryParams {
+	queryParams := make([]MatchingQueryParams, 0, len(bw.matchingStrategy))
+	for _, key := range bw.matchingStrategy {
+		if key == MatchingKeyName {
+			queryParams = append(queryParams, MatchingQueryParams{Key: key, Params: nameParams})
+			continue
+		}
+
+		value := values[key]
+		if value == nil || *value == "" {
+			continue
+		}
+		queryParams = append(queryParams, MatchingQueryParams{Key: key, Params: map[string]string{string(key): *value}})
+	}
+	return queryParams
+}
+
+// ValidateMatchingStrategy validates a matching strategy for an object type
+func ValidateMatchingStrategy(objectType string, strategy MatchingStrategy) error {
+	supported, ok := supportedMatchingKeys[objectType]
+	if !ok {
+		return fmt.Errorf("object type %s doesn't support matching strategies", objectType)
+	}
+
+	if len(strategy) == 0 {
+		return fmt.Errorf("empty matching strategy for %s", objectType)
+	}
+
+	seen := make(map[MatchingKey]struct{}, len(strategy))
+	for _, key := range strategy {
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate matching key %s for %s", key, objectType)
+		}
+		seen[key] = struct{}{}
+
+		if !matchingKeySupported(supported, key) {
+			return fmt.Errorf("unsupported matching key %q for %s", key, objectType)
+		}
+	}
+
+	return nil
+}
+
+func matchingKeySupported(supported []MatchingKey, key MatchingKey) bool {
+	for _, k := range supported {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
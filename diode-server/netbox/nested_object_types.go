@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// objectTypes lists the object types supported
+var objectTypes = []string{
+	DcimDeviceObjectType,
+	DcimDeviceRoleObjectType,
+	DcimDeviceTypeObjectType,
+	DcimInterfaceObjectType,
+	DcimManufacturerObjectType,
+	DcimPlatformObjectType,
+	DcimSiteObjectType,
+	ExtrasTagObjectType,
+	IpamIPAddressObjectType,
+	IpamPrefixObjectType,
+	VirtualizationClusterGroupObjectType,
+	VirtualizationClusterTypeObjectType,
+	VirtualizationClusterObjectType,
+	VirtualizationVirtualMachineObjectType,
+	VirtualizationVMInterfaceObjectType,
+	VirtualizationVirtualDiskObjectType,
+	WirelessLANObjectType,
+	WirelessLANGroupObjectType,
+	WirelessLinkObjectType,
+	IpamFHRPGroupObjectType,
+	IpamFHRPGroupAssignmentObjectType,
+}
+
+// interfaceImplementations lists the types implementing the interfaces of object data fields
+var interfaceImplementations = map[reflect.Type][]reflect.Type{
+	reflect.TypeOf((*IPAddressAssignedObject)(nil)).Elem(): {
+		reflect.TypeOf(IPAddressInterface{}),
+		reflect.TypeOf(IPAddressFHRPGroup{}),
+	},
+}
+
+// ignoredNestedFields lists the fields of object data left out of their nested objects, as they are not reconciled
+var ignoredNestedFields = map[reflect.Type][]string{
+	reflect.TypeOf(DcimDevice{}):                   {"PrimaryIPv4", "PrimaryIPv6"},
+	reflect.TypeOf(VirtualizationVirtualMachine{}): {"PrimaryIPv4", "PrimaryIPv6", "Device"},
+}
+
+var (
+	nestedObjectTypesOnce sync.Once
+	nestedObjectTypes     map[string][]string
+	dependentObjectTypes  map[string][]string
+)
+
+// NestedObjectTypes returns the object types which objects of an object type may nest, directly or through other
+// nested objects, sorted. They are derived from the fields of the object type data, so are the same for all replicas.
+func NestedObjectTypes(objectType string) []string {
+	deriveNestedObjectTypes()
+	return nestedObjectTypes[objectType]
+}
+
+// DependentObjectTypes returns the object types whose objects may nest objects of an object type, sorted
+func DependentObjectTypes(objectType string) []string {
+	deriveNestedObjectTypes()
+	return dependentObjectTypes[objectType]
+}
+
+func deriveNestedObjectTypes() {
+	nestedObjectTypesOnce.Do(func() {
+		dataObjectTypes := make(map[reflect.Type]string, len(objectTypes))
+		for _, t := range objectTypes {
+			dw, err := NewDataWrapper(t)
+			if err != nil {
+				continue
+			}
+			dataObjectTypes[reflect.TypeOf(dw.Data()).Elem()] = t
+		}
+
+		nestedObjectTypes = make(map[string][]string, len(dataObjectTypes))
+		for dataType, t := range dataObjectTypes {
+			nested := make(map[string]struct{})
+			collectNestedObjectTypes(dataType, dataObjectTypes, nested, make(map[reflect.Type]struct{}))
+			delete(nested, t)
+
+			types := make([]string, 0, len(nested))
+			for nestedType := range nested {
+				types = append(types, nestedType)
+			}
+			slices.Sort(types)
+			nestedObjectTypes[t] = types
+		}
+
+		dependentObjectTypes = make(map[string][]string)
+		for _, t := range objectTypes {
+			for _, nestedType := range nestedObjectTypes[t] {
+				dependentObjectTypes[nestedType] = append(dependentObjectTypes[nestedType], t)
+			}
+		}
+		for _, types := range dependentObjectTypes {
+			slices.Sort(types)
+		}
+	})
+}
+
+// collectNestedObjectTypes collects the object types of the fields of a struct type and of the fields of its fields,
+// the fields of interface types holding any of their implementations
+func collectNestedObjectTypes(t reflect.Type, dataObjectTypes map[reflect.Type]string, nested map[string]struct{}, visited map[reflect.Type]struct{}) {
+	if _, ok := visited[t]; ok {
+		return
+	}
+	visited[t] = struct{}{}
+
+	for i := 0; i < t.NumField(); i++ {
+		if slices.Contains(ignoredNestedFields[t], t.Field(i).Name) {
+			continue
+		}
+
+		ft := t.Field(i).Type
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+		if ft.PkgPath() != t.PkgPath() {
+			continue
+		}
+
+		fieldTypes := []reflect.Type{ft}
+		if ft.Kind() == reflect.Interface {
+			fieldTypes = interfaceImplementations[ft]
+		}
+
+		for _, fieldType := range fieldTypes {
+			if fieldType.Kind() != reflect.Struct {
+				continue
+			}
+			if objectType, ok := dataObjectTypes[fieldType]; ok {
+				nested[objectType] = struct{}{}
+			}
+			collectNestedObjectTypes(fieldType, dataObjectTypes, nested, visited)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package netbox
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagMergeStrategy decides how ingested tags are merged with the tags of an object in NetBox
+type TagMergeStrategy string
+
+const (
+	// TagMergeStrategyUnion adds the ingested tags to the tags in NetBox, this is the default strategy
+	TagMergeStrategyUnion TagMergeStrategy = "union"
+
+	// TagMergeStrategyReplace replaces the tags in NetBox with the ingested ones
+	TagMergeStrategyReplace TagMergeStrategy = "replace"
+
+	// TagMergeStrategyManagedPrefix adds the ingested tags and removes the tags in NetBox with the managed prefix which
+	// are no longer ingested, other tags being left untouched
+	TagMergeStrategyManagedPrefix TagMergeStrategy = "managed_prefix"
+)
+
+// TagPolicy is the tag merge strategy of a data source, Prefix being the prefix of the names of the tags managed with
+// the managed prefix strategy (e.g. diode:)
+type TagPolicy struct {
+	Strategy TagMergeStrategy `yaml:"strategy"`
+	Prefix   string           `yaml:"prefix"`
+}
+
+// Validate validates the tag policy
+func (p TagPolicy) Validate() error {
+	switch p.Strategy {
+	case "", TagMergeStrategyUnion, TagMergeStrategyReplace:
+		if p.Prefix != "" {
+			return fmt.Errorf("prefix is only supported by the %s strategy", TagMergeStrategyManagedPrefix)
+		}
+	case TagMergeStrategyManagedPrefix:
+		if p.Prefix == "" {
+			return fmt.Errorf("prefix is required by the %s strategy", TagMergeStrategyManagedPrefix)
+		}
+	default:
+		return fmt.Errorf("unknown tag merge strategy %q", p.Strategy)
+	}
+	return nil
+}
+
+// keptTags returns the tags in NetBox kept when merged with the ingested tags
+func (p TagPolicy) keptTags(intendedTags []*Tag, actualTags []*Tag) []*Tag {
+	switch p.Strategy {
+	case TagMergeStrategyReplace:
+		return nil
+	case TagMergeStrategyManagedPrefix:
+		kept := make([]*Tag, 0, len(intendedTags))
+		for _, t := range intendedTags {
+			if strings.HasPrefix(t.Name, p.Prefix) && !slices.ContainsFunc(actualTags, func(at *Tag) bool { return at.Name == t.Name }) {
+				continue
+			}
+			kept = append(kept, t)
+		}
+		return kept
+	default:
+		return intendedTags
+	}
+}
+
+// TagMerger is implemented by data wrappers merging ingested tags per a tag policy when patched
+type TagMerger interface {
+	// SetTagPolicy sets the tag policy
+	SetTagPolicy(TagPolicy)
+}
+
+// SetTagPolicy sets the tag policy
+func (bw *BaseDataWrapper) SetTagPolicy(policy TagPolicy) {
+	bw.tagPolicy = policy
+}
+
+// mergeTags merges the ingested tags with the tags of the object in NetBox per the tag policy, nested objects of the
+// ingested object always keeping their tags in NetBox. Tags all removed are recorded as cleared.
+func (bw *BaseDataWrapper) mergeTags(actualTags []*Tag, intendedTags []*Tag, intendedNestedObjects map[string]ComparableData) []*Tag {
+	if bw.hasParent {
+		return mergeTags(actualTags, intendedTags, intendedNestedObjects)
+	}
+
+	tags := mergeTags(actualTags, bw.tagPolicy.keptTags(intendedTags, actualTags), intendedNestedObjects)
+	if len(tags) == 0 && len(intendedTags) > 0 && !slices.Contains(bw.clearedFields, TagsFieldName) {
+		bw.clearedFields = append(bw.clearedFields, TagsFieldName)
+	}
+
+	return tags
+}
@@ -105,6 +105,9 @@ type VirtualizationVirtualMachine struct {
 	Description *string                `json:"description,omitempty"`
 	Comments    *string                `json:"comments,omitempty"`
 	Tags        []*Tag                 `json:"tags,omitempty"`
+
+	// PrimaryIPAddress only matches the virtual machine to an existing one, it's not a NetBox field
+	PrimaryIPAddress *string `json:"-" mapstructure:"-" hash:"ignore"`
 }
 
 // VirtualizationVMInterface represents a Virtualization Interface
@@ -119,6 +122,16 @@ type VirtualizationVMInterface struct {
 	Tags           []*Tag                        `json:"tags,omitempty"`
 }
 
+// Validate checks if the Virtualization Interface is valid
+func (vmi *VirtualizationVMInterface) Validate() error {
+	if vmi.MACAddress != nil {
+		if _, err := normaliseMACAddress(*vmi.MACAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // VirtualizationVirtualDisk represents a Virtualization Virtual Disk
 type VirtualizationVirtualDisk struct {
 	ID             int                           `json:"id,omitempty"`
@@ -306,6 +319,8 @@ func FromProtoVirtualMachine(virtualMachinePb *diodepb.VirtualMachine) *Virtuali
 		Description: virtualMachinePb.Description,
 		Comments:    virtualMachinePb.Comments,
 		Tags:        FromProtoTags(virtualMachinePb.Tags),
+
+		PrimaryIPAddress: primaryIPAddress(virtualMachinePb.PrimaryIp4, virtualMachinePb.PrimaryIp6),
 	}
 }
 
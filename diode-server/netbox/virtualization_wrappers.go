@@ -119,6 +119,7 @@ func (vw *VirtualizationClusterGroupDataWrapper) Patch(cmp ComparableData, inten
 
 	if intended != nil {
 		vw.ClusterGroup.ID = intended.ClusterGroup.ID
+		vw.enforceFieldOwnership(vw.ClusterGroup, intended.ClusterGroup)
 		vw.ClusterGroup.Name = intended.ClusterGroup.Name
 		vw.ClusterGroup.Slug = intended.ClusterGroup.Slug
 
@@ -126,20 +127,26 @@ func (vw *VirtualizationClusterGroupDataWrapper) Patch(cmp ComparableData, inten
 			vw.ClusterGroup.Description = intended.ClusterGroup.Description
 		}
 
-		tagsToMerge := mergeTags(vw.ClusterGroup.Tags, intended.ClusterGroup.Tags, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.ClusterGroup.Tags, intended.ClusterGroup.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.ClusterGroup.Tags = tagsToMerge
 		}
 
+		vw.clearFields(vw.ClusterGroup, intended.ClusterGroup)
+
+		vw.diffFields(vw.ClusterGroup, intended.ClusterGroup)
+
 		actualHash, _ := hashstructure.Hash(vw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		vw.enforceFieldOwnership(vw.ClusterGroup, nil)
+
 		vw.SetDefaults()
 
-		tagsToMerge := mergeTags(vw.ClusterGroup.Tags, nil, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.ClusterGroup.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.ClusterGroup.Tags = tagsToMerge
@@ -272,6 +279,7 @@ func (vw *VirtualizationClusterTypeDataWrapper) Patch(cmp ComparableData, intend
 
 	if intended != nil {
 		vw.ClusterType.ID = intended.ClusterType.ID
+		vw.enforceFieldOwnership(vw.ClusterType, intended.ClusterType)
 		vw.ClusterType.Name = intended.ClusterType.Name
 		vw.ClusterType.Slug = intended.ClusterType.Slug
 
@@ -279,20 +287,26 @@ func (vw *VirtualizationClusterTypeDataWrapper) Patch(cmp ComparableData, intend
 			vw.ClusterType.Description = intended.ClusterType.Description
 		}
 
-		tagsToMerge := mergeTags(vw.ClusterType.Tags, intended.ClusterType.Tags, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.ClusterType.Tags, intended.ClusterType.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.ClusterType.Tags = tagsToMerge
 		}
 
+		vw.clearFields(vw.ClusterType, intended.ClusterType)
+
+		vw.diffFields(vw.ClusterType, intended.ClusterType)
+
 		actualHash, _ := hashstructure.Hash(vw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		vw.enforceFieldOwnership(vw.ClusterType, nil)
+
 		vw.SetDefaults()
 
-		tagsToMerge := mergeTags(vw.ClusterType.Tags, nil, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.ClusterType.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.ClusterType.Tags = tagsToMerge
@@ -480,6 +494,7 @@ func (vw *VirtualizationClusterDataWrapper) Patch(cmp ComparableData, intendedNe
 		}
 
 		vw.Cluster.ID = intended.Cluster.ID
+		vw.enforceFieldOwnership(vw.Cluster, intended.Cluster)
 		vw.Cluster.Name = intended.Cluster.Name
 
 		if vw.Cluster.Description == nil {
@@ -588,7 +603,7 @@ func (vw *VirtualizationClusterDataWrapper) Patch(cmp ComparableData, intendedNe
 
 		vw.objectsToReconcile = append(vw.objectsToReconcile, groupObjectsToReconcile...)
 
-		tagsToMerge := mergeTags(vw.Cluster.Tags, intended.Cluster.Tags, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.Cluster.Tags, intended.Cluster.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.Cluster.Tags = tagsToMerge
@@ -600,11 +615,17 @@ func (vw *VirtualizationClusterDataWrapper) Patch(cmp ComparableData, intendedNe
 			}
 		}
 
+		vw.clearFields(vw.Cluster, intended.Cluster)
+
+		vw.diffFields(vw.Cluster, intended.Cluster)
+
 		actualHash, _ := hashstructure.Hash(vw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		vw.enforceFieldOwnership(vw.Cluster, nil)
+
 		vw.SetDefaults()
 
 		siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
@@ -667,7 +688,7 @@ func (vw *VirtualizationClusterDataWrapper) Patch(cmp ComparableData, intendedNe
 
 		vw.objectsToReconcile = append(vw.objectsToReconcile, groupObjectsToReconcile...)
 
-		tagsToMerge := mergeTags(vw.Cluster.Tags, nil, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.Cluster.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.Cluster.Tags = tagsToMerge
@@ -854,6 +875,14 @@ func (vw *VirtualizationVirtualMachineDataWrapper) ObjectStateQueryParams() map[
 	return params
 }
 
+// MatchingObjectStateQueryParams returns the query parameters of each key of the matching strategy in order, skipping
+// keys the virtual machine has no value for
+func (vw *VirtualizationVirtualMachineDataWrapper) MatchingObjectStateQueryParams() []MatchingQueryParams {
+	return vw.matchingQueryParams(vw.ObjectStateQueryParams(), map[MatchingKey]*string{
+		MatchingKeyPrimaryIP: vw.VirtualMachine.PrimaryIPAddress,
+	})
+}
+
 // ID returns the ID of the data
 func (vw *VirtualizationVirtualMachineDataWrapper) ID() int {
 	return vw.VirtualMachine.ID
@@ -900,7 +929,12 @@ func (vw *VirtualizationVirtualMachineDataWrapper) Patch(cmp ComparableData, int
 		}
 
 		vw.VirtualMachine.ID = intended.VirtualMachine.ID
-		vw.VirtualMachine.Name = intended.VirtualMachine.Name
+		vw.enforceFieldOwnership(vw.VirtualMachine, intended.VirtualMachine)
+
+		// keep the ingested name of a virtual machine matched by another key, so it gets renamed
+		if vw.matchedByName() {
+			vw.VirtualMachine.Name = intended.VirtualMachine.Name
+		}
 
 		if vw.VirtualMachine.Status == nil || *vw.VirtualMachine.Status == "" {
 			vw.VirtualMachine.Status = intended.VirtualMachine.Status
@@ -1115,7 +1149,7 @@ func (vw *VirtualizationVirtualMachineDataWrapper) Patch(cmp ComparableData, int
 			vw.VirtualMachine.Description = intended.VirtualMachine.Description
 		}
 
-		tagsToMerge := mergeTags(vw.VirtualMachine.Tags, intended.VirtualMachine.Tags, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.VirtualMachine.Tags, intended.VirtualMachine.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.VirtualMachine.Tags = tagsToMerge
@@ -1127,11 +1161,17 @@ func (vw *VirtualizationVirtualMachineDataWrapper) Patch(cmp ComparableData, int
 			}
 		}
 
+		vw.clearFields(vw.VirtualMachine, intended.VirtualMachine)
+
+		vw.diffFields(vw.VirtualMachine, intended.VirtualMachine)
+
 		actualHash, _ := hashstructure.Hash(vw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		vw.enforceFieldOwnership(vw.VirtualMachine, nil)
+
 		vw.SetDefaults()
 
 		siteObjectsToReconcile, siteErr := actualSite.Patch(intendedSite, intendedNestedObjects)
@@ -1240,7 +1280,7 @@ func (vw *VirtualizationVirtualMachineDataWrapper) Patch(cmp ComparableData, int
 			vw.objectsToReconcile = append(vw.objectsToReconcile, deviceObjectsToReconcile...)
 		}
 
-		tagsToMerge := mergeTags(vw.VirtualMachine.Tags, nil, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.VirtualMachine.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.VirtualMachine.Tags = tagsToMerge
@@ -1303,6 +1343,13 @@ func (vw *VirtualizationVMInterfaceDataWrapper) IsValid() bool {
 	if vw.VMInterface != nil && !vw.hasParent && vw.VMInterface.Name == "" {
 		vw.VMInterface = nil
 	}
+
+	if vw.VMInterface != nil {
+		if err := vw.VMInterface.Validate(); err != nil {
+			return false
+		}
+	}
+
 	return vw.VMInterface != nil
 }
 
@@ -1311,6 +1358,11 @@ func (vw *VirtualizationVMInterfaceDataWrapper) Normalise() {
 	if vw.IsValid() && vw.VMInterface.Tags != nil && len(vw.VMInterface.Tags) == 0 {
 		vw.VMInterface.Tags = nil
 	}
+	if vw.VMInterface != nil {
+		if macAddress, err := normaliseMACAddressPtr(vw.VMInterface.MACAddress); err == nil {
+			vw.VMInterface.MACAddress = macAddress
+		}
+	}
 	vw.intended = true
 }
 
@@ -1335,6 +1387,12 @@ func (vw *VirtualizationVMInterfaceDataWrapper) NestedObjects() ([]ComparableDat
 		vw.placeholder = true
 	}
 
+	macAddress, err := normaliseMACAddressPtr(vw.VMInterface.MACAddress)
+	if err != nil {
+		return nil, err
+	}
+	vw.VMInterface.MACAddress = macAddress
+
 	virtualMachine := VirtualizationVirtualMachineDataWrapper{VirtualMachine: vw.VMInterface.VirtualMachine, BaseDataWrapper: BaseDataWrapper{placeholder: vw.placeholder, hasParent: true, intended: vw.intended}}
 
 	vmo, err := virtualMachine.NestedObjects()
@@ -1382,6 +1440,39 @@ func (vw *VirtualizationVMInterfaceDataWrapper) ObjectStateQueryParams() map[str
 	return params
 }
 
+// FallbackObjectStateQueryParams returns the query parameters needed to retrieve its object state by MAC address
+func (vw *VirtualizationVMInterfaceDataWrapper) FallbackObjectStateQueryParams() map[string]string {
+	if vw.VMInterface.MACAddress == nil {
+		return nil
+	}
+	params := map[string]string{
+		"mac_address": *vw.VMInterface.MACAddress,
+	}
+	if vw.VMInterface.VirtualMachine != nil {
+		params["virtual_machine__name"] = vw.VMInterface.VirtualMachine.Name
+
+		if vw.VMInterface.VirtualMachine.Site != nil {
+			params["virtual_machine__site__name"] = vw.VMInterface.VirtualMachine.Site.Name
+		}
+	}
+	return params
+}
+
+// NormaliseInterfaceName canonicalises the interface name per the naming convention of the platform of its virtual
+// machine, virtual machine interfaces have no label to preserve the ingested name in
+func (vw *VirtualizationVMInterfaceDataWrapper) NormaliseInterfaceName(naming *InterfaceNaming) {
+	if vw.VMInterface == nil {
+		return
+	}
+
+	var platform, manufacturer string
+	if vw.VMInterface.VirtualMachine != nil {
+		platform, manufacturer = platformAndManufacturerNames(vw.VMInterface.VirtualMachine.Platform, nil)
+	}
+
+	vw.VMInterface.Name = naming.Convention(platform, manufacturer).CanonicalName(vw.VMInterface.Name)
+}
+
 // ID returns the ID of the data
 func (vw *VirtualizationVMInterfaceDataWrapper) ID() int {
 	return vw.VMInterface.ID
@@ -1416,7 +1507,12 @@ func (vw *VirtualizationVMInterfaceDataWrapper) Patch(cmp ComparableData, intend
 		}
 
 		vw.VMInterface.ID = intended.VMInterface.ID
-		vw.VMInterface.Name = intended.VMInterface.Name
+		vw.enforceFieldOwnership(vw.VMInterface, intended.VMInterface)
+
+		// keep the ingested name of an interface matched by MAC address, so it gets renamed
+		if slug.Make(vw.VMInterface.Name) == slug.Make(intended.VMInterface.Name) {
+			vw.VMInterface.Name = intended.VMInterface.Name
+		}
 
 		if actualVirtualMachine.IsPlaceholder() && intended.VMInterface.VirtualMachine != nil {
 			intendedVirtualMachine = extractFromObjectsMap(currentNestedObjectsMap, fmt.Sprintf("%p", intended.VMInterface.VirtualMachine))
@@ -1468,7 +1564,7 @@ func (vw *VirtualizationVMInterfaceDataWrapper) Patch(cmp ComparableData, intend
 			vw.VMInterface.Description = intended.VMInterface.Description
 		}
 
-		tagsToMerge := mergeTags(vw.VMInterface.Tags, intended.VMInterface.Tags, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.VMInterface.Tags, intended.VMInterface.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.VMInterface.Tags = tagsToMerge
@@ -1480,11 +1576,17 @@ func (vw *VirtualizationVMInterfaceDataWrapper) Patch(cmp ComparableData, intend
 			}
 		}
 
+		vw.clearFields(vw.VMInterface, intended.VMInterface)
+
+		vw.diffFields(vw.VMInterface, intended.VMInterface)
+
 		actualHash, _ := hashstructure.Hash(vw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		vw.enforceFieldOwnership(vw.VMInterface, nil)
+
 		vw.SetDefaults()
 
 		virtualMachineObjectsToReconcile, virtualMachineErr := actualVirtualMachine.Patch(intendedVirtualMachine, intendedNestedObjects)
@@ -1507,7 +1609,7 @@ func (vw *VirtualizationVMInterfaceDataWrapper) Patch(cmp ComparableData, intend
 
 		vw.objectsToReconcile = append(vw.objectsToReconcile, virtualMachineObjectsToReconcile...)
 
-		tagsToMerge := mergeTags(vw.VMInterface.Tags, nil, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.VMInterface.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.VMInterface.Tags = tagsToMerge
@@ -1672,6 +1774,7 @@ func (vw *VirtualizationVirtualDiskDataWrapper) Patch(cmp ComparableData, intend
 		}
 
 		vw.VirtualDisk.ID = intended.VirtualDisk.ID
+		vw.enforceFieldOwnership(vw.VirtualDisk, intended.VirtualDisk)
 		vw.VirtualDisk.Name = intended.VirtualDisk.Name
 
 		if actualVirtualMachine.IsPlaceholder() && intended.VirtualDisk.VirtualMachine != nil {
@@ -1712,7 +1815,7 @@ func (vw *VirtualizationVirtualDiskDataWrapper) Patch(cmp ComparableData, intend
 			vw.VirtualDisk.Description = intended.VirtualDisk.Description
 		}
 
-		tagsToMerge := mergeTags(vw.VirtualDisk.Tags, intended.VirtualDisk.Tags, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.VirtualDisk.Tags, intended.VirtualDisk.Tags, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.VirtualDisk.Tags = tagsToMerge
@@ -1724,11 +1827,17 @@ func (vw *VirtualizationVirtualDiskDataWrapper) Patch(cmp ComparableData, intend
 			}
 		}
 
+		vw.clearFields(vw.VirtualDisk, intended.VirtualDisk)
+
+		vw.diffFields(vw.VirtualDisk, intended.VirtualDisk)
+
 		actualHash, _ := hashstructure.Hash(vw.Data(), hashstructure.FormatV2, nil)
 		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
 
 		reconciliationRequired = actualHash != intendedHash
 	} else {
+		vw.enforceFieldOwnership(vw.VirtualDisk, nil)
+
 		vw.SetDefaults()
 
 		virtualMachineObjectsToReconcile, virtualMachineErr := actualVirtualMachine.Patch(intendedVirtualMachine, intendedNestedObjects)
@@ -1751,7 +1860,7 @@ func (vw *VirtualizationVirtualDiskDataWrapper) Patch(cmp ComparableData, intend
 
 		vw.objectsToReconcile = append(vw.objectsToReconcile, virtualMachineObjectsToReconcile...)
 
-		tagsToMerge := mergeTags(vw.VirtualDisk.Tags, nil, intendedNestedObjects)
+		tagsToMerge := vw.mergeTags(vw.VirtualDisk.Tags, nil, intendedNestedObjects)
 
 		if len(tagsToMerge) > 0 {
 			vw.VirtualDisk.Tags = tagsToMerge
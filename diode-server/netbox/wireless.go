@@ -0,0 +1,319 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+)
+
+const (
+	// WirelessLANObjectType represents the Wireless LAN object type
+	WirelessLANObjectType = "wireless.wirelesslan"
+
+	// WirelessLANGroupObjectType represents the Wireless LAN Group object type
+	WirelessLANGroupObjectType = "wireless.wirelesslangroup"
+
+	// WirelessLinkObjectType represents the Wireless Link object type
+	WirelessLinkObjectType = "wireless.wirelesslink"
+)
+
+var (
+	// ErrInvalidWirelessLANStatus is returned when the wireless LAN status is invalid
+	ErrInvalidWirelessLANStatus = errors.New("invalid wireless LAN status")
+
+	// ErrInvalidWirelessLinkStatus is returned when the wireless link status is invalid
+	ErrInvalidWirelessLinkStatus = errors.New("invalid wireless link status")
+
+	// ErrInvalidWirelessAuthType is returned when the wireless authentication type is invalid
+	ErrInvalidWirelessAuthType = errors.New("invalid wireless authentication type")
+
+	// ErrInvalidWirelessAuthCipher is returned when the wireless authentication cipher is invalid
+	ErrInvalidWirelessAuthCipher = errors.New("invalid wireless authentication cipher")
+
+	// ErrInvalidWirelessLinkDistanceUnit is returned when the wireless link distance unit is invalid
+	ErrInvalidWirelessLinkDistanceUnit = errors.New("invalid wireless link distance unit")
+
+	// DefaultWirelessLANStatus is the default status for a wireless LAN
+	DefaultWirelessLANStatus = "active"
+
+	// DefaultWirelessLinkStatus is the default status for a wireless link
+	DefaultWirelessLinkStatus = "connected"
+)
+
+var wirelessLANStatusMap = map[string]struct{}{
+	"active":     {},
+	"reserved":   {},
+	"disabled":   {},
+	"deprecated": {},
+}
+
+var wirelessLinkStatusMap = map[string]struct{}{
+	"connected":       {},
+	"planned":         {},
+	"decommissioning": {},
+}
+
+var wirelessAuthTypeMap = map[string]struct{}{
+	"open":           {},
+	"wep":            {},
+	"wpa-personal":   {},
+	"wpa-enterprise": {},
+}
+
+var wirelessAuthCipherMap = map[string]struct{}{
+	"auto": {},
+	"tkip": {},
+	"aes":  {},
+}
+
+var wirelessLinkDistanceUnitMap = map[string]struct{}{
+	"km": {},
+	"m":  {},
+	"mi": {},
+	"ft": {},
+}
+
+func validateWirelessLANStatus(s string) bool {
+	_, ok := wirelessLANStatusMap[s]
+	return ok
+}
+
+func validateWirelessLinkStatus(s string) bool {
+	_, ok := wirelessLinkStatusMap[s]
+	return ok
+}
+
+func validateWirelessAuthType(t string) bool {
+	_, ok := wirelessAuthTypeMap[t]
+	return ok
+}
+
+func validateWirelessAuthCipher(c string) bool {
+	_, ok := wirelessAuthCipherMap[c]
+	return ok
+}
+
+func validateWirelessLinkDistanceUnit(u string) bool {
+	_, ok := wirelessLinkDistanceUnitMap[u]
+	return ok
+}
+
+// WirelessLANGroup represents a Wireless LAN Group
+type WirelessLANGroup struct {
+	ID          int     `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Slug        string  `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Tags        []*Tag  `json:"tags,omitempty"`
+}
+
+// WirelessLAN represents a Wireless LAN
+type WirelessLAN struct {
+	ID          int               `json:"id,omitempty"`
+	SSID        string            `json:"ssid,omitempty" mapstructure:"ssid"`
+	Group       *WirelessLANGroup `json:"group,omitempty" mapstructure:"group"`
+	Status      *string           `json:"status,omitempty"`
+	AuthType    *string           `json:"auth_type,omitempty" mapstructure:"auth_type"`
+	AuthCipher  *string           `json:"auth_cipher,omitempty" mapstructure:"auth_cipher"`
+	AuthPSK     *string           `json:"auth_psk,omitempty" mapstructure:"auth_psk"`
+	Description *string           `json:"description,omitempty"`
+	Comments    *string           `json:"comments,omitempty"`
+	Tags        []*Tag            `json:"tags,omitempty"`
+}
+
+// Validate checks if the Wireless LAN is valid
+func (w *WirelessLAN) Validate() error {
+	if w.Status != nil && !validateWirelessLANStatus(*w.Status) {
+		return ErrInvalidWirelessLANStatus
+	}
+	if w.AuthType != nil && !validateWirelessAuthType(*w.AuthType) {
+		return ErrInvalidWirelessAuthType
+	}
+	if w.AuthCipher != nil && !validateWirelessAuthCipher(*w.AuthCipher) {
+		return ErrInvalidWirelessAuthCipher
+	}
+	return nil
+}
+
+// WirelessLink represents a Wireless Link
+type WirelessLink struct {
+	ID           int            `json:"id,omitempty"`
+	InterfaceA   *DcimInterface `json:"interface_a,omitempty" mapstructure:"interface_a"`
+	InterfaceB   *DcimInterface `json:"interface_b,omitempty" mapstructure:"interface_b"`
+	SSID         *string        `json:"ssid,omitempty" mapstructure:"ssid"`
+	Status       *string        `json:"status,omitempty"`
+	AuthType     *string        `json:"auth_type,omitempty" mapstructure:"auth_type"`
+	AuthCipher   *string        `json:"auth_cipher,omitempty" mapstructure:"auth_cipher"`
+	AuthPSK      *string        `json:"auth_psk,omitempty" mapstructure:"auth_psk"`
+	Distance     *float64       `json:"distance,omitempty"`
+	DistanceUnit *string        `json:"distance_unit,omitempty" mapstructure:"distance_unit"`
+	Description  *string        `json:"description,omitempty"`
+	Comments     *string        `json:"comments,omitempty"`
+	Tags         []*Tag         `json:"tags,omitempty"`
+}
+
+// Validate checks if the Wireless Link is valid
+func (l *WirelessLink) Validate() error {
+	if l.Status != nil && !validateWirelessLinkStatus(*l.Status) {
+		return ErrInvalidWirelessLinkStatus
+	}
+	if l.AuthType != nil && !validateWirelessAuthType(*l.AuthType) {
+		return ErrInvalidWirelessAuthType
+	}
+	if l.AuthCipher != nil && !validateWirelessAuthCipher(*l.AuthCipher) {
+		return ErrInvalidWirelessAuthCipher
+	}
+	if l.DistanceUnit != nil && !validateWirelessLinkDistanceUnit(*l.DistanceUnit) {
+		return ErrInvalidWirelessLinkDistanceUnit
+	}
+	return nil
+}
+
+// NewWirelessLANGroup creates a new wireless LAN group placeholder
+func NewWirelessLANGroup() *WirelessLANGroup {
+	return &WirelessLANGroup{
+		Name: "undefined",
+		Slug: "undefined",
+	}
+}
+
+// NewWirelessLAN creates a new wireless LAN placeholder
+func NewWirelessLAN() *WirelessLAN {
+	status := DefaultWirelessLANStatus
+	return &WirelessLAN{
+		SSID:   "undefined",
+		Status: &status,
+	}
+}
+
+// FromProtoWirelessLANGroupEntity converts a diode wireless LAN group entity to a wireless LAN group
+func FromProtoWirelessLANGroupEntity(entity *diodepb.Entity) (*WirelessLANGroup, error) {
+	if entity == nil || entity.GetWirelessLanGroup() == nil {
+		return nil, fmt.Errorf("entity is nil or not a wireless LAN group")
+	}
+
+	return FromProtoWirelessLANGroup(entity.GetWirelessLanGroup()), nil
+}
+
+// FromProtoWirelessLANGroup converts a diode wireless LAN group to a wireless LAN group
+func FromProtoWirelessLANGroup(wirelessLANGroupPb *diodepb.WirelessLANGroup) *WirelessLANGroup {
+	if wirelessLANGroupPb == nil {
+		return nil
+	}
+
+	return &WirelessLANGroup{
+		Name:        wirelessLANGroupPb.Name,
+		Slug:        wirelessLANGroupPb.Slug,
+		Description: wirelessLANGroupPb.Description,
+		Tags:        FromProtoTags(wirelessLANGroupPb.Tags),
+	}
+}
+
+// FromProtoWirelessLANEntity converts a diode wireless LAN entity to a wireless LAN
+func FromProtoWirelessLANEntity(entity *diodepb.Entity) (*WirelessLAN, error) {
+	if entity == nil || entity.GetWirelessLan() == nil {
+		return nil, fmt.Errorf("entity is nil or not a wireless LAN")
+	}
+
+	return FromProtoWirelessLAN(entity.GetWirelessLan()), nil
+}
+
+// FromProtoWirelessLAN converts a diode wireless LAN to a wireless LAN
+func FromProtoWirelessLAN(wirelessLANPb *diodepb.WirelessLAN) *WirelessLAN {
+	if wirelessLANPb == nil {
+		return nil
+	}
+
+	var status *string
+	if wirelessLANPb.Status != "" {
+		status = &wirelessLANPb.Status
+	}
+
+	var authType *string
+	if wirelessLANPb.AuthType != "" {
+		authType = &wirelessLANPb.AuthType
+	}
+
+	var authCipher *string
+	if wirelessLANPb.AuthCipher != "" {
+		authCipher = &wirelessLANPb.AuthCipher
+	}
+
+	return &WirelessLAN{
+		SSID:        wirelessLANPb.Ssid,
+		Group:       FromProtoWirelessLANGroup(wirelessLANPb.Group),
+		Status:      status,
+		AuthType:    authType,
+		AuthCipher:  authCipher,
+		AuthPSK:     wirelessLANPb.AuthPsk,
+		Description: wirelessLANPb.Description,
+		Comments:    wirelessLANPb.Comments,
+		Tags:        FromProtoTags(wirelessLANPb.Tags),
+	}
+}
+
+// FromProtoWirelessLANs converts a slice of diode wireless LANs to a slice of wireless LANs
+func FromProtoWirelessLANs(wirelessLANsPb []*diodepb.WirelessLAN) []*WirelessLAN {
+	if wirelessLANsPb == nil {
+		return nil
+	}
+
+	var wirelessLANs []*WirelessLAN
+	for _, wirelessLANPb := range wirelessLANsPb {
+		wirelessLANs = append(wirelessLANs, FromProtoWirelessLAN(wirelessLANPb))
+	}
+
+	return wirelessLANs
+}
+
+// FromProtoWirelessLinkEntity converts a diode wireless link entity to a wireless link
+func FromProtoWirelessLinkEntity(entity *diodepb.Entity) (*WirelessLink, error) {
+	if entity == nil || entity.GetWirelessLink() == nil {
+		return nil, fmt.Errorf("entity is nil or not a wireless link")
+	}
+
+	return FromProtoWirelessLink(entity.GetWirelessLink()), nil
+}
+
+// FromProtoWirelessLink converts a diode wireless link to a wireless link
+func FromProtoWirelessLink(wirelessLinkPb *diodepb.WirelessLink) *WirelessLink {
+	if wirelessLinkPb == nil {
+		return nil
+	}
+
+	var status *string
+	if wirelessLinkPb.Status != "" {
+		status = &wirelessLinkPb.Status
+	}
+
+	var authType *string
+	if wirelessLinkPb.AuthType != "" {
+		authType = &wirelessLinkPb.AuthType
+	}
+
+	var authCipher *string
+	if wirelessLinkPb.AuthCipher != "" {
+		authCipher = &wirelessLinkPb.AuthCipher
+	}
+
+	var distanceUnit *string
+	if wirelessLinkPb.DistanceUnit != "" {
+		distanceUnit = &wirelessLinkPb.DistanceUnit
+	}
+
+	return &WirelessLink{
+		InterfaceA:   FromProtoInterface(wirelessLinkPb.InterfaceA),
+		InterfaceB:   FromProtoInterface(wirelessLinkPb.InterfaceB),
+		SSID:         wirelessLinkPb.Ssid,
+		Status:       status,
+		AuthType:     authType,
+		AuthCipher:   authCipher,
+		AuthPSK:      wirelessLinkPb.AuthPsk,
+		Distance:     wirelessLinkPb.Distance,
+		DistanceUnit: distanceUnit,
+		Description:  wirelessLinkPb.Description,
+		Comments:     wirelessLinkPb.Comments,
+		Tags:         FromProtoTags(wirelessLinkPb.Tags),
+	}
+}
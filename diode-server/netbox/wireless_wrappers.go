@@ -0,0 +1,820 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gosimple/slug"
+	"github.com/mitchellh/hashstructure/v2"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+)
+
+// WirelessLANGroupDataWrapper represents a wireless LAN group data wrapper
+type WirelessLANGroupDataWrapper struct {
+	BaseDataWrapper
+	WirelessLANGroup *WirelessLANGroup
+}
+
+func (*WirelessLANGroupDataWrapper) comparableData() {}
+
+// FromProtoEntity sets the data from a proto entity
+func (ww *WirelessLANGroupDataWrapper) FromProtoEntity(entity *diodepb.Entity) error {
+	wirelessLANGroup, err := FromProtoWirelessLANGroupEntity(entity)
+	if err != nil {
+		return err
+	}
+	ww.WirelessLANGroup = wirelessLANGroup
+	return nil
+}
+
+// Data returns the WirelessLANGroup
+func (ww *WirelessLANGroupDataWrapper) Data() any {
+	return ww.WirelessLANGroup
+}
+
+// IsValid returns true if the WirelessLANGroup is not nil
+func (ww *WirelessLANGroupDataWrapper) IsValid() bool {
+	if ww.WirelessLANGroup != nil && !ww.hasParent && ww.WirelessLANGroup.Name == "" {
+		ww.WirelessLANGroup = nil
+	}
+	return ww.WirelessLANGroup != nil
+}
+
+// Normalise normalises the data
+func (ww *WirelessLANGroupDataWrapper) Normalise() {
+	if ww.IsValid() && ww.WirelessLANGroup.Tags != nil && len(ww.WirelessLANGroup.Tags) == 0 {
+		ww.WirelessLANGroup.Tags = nil
+	}
+	ww.intended = true
+}
+
+// NestedObjects returns all nested objects
+func (ww *WirelessLANGroupDataWrapper) NestedObjects() ([]ComparableData, error) {
+	if len(ww.nestedObjects) > 0 {
+		return ww.nestedObjects, nil
+	}
+
+	if ww.WirelessLANGroup != nil && ww.hasParent && ww.WirelessLANGroup.Name == "" {
+		ww.WirelessLANGroup = nil
+	}
+
+	objects := make([]ComparableData, 0)
+
+	if ww.WirelessLANGroup == nil && ww.intended {
+		return objects, nil
+	}
+
+	if ww.WirelessLANGroup == nil && ww.hasParent {
+		ww.WirelessLANGroup = NewWirelessLANGroup()
+		ww.placeholder = true
+	}
+
+	if ww.WirelessLANGroup.Slug == "" {
+		ww.WirelessLANGroup.Slug = slug.Make(ww.WirelessLANGroup.Name)
+	}
+
+	if ww.WirelessLANGroup.Tags != nil {
+		for _, t := range ww.WirelessLANGroup.Tags {
+			if t.Slug == "" {
+				t.Slug = slug.Make(t.Name)
+			}
+			objects = append(objects, &TagDataWrapper{Tag: t, hasParent: true})
+		}
+	}
+
+	ww.nestedObjects = objects
+
+	objects = append(objects, ww)
+
+	return objects, nil
+}
+
+// DataType returns the data type
+func (ww *WirelessLANGroupDataWrapper) DataType() string {
+	return WirelessLANGroupObjectType
+}
+
+// ObjectStateQueryParams returns the query parameters needed to retrieve its object state
+func (ww *WirelessLANGroupDataWrapper) ObjectStateQueryParams() map[string]string {
+	return map[string]string{
+		"q": ww.WirelessLANGroup.Name,
+	}
+}
+
+// ID returns the ID of the data
+func (ww *WirelessLANGroupDataWrapper) ID() int {
+	return ww.WirelessLANGroup.ID
+}
+
+// Patch creates patches between the actual, intended and current data
+func (ww *WirelessLANGroupDataWrapper) Patch(cmp ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
+	intended, ok := cmp.(*WirelessLANGroupDataWrapper)
+	if !ok && intended != nil {
+		return nil, errors.New("invalid data type")
+	}
+
+	reconciliationRequired := true
+
+	if intended != nil {
+		ww.WirelessLANGroup.ID = intended.WirelessLANGroup.ID
+		ww.enforceFieldOwnership(ww.WirelessLANGroup, intended.WirelessLANGroup)
+		ww.WirelessLANGroup.Name = intended.WirelessLANGroup.Name
+		ww.WirelessLANGroup.Slug = intended.WirelessLANGroup.Slug
+
+		if ww.WirelessLANGroup.Description == nil {
+			ww.WirelessLANGroup.Description = intended.WirelessLANGroup.Description
+		}
+
+		tagsToMerge := ww.mergeTags(ww.WirelessLANGroup.Tags, intended.WirelessLANGroup.Tags, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			ww.WirelessLANGroup.Tags = tagsToMerge
+		}
+
+		ww.clearFields(ww.WirelessLANGroup, intended.WirelessLANGroup)
+
+		ww.diffFields(ww.WirelessLANGroup, intended.WirelessLANGroup)
+
+		actualHash, _ := hashstructure.Hash(ww.Data(), hashstructure.FormatV2, nil)
+		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
+
+		reconciliationRequired = actualHash != intendedHash
+	} else {
+		ww.enforceFieldOwnership(ww.WirelessLANGroup, nil)
+
+		ww.SetDefaults()
+
+		tagsToMerge := ww.mergeTags(ww.WirelessLANGroup.Tags, nil, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			ww.WirelessLANGroup.Tags = tagsToMerge
+		}
+	}
+
+	for _, t := range ww.WirelessLANGroup.Tags {
+		if t.ID == 0 {
+			ww.objectsToReconcile = append(ww.objectsToReconcile, &TagDataWrapper{Tag: t, hasParent: true})
+		}
+	}
+
+	if reconciliationRequired {
+		ww.hasChanged = true
+		ww.objectsToReconcile = append(ww.objectsToReconcile, ww)
+	}
+
+	return ww.objectsToReconcile, nil
+}
+
+// SetDefaults sets the default values for the wireless LAN group
+func (ww *WirelessLANGroupDataWrapper) SetDefaults() {}
+
+// WirelessLANDataWrapper represents a wireless LAN data wrapper
+type WirelessLANDataWrapper struct {
+	BaseDataWrapper
+	WirelessLAN *WirelessLAN
+}
+
+func (*WirelessLANDataWrapper) comparableData() {}
+
+// FromProtoEntity sets the data from a proto entity
+func (ww *WirelessLANDataWrapper) FromProtoEntity(entity *diodepb.Entity) error {
+	wirelessLAN, err := FromProtoWirelessLANEntity(entity)
+	if err != nil {
+		return err
+	}
+	ww.WirelessLAN = wirelessLAN
+	return nil
+}
+
+// Data returns the WirelessLAN
+func (ww *WirelessLANDataWrapper) Data() any {
+	return ww.WirelessLAN
+}
+
+// IsValid returns true if the WirelessLAN is not nil
+func (ww *WirelessLANDataWrapper) IsValid() bool {
+	if ww.WirelessLAN != nil && !ww.hasParent && ww.WirelessLAN.SSID == "" {
+		ww.WirelessLAN = nil
+	}
+
+	if ww.WirelessLAN != nil {
+		if err := ww.WirelessLAN.Validate(); err != nil {
+			return false
+		}
+	}
+
+	return ww.WirelessLAN != nil
+}
+
+// Normalise normalises the data
+func (ww *WirelessLANDataWrapper) Normalise() {
+	if ww.IsValid() && ww.WirelessLAN.Tags != nil && len(ww.WirelessLAN.Tags) == 0 {
+		ww.WirelessLAN.Tags = nil
+	}
+	ww.intended = true
+}
+
+// NestedObjects returns all nested objects
+func (ww *WirelessLANDataWrapper) NestedObjects() ([]ComparableData, error) {
+	if len(ww.nestedObjects) > 0 {
+		return ww.nestedObjects, nil
+	}
+
+	if ww.WirelessLAN != nil && ww.hasParent && ww.WirelessLAN.SSID == "" {
+		ww.WirelessLAN = nil
+	}
+
+	objects := make([]ComparableData, 0)
+
+	if ww.WirelessLAN == nil && ww.intended {
+		return objects, nil
+	}
+
+	if ww.WirelessLAN == nil && ww.hasParent {
+		ww.WirelessLAN = NewWirelessLAN()
+		ww.placeholder = true
+	}
+
+	if ww.WirelessLAN.Group != nil {
+		group := WirelessLANGroupDataWrapper{WirelessLANGroup: ww.WirelessLAN.Group, BaseDataWrapper: BaseDataWrapper{placeholder: ww.placeholder, hasParent: true, intended: ww.intended}}
+
+		gro, err := group.NestedObjects()
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, gro...)
+
+		ww.WirelessLAN.Group = group.WirelessLANGroup
+	}
+
+	if ww.WirelessLAN.Tags != nil {
+		for _, t := range ww.WirelessLAN.Tags {
+			if t.Slug == "" {
+				t.Slug = slug.Make(t.Name)
+			}
+			objects = append(objects, &TagDataWrapper{Tag: t, hasParent: true})
+		}
+	}
+
+	ww.nestedObjects = objects
+
+	objects = append(objects, ww)
+
+	return objects, nil
+}
+
+// DataType returns the data type
+func (ww *WirelessLANDataWrapper) DataType() string {
+	return WirelessLANObjectType
+}
+
+// ObjectStateQueryParams returns the query parameters needed to retrieve its object state
+func (ww *WirelessLANDataWrapper) ObjectStateQueryParams() map[string]string {
+	params := map[string]string{
+		"q": ww.WirelessLAN.SSID,
+	}
+	if ww.WirelessLAN.Group != nil {
+		params["group__name"] = ww.WirelessLAN.Group.Name
+	}
+	return params
+}
+
+// ID returns the ID of the data
+func (ww *WirelessLANDataWrapper) ID() int {
+	return ww.WirelessLAN.ID
+}
+
+// Patch creates patches between the actual, intended and current data
+func (ww *WirelessLANDataWrapper) Patch(cmp ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
+	intended, ok := cmp.(*WirelessLANDataWrapper)
+	if !ok && intended != nil {
+		return nil, errors.New("invalid data type")
+	}
+
+	actualNestedObjectsMap := make(map[string]ComparableData)
+	for _, obj := range ww.nestedObjects {
+		actualNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
+	}
+
+	actualGroup := extractFromObjectsMap(actualNestedObjectsMap, fmt.Sprintf("%p", ww.WirelessLAN.Group))
+	intendedGroup := extractFromObjectsMap(intendedNestedObjects, fmt.Sprintf("%p", ww.WirelessLAN.Group))
+
+	reconciliationRequired := true
+
+	if intended != nil {
+		currentNestedObjectsMap := make(map[string]ComparableData)
+		currentNestedObjects, err := intended.NestedObjects()
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range currentNestedObjects {
+			currentNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
+		}
+
+		ww.WirelessLAN.ID = intended.WirelessLAN.ID
+		ww.enforceFieldOwnership(ww.WirelessLAN, intended.WirelessLAN)
+		ww.WirelessLAN.SSID = intended.WirelessLAN.SSID
+
+		if actualGroup != nil {
+			if actualGroup.IsPlaceholder() && intended.WirelessLAN.Group != nil {
+				intendedGroup = extractFromObjectsMap(currentNestedObjectsMap, fmt.Sprintf("%p", intended.WirelessLAN.Group))
+			}
+
+			groupObjectsToReconcile, groupErr := actualGroup.Patch(intendedGroup, intendedNestedObjects)
+			if groupErr != nil {
+				return nil, groupErr
+			}
+
+			group, err := copyData(actualGroup.Data().(*WirelessLANGroup))
+			if err != nil {
+				return nil, err
+			}
+			group.Tags = nil
+
+			if !actualGroup.HasChanged() {
+				group = &WirelessLANGroup{
+					ID: actualGroup.ID(),
+				}
+
+				intendedGroupID := intendedGroup.ID()
+				if intended.WirelessLAN.Group != nil {
+					intendedGroupID = intended.WirelessLAN.Group.ID
+				}
+
+				intended.WirelessLAN.Group = &WirelessLANGroup{
+					ID: intendedGroupID,
+				}
+			}
+
+			ww.WirelessLAN.Group = group
+
+			ww.objectsToReconcile = append(ww.objectsToReconcile, groupObjectsToReconcile...)
+		} else {
+			if intended.WirelessLAN.Group != nil {
+				groupID := intended.WirelessLAN.Group.ID
+				ww.WirelessLAN.Group = &WirelessLANGroup{
+					ID: groupID,
+				}
+				intended.WirelessLAN.Group = &WirelessLANGroup{
+					ID: groupID,
+				}
+			}
+		}
+
+		if ww.WirelessLAN.Status == nil {
+			ww.WirelessLAN.Status = intended.WirelessLAN.Status
+		}
+
+		if ww.WirelessLAN.AuthType == nil {
+			ww.WirelessLAN.AuthType = intended.WirelessLAN.AuthType
+		}
+
+		if ww.WirelessLAN.AuthCipher == nil {
+			ww.WirelessLAN.AuthCipher = intended.WirelessLAN.AuthCipher
+		}
+
+		if ww.WirelessLAN.AuthPSK == nil {
+			ww.WirelessLAN.AuthPSK = intended.WirelessLAN.AuthPSK
+		}
+
+		if ww.WirelessLAN.Description == nil {
+			ww.WirelessLAN.Description = intended.WirelessLAN.Description
+		}
+
+		if ww.WirelessLAN.Comments == nil {
+			ww.WirelessLAN.Comments = intended.WirelessLAN.Comments
+		}
+
+		tagsToMerge := ww.mergeTags(ww.WirelessLAN.Tags, intended.WirelessLAN.Tags, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			ww.WirelessLAN.Tags = tagsToMerge
+		}
+
+		for _, t := range ww.WirelessLAN.Tags {
+			if t.ID == 0 {
+				ww.objectsToReconcile = append(ww.objectsToReconcile, &TagDataWrapper{Tag: t, hasParent: true})
+			}
+		}
+
+		ww.clearFields(ww.WirelessLAN, intended.WirelessLAN)
+
+		ww.diffFields(ww.WirelessLAN, intended.WirelessLAN)
+
+		actualHash, _ := hashstructure.Hash(ww.Data(), hashstructure.FormatV2, nil)
+		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
+
+		reconciliationRequired = actualHash != intendedHash
+	} else {
+		ww.enforceFieldOwnership(ww.WirelessLAN, nil)
+
+		ww.SetDefaults()
+
+		if actualGroup != nil {
+			groupObjectsToReconcile, groupErr := actualGroup.Patch(intendedGroup, intendedNestedObjects)
+			if groupErr != nil {
+				return nil, groupErr
+			}
+
+			group, err := copyData(actualGroup.Data().(*WirelessLANGroup))
+			if err != nil {
+				return nil, err
+			}
+			group.Tags = nil
+
+			if !actualGroup.HasChanged() {
+				group = &WirelessLANGroup{
+					ID: actualGroup.ID(),
+				}
+			}
+			ww.WirelessLAN.Group = group
+
+			ww.objectsToReconcile = append(ww.objectsToReconcile, groupObjectsToReconcile...)
+		}
+
+		tagsToMerge := ww.mergeTags(ww.WirelessLAN.Tags, nil, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			ww.WirelessLAN.Tags = tagsToMerge
+		}
+
+		for _, t := range ww.WirelessLAN.Tags {
+			if t.ID == 0 {
+				ww.objectsToReconcile = append(ww.objectsToReconcile, &TagDataWrapper{Tag: t, hasParent: true})
+			}
+		}
+	}
+
+	if reconciliationRequired {
+		ww.hasChanged = true
+		ww.objectsToReconcile = append(ww.objectsToReconcile, ww)
+	}
+
+	return ww.objectsToReconcile, nil
+}
+
+// SetDefaults sets the default values for the wireless LAN
+func (ww *WirelessLANDataWrapper) SetDefaults() {
+	if ww.WirelessLAN.Status == nil || *ww.WirelessLAN.Status == "" {
+		ww.WirelessLAN.Status = &DefaultWirelessLANStatus
+	}
+}
+
+// WirelessLinkDataWrapper represents a wireless link data wrapper
+type WirelessLinkDataWrapper struct {
+	BaseDataWrapper
+	WirelessLink *WirelessLink
+}
+
+func (*WirelessLinkDataWrapper) comparableData() {}
+
+// FromProtoEntity sets the data from a proto entity
+func (ww *WirelessLinkDataWrapper) FromProtoEntity(entity *diodepb.Entity) error {
+	wirelessLink, err := FromProtoWirelessLinkEntity(entity)
+	if err != nil {
+		return err
+	}
+	ww.WirelessLink = wirelessLink
+	return nil
+}
+
+// Data returns the WirelessLink
+func (ww *WirelessLinkDataWrapper) Data() any {
+	return ww.WirelessLink
+}
+
+// IsValid returns true if the WirelessLink is not nil
+func (ww *WirelessLinkDataWrapper) IsValid() bool {
+	if ww.WirelessLink != nil && (ww.WirelessLink.InterfaceA == nil || ww.WirelessLink.InterfaceB == nil) {
+		ww.WirelessLink = nil
+	}
+
+	if ww.WirelessLink != nil {
+		if err := ww.WirelessLink.Validate(); err != nil {
+			return false
+		}
+	}
+
+	return ww.WirelessLink != nil
+}
+
+// Normalise normalises the data
+func (ww *WirelessLinkDataWrapper) Normalise() {
+	if ww.IsValid() && ww.WirelessLink.Tags != nil && len(ww.WirelessLink.Tags) == 0 {
+		ww.WirelessLink.Tags = nil
+	}
+	ww.intended = true
+}
+
+// NestedObjects returns all nested objects
+func (ww *WirelessLinkDataWrapper) NestedObjects() ([]ComparableData, error) {
+	if len(ww.nestedObjects) > 0 {
+		return ww.nestedObjects, nil
+	}
+
+	objects := make([]ComparableData, 0)
+
+	if ww.WirelessLink == nil {
+		return objects, nil
+	}
+
+	interfaceA := DcimInterfaceDataWrapper{Interface: ww.WirelessLink.InterfaceA, BaseDataWrapper: BaseDataWrapper{placeholder: ww.placeholder, hasParent: true, intended: ww.intended}}
+
+	iao, err := interfaceA.NestedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	objects = append(objects, iao...)
+
+	ww.WirelessLink.InterfaceA = interfaceA.Interface
+
+	interfaceB := DcimInterfaceDataWrapper{Interface: ww.WirelessLink.InterfaceB, BaseDataWrapper: BaseDataWrapper{placeholder: ww.placeholder, hasParent: true, intended: ww.intended}}
+
+	ibo, err := interfaceB.NestedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	objects = append(objects, ibo...)
+
+	ww.WirelessLink.InterfaceB = interfaceB.Interface
+
+	if ww.WirelessLink.Tags != nil {
+		for _, t := range ww.WirelessLink.Tags {
+			if t.Slug == "" {
+				t.Slug = slug.Make(t.Name)
+			}
+			objects = append(objects, &TagDataWrapper{Tag: t, hasParent: true})
+		}
+	}
+
+	ww.nestedObjects = objects
+
+	objects = append(objects, ww)
+
+	return objects, nil
+}
+
+// DataType returns the data type
+func (ww *WirelessLinkDataWrapper) DataType() string {
+	return WirelessLinkObjectType
+}
+
+// ObjectStateQueryParams returns the query parameters needed to retrieve its object state
+func (ww *WirelessLinkDataWrapper) ObjectStateQueryParams() map[string]string {
+	params := make(map[string]string)
+	if ww.WirelessLink.SSID != nil {
+		params["q"] = *ww.WirelessLink.SSID
+	}
+	for prefix, interf := range map[string]*DcimInterface{"interface_a": ww.WirelessLink.InterfaceA, "interface_b": ww.WirelessLink.InterfaceB} {
+		if interf == nil {
+			continue
+		}
+		params[prefix+"__name"] = interf.Name
+		if interf.Device != nil {
+			params[prefix+"__device__name"] = interf.Device.Name
+			if interf.Device.Site != nil {
+				params[prefix+"__device__site__name"] = interf.Device.Site.Name
+			}
+		}
+	}
+	return params
+}
+
+// ID returns the ID of the data
+func (ww *WirelessLinkDataWrapper) ID() int {
+	return ww.WirelessLink.ID
+}
+
+func (ww *WirelessLinkDataWrapper) hash() string {
+	interfaceHash := func(interf *DcimInterface) string {
+		if interf == nil {
+			return ""
+		}
+		return (&DcimInterfaceDataWrapper{Interface: interf}).hash()
+	}
+	return slug.Make(fmt.Sprintf("%s-%s", interfaceHash(ww.WirelessLink.InterfaceA), interfaceHash(ww.WirelessLink.InterfaceB)))
+}
+
+// Patch creates patches between the actual, intended and current data
+func (ww *WirelessLinkDataWrapper) Patch(cmp ComparableData, intendedNestedObjects map[string]ComparableData) ([]ComparableData, error) {
+	intended, ok := cmp.(*WirelessLinkDataWrapper)
+	if !ok && intended != nil {
+		return nil, errors.New("invalid data type")
+	}
+
+	actualNestedObjectsMap := make(map[string]ComparableData)
+	for _, obj := range ww.nestedObjects {
+		actualNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
+	}
+
+	actualInterfaceA := extractFromObjectsMap(actualNestedObjectsMap, fmt.Sprintf("%p", ww.WirelessLink.InterfaceA))
+	intendedInterfaceA := extractFromObjectsMap(intendedNestedObjects, fmt.Sprintf("%p", ww.WirelessLink.InterfaceA))
+
+	actualInterfaceB := extractFromObjectsMap(actualNestedObjectsMap, fmt.Sprintf("%p", ww.WirelessLink.InterfaceB))
+	intendedInterfaceB := extractFromObjectsMap(intendedNestedObjects, fmt.Sprintf("%p", ww.WirelessLink.InterfaceB))
+
+	reconciliationRequired := true
+
+	if intended != nil && ww.hash() == intended.hash() {
+		ww.WirelessLink.ID = intended.WirelessLink.ID
+		ww.enforceFieldOwnership(ww.WirelessLink, intended.WirelessLink)
+
+		interfaceAObjectsToReconcile, interfaceA, interfaceAErr := patchNestedInterface(actualInterfaceA, intendedInterfaceA, intendedNestedObjects)
+		if interfaceAErr != nil {
+			return nil, interfaceAErr
+		}
+
+		if !actualInterfaceA.HasChanged() && intended.WirelessLink.InterfaceA != nil {
+			intended.WirelessLink.InterfaceA = nestedInterfaceRef(intended.WirelessLink.InterfaceA)
+		}
+
+		ww.WirelessLink.InterfaceA = interfaceA
+
+		ww.objectsToReconcile = append(ww.objectsToReconcile, interfaceAObjectsToReconcile...)
+
+		interfaceBObjectsToReconcile, interfaceB, interfaceBErr := patchNestedInterface(actualInterfaceB, intendedInterfaceB, intendedNestedObjects)
+		if interfaceBErr != nil {
+			return nil, interfaceBErr
+		}
+
+		if !actualInterfaceB.HasChanged() && intended.WirelessLink.InterfaceB != nil {
+			intended.WirelessLink.InterfaceB = nestedInterfaceRef(intended.WirelessLink.InterfaceB)
+		}
+
+		ww.WirelessLink.InterfaceB = interfaceB
+
+		ww.objectsToReconcile = append(ww.objectsToReconcile, interfaceBObjectsToReconcile...)
+
+		if ww.WirelessLink.SSID == nil {
+			ww.WirelessLink.SSID = intended.WirelessLink.SSID
+		}
+
+		if ww.WirelessLink.Status == nil {
+			ww.WirelessLink.Status = intended.WirelessLink.Status
+		}
+
+		if ww.WirelessLink.AuthType == nil {
+			ww.WirelessLink.AuthType = intended.WirelessLink.AuthType
+		}
+
+		if ww.WirelessLink.AuthCipher == nil {
+			ww.WirelessLink.AuthCipher = intended.WirelessLink.AuthCipher
+		}
+
+		if ww.WirelessLink.AuthPSK == nil {
+			ww.WirelessLink.AuthPSK = intended.WirelessLink.AuthPSK
+		}
+
+		if ww.WirelessLink.Distance == nil {
+			ww.WirelessLink.Distance = intended.WirelessLink.Distance
+		}
+
+		if ww.WirelessLink.DistanceUnit == nil {
+			ww.WirelessLink.DistanceUnit = intended.WirelessLink.DistanceUnit
+		}
+
+		if ww.WirelessLink.Description == nil {
+			ww.WirelessLink.Description = intended.WirelessLink.Description
+		}
+
+		if ww.WirelessLink.Comments == nil {
+			ww.WirelessLink.Comments = intended.WirelessLink.Comments
+		}
+
+		tagsToMerge := ww.mergeTags(ww.WirelessLink.Tags, intended.WirelessLink.Tags, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			ww.WirelessLink.Tags = tagsToMerge
+		}
+
+		for _, t := range ww.WirelessLink.Tags {
+			if t.ID == 0 {
+				ww.objectsToReconcile = append(ww.objectsToReconcile, &TagDataWrapper{Tag: t, hasParent: true})
+			}
+		}
+
+		ww.clearFields(ww.WirelessLink, intended.WirelessLink)
+
+		ww.diffFields(ww.WirelessLink, intended.WirelessLink)
+
+		actualHash, _ := hashstructure.Hash(ww.Data(), hashstructure.FormatV2, nil)
+		intendedHash, _ := hashstructure.Hash(intended.Data(), hashstructure.FormatV2, nil)
+
+		reconciliationRequired = actualHash != intendedHash
+	} else {
+		ww.enforceFieldOwnership(ww.WirelessLink, nil)
+
+		ww.SetDefaults()
+
+		interfaceAObjectsToReconcile, interfaceA, interfaceAErr := patchNestedInterface(actualInterfaceA, intendedInterfaceA, intendedNestedObjects)
+		if interfaceAErr != nil {
+			return nil, interfaceAErr
+		}
+		ww.WirelessLink.InterfaceA = interfaceA
+
+		ww.objectsToReconcile = append(ww.objectsToReconcile, interfaceAObjectsToReconcile...)
+
+		interfaceBObjectsToReconcile, interfaceB, interfaceBErr := patchNestedInterface(actualInterfaceB, intendedInterfaceB, intendedNestedObjects)
+		if interfaceBErr != nil {
+			return nil, interfaceBErr
+		}
+		ww.WirelessLink.InterfaceB = interfaceB
+
+		ww.objectsToReconcile = append(ww.objectsToReconcile, interfaceBObjectsToReconcile...)
+
+		tagsToMerge := ww.mergeTags(ww.WirelessLink.Tags, nil, intendedNestedObjects)
+
+		if len(tagsToMerge) > 0 {
+			ww.WirelessLink.Tags = tagsToMerge
+		}
+
+		for _, t := range ww.WirelessLink.Tags {
+			if t.ID == 0 {
+				ww.objectsToReconcile = append(ww.objectsToReconcile, &TagDataWrapper{Tag: t, hasParent: true})
+			}
+		}
+	}
+
+	if reconciliationRequired {
+		ww.hasChanged = true
+		ww.objectsToReconcile = append(ww.objectsToReconcile, ww)
+	}
+
+	dedupObjectsToReconcile, err := dedupObjectsToReconcile(ww.objectsToReconcile)
+	if err != nil {
+		return nil, err
+	}
+	ww.objectsToReconcile = dedupObjectsToReconcile
+
+	return ww.objectsToReconcile, nil
+}
+
+// SetDefaults sets the default values for the wireless link
+func (ww *WirelessLinkDataWrapper) SetDefaults() {
+	if ww.WirelessLink.Status == nil || *ww.WirelessLink.Status == "" {
+		ww.WirelessLink.Status = &DefaultWirelessLinkStatus
+	}
+}
+
+// patchWirelessLANs patches the wireless LANs assigned to an interface and merges them with the ones
+// already assigned in NetBox. It returns the wireless LANs to assign, the intended wireless LANs reduced
+// to references and the objects to reconcile.
+func patchWirelessLANs(actualWirelessLANs []*WirelessLAN, intendedWirelessLANs []*WirelessLAN, actualNestedObjectsMap map[string]ComparableData, intendedNestedObjects map[string]ComparableData) ([]*WirelessLAN, []*WirelessLAN, []ComparableData, error) {
+	objectsToReconcile := make([]ComparableData, 0)
+
+	var wirelessLANsToMerge []*WirelessLAN
+	var intendedWirelessLANRefs []*WirelessLAN
+	wirelessLANIndexes := make(map[int]int)
+
+	for _, w := range intendedWirelessLANs {
+		intendedWirelessLANRefs = append(intendedWirelessLANRefs, &WirelessLAN{ID: w.ID})
+		if _, ok := wirelessLANIndexes[w.ID]; ok {
+			continue
+		}
+		wirelessLANIndexes[w.ID] = len(wirelessLANsToMerge)
+		wirelessLANsToMerge = append(wirelessLANsToMerge, &WirelessLAN{ID: w.ID})
+	}
+
+	for _, w := range actualWirelessLANs {
+		key := fmt.Sprintf("%p", w)
+		actualWirelessLAN := extractFromObjectsMap(actualNestedObjectsMap, key)
+		if actualWirelessLAN == nil {
+			continue
+		}
+		intendedWirelessLAN := extractFromObjectsMap(intendedNestedObjects, key)
+
+		wirelessLANObjectsToReconcile, err := actualWirelessLAN.Patch(intendedWirelessLAN, intendedNestedObjects)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		objectsToReconcile = append(objectsToReconcile, wirelessLANObjectsToReconcile...)
+
+		wirelessLAN := &WirelessLAN{
+			ID: actualWirelessLAN.ID(),
+		}
+
+		if actualWirelessLAN.HasChanged() {
+			wirelessLAN, err = copyData(actualWirelessLAN.Data().(*WirelessLAN))
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			wirelessLAN.Tags = nil
+		}
+
+		if idx, ok := wirelessLANIndexes[wirelessLAN.ID]; ok && wirelessLAN.ID > 0 {
+			wirelessLANsToMerge[idx] = wirelessLAN
+			continue
+		}
+
+		if wirelessLAN.ID > 0 {
+			wirelessLANIndexes[wirelessLAN.ID] = len(wirelessLANsToMerge)
+		}
+		wirelessLANsToMerge = append(wirelessLANsToMerge, wirelessLAN)
+	}
+
+	return wirelessLANsToMerge, intendedWirelessLANRefs, objectsToReconcile, nil
+}
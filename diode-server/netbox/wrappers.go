@@ -56,6 +56,13 @@ type ComparableData interface {
 	HasChanged() bool
 }
 
+// FallbackQueryParamsProvider is implemented by data wrappers which can be matched by an alternative key
+// when their object state can't be retrieved with ObjectStateQueryParams
+type FallbackQueryParamsProvider interface {
+	// FallbackObjectStateQueryParams returns the query parameters of the alternative lookup, nil if there is none
+	FallbackObjectStateQueryParams() map[string]string
+}
+
 // BaseDataWrapper is the base struct for all data wrappers
 type BaseDataWrapper struct {
 	placeholder        bool
@@ -64,6 +71,15 @@ type BaseDataWrapper struct {
 	hasChanged         bool
 	nestedObjects      []ComparableData
 	objectsToReconcile []ComparableData
+	fieldOwnership     FieldOwnershipPolicy
+	skippedFields      []string
+	fieldsToClear      []string
+	tagsToRemove       []string
+	clearedFields      []string
+	changedFields      []FieldChange
+	tagPolicy          TagPolicy
+	matchingStrategy   MatchingStrategy
+	matchedBy          MatchingKey
 }
 
 // IsPlaceholder returns true if the data is a placeholder
@@ -218,6 +234,16 @@ func NewDataWrapper(dataType string) (ComparableData, error) {
 		return &VirtualizationVMInterfaceDataWrapper{}, nil
 	case VirtualizationVirtualDiskObjectType:
 		return &VirtualizationVirtualDiskDataWrapper{}, nil
+	case WirelessLANObjectType:
+		return &WirelessLANDataWrapper{}, nil
+	case WirelessLANGroupObjectType:
+		return &WirelessLANGroupDataWrapper{}, nil
+	case WirelessLinkObjectType:
+		return &WirelessLinkDataWrapper{}, nil
+	case IpamFHRPGroupObjectType:
+		return &IpamFHRPGroupDataWrapper{}, nil
+	case IpamFHRPGroupAssignmentObjectType:
+		return &IpamFHRPGroupAssignmentDataWrapper{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported data type %s", dataType)
 	}
@@ -0,0 +1,84 @@
+package netboxdiodeplugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisObjectStateCacheInvalidationsChannel is the Redis channel the object types whose cached object states are
+// invalidated are published to, for all the replicas to invalidate their in-process caches
+const RedisObjectStateCacheInvalidationsChannel = "diode.object-state-cache-invalidations"
+
+// Publisher publishes messages to Redis channels
+type Publisher interface {
+	Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd
+}
+
+// BroadcastObjectStateCache is an in-process object state cache whose invalidations are published to all the replicas
+type BroadcastObjectStateCache struct {
+	ObjectStateCache
+	publisher Publisher
+}
+
+// NewBroadcastObjectStateCache creates a new object state cache publishing the invalidations of cache, the replicas
+// receiving them with WatchObjectStateCacheInvalidations
+func NewBroadcastObjectStateCache(cache ObjectStateCache, publisher Publisher) *BroadcastObjectStateCache {
+	return &BroadcastObjectStateCache{
+		ObjectStateCache: cache,
+		publisher:        publisher,
+	}
+}
+
+// CachesNotFound returns true if the underlying cache may hold the states of objects not found
+func (c *BroadcastObjectStateCache) CachesNotFound() bool {
+	notFoundCache, ok := c.ObjectStateCache.(NotFoundObjectStateCache)
+	return ok && notFoundCache.CachesNotFound()
+}
+
+// Invalidate removes all object states cached for the object types and publishes their invalidation
+func (c *BroadcastObjectStateCache) Invalidate(ctx context.Context, objectTypes ...string) error {
+	err := c.ObjectStateCache.Invalidate(ctx, objectTypes...)
+	return errors.Join(err, PublishObjectStateCacheInvalidation(ctx, c.publisher, objectTypes...))
+}
+
+// PublishObjectStateCacheInvalidation publishes the invalidation of the object states cached for the object types
+func PublishObjectStateCacheInvalidation(ctx context.Context, publisher Publisher, objectTypes ...string) error {
+	if len(objectTypes) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(objectTypes)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(ctx, RedisObjectStateCacheInvalidationsChannel, b).Err()
+}
+
+// WatchObjectStateCacheInvalidations invalidates the object states of cache for the object types of the invalidations
+// published to the subscription, until the context is done
+func WatchObjectStateCacheInvalidations(ctx context.Context, logger *slog.Logger, messages <-chan *redis.Message, cache ObjectStateCache) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return errors.New("object state cache invalidations subscription closed")
+			}
+
+			var objectTypes []string
+			if err := json.Unmarshal([]byte(msg.Payload), &objectTypes); err != nil {
+				logger.Warn("failed to parse published object state cache invalidation", "error", err)
+				continue
+			}
+
+			if err := cache.Invalidate(ctx, objectTypes...); err != nil {
+				logger.Warn("failed to invalidate cached object states", "object_types", objectTypes, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package netboxdiodeplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// ObjectStateCache stores encoded object states per object type
+type ObjectStateCache interface {
+	// Get returns the object state cached under key, false if there is none
+	Get(ctx context.Context, objectType string, key string) ([]byte, bool, error)
+
+	// Set caches an object state under key
+	Set(ctx context.Context, objectType string, key string, value []byte) error
+
+	// Invalidate removes all object states cached for the object types
+	Invalidate(ctx context.Context, objectTypes ...string) error
+}
+
+// NotFoundObjectStateCache is implemented by object state caches which may hold the states of objects not found, other
+// caches only holding the states of existing objects
+type NotFoundObjectStateCache interface {
+	ObjectStateCache
+
+	// CachesNotFound returns true if the states of objects not found may be cached
+	CachesNotFound() bool
+}
+
+// ObjectStateCacheStats represents the lookups of a cached client
+type ObjectStateCacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// cachedObjectState represents an object state stored in an object state cache
+type cachedObjectState struct {
+	ObjectID       int             `json:"object_id"`
+	ObjectType     string          `json:"object_type"`
+	ObjectChangeID int             `json:"object_change_id"`
+	Object         json.RawMessage `json:"object"`
+	ExpiresAt      int64           `json:"expires_at"`
+}
+
+// CachedClient is a NetBox Diode plugin API caching object states retrieved from another NetBox Diode plugin API
+type CachedClient struct {
+	logger *slog.Logger
+	client NetBoxAPI
+	ttl    time.Duration
+	caches []ObjectStateCache
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewCachedClient creates a new cached client looking up object states in caches in order before retrieving them
+// from client, object states are cached for ttl
+func NewCachedClient(logger *slog.Logger, client NetBoxAPI, ttl time.Duration, caches ...ObjectStateCache) *CachedClient {
+	return &CachedClient{
+		logger: logger,
+		client: client,
+		ttl:    ttl,
+		caches: caches,
+	}
+}
+
+// Stats returns the cache hits and misses since the client was created
+func (c *CachedClient) Stats() ObjectStateCacheStats {
+	return ObjectStateCacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// TakeStats returns the cache hits and misses since the stats were last taken, resetting them
+func (c *CachedClient) TakeStats() ObjectStateCacheStats {
+	return ObjectStateCacheStats{
+		Hits:   c.hits.Swap(0),
+		Misses: c.misses.Swap(0),
+	}
+}
+
+// RetrieveObjectState retrieves the object state from the first cache holding it, from the underlying client otherwise
+func (c *CachedClient) RetrieveObjectState(ctx context.Context, params RetrieveObjectStateQueryParams) (*ObjectState, error) {
+	if objState, ok := c.lookup(ctx, params); ok {
+		return objState, nil
+	}
+
+	objState, err := c.client.RetrieveObjectState(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(ctx, params, objState)
+
+	return objState, nil
+}
+
+// RetrieveObjectStates retrieves the object states from the first cache holding them, the ones not cached are
+// retrieved from the underlying client in one round-trip
+func (c *CachedClient) RetrieveObjectStates(ctx context.Context, params []RetrieveObjectStateQueryParams) ([]*ObjectState, error) {
+	objStates := make([]*ObjectState, len(params))
+
+	missed := make([]int, 0)
+	missedParams := make([]RetrieveObjectStateQueryParams, 0)
+	for i, p := range params {
+		if objState, ok := c.lookup(ctx, p); ok {
+			objStates[i] = objState
+			continue
+		}
+		missed = append(missed, i)
+		missedParams = append(missedParams, p)
+	}
+
+	if len(missedParams) == 0 {
+		return objStates, nil
+	}
+
+	retrieved, err := c.client.RetrieveObjectStates(ctx, missedParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(retrieved) != len(missedParams) {
+		return nil, fmt.Errorf("expected %d object states, got %d", len(missedParams), len(retrieved))
+	}
+
+	for j, i := range missed {
+		objStates[i] = retrieved[j]
+		c.store(ctx, params[i], retrieved[j])
+	}
+
+	return objStates, nil
+}
+
+func (c *CachedClient) lookup(ctx context.Context, params RetrieveObjectStateQueryParams) (*ObjectState, bool) {
+	key := params.Key()
+
+	for i, cache := range c.caches {
+		value, ok, err := cache.Get(ctx, params.ObjectType, key)
+		if err != nil {
+			c.logger.Warn("failed to get cached object state", "object_type", params.ObjectType, "key", key, "error", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		objState, cached, err := c.decodeObjectState(params.ObjectType, value)
+		if err != nil {
+			c.logger.Warn("failed to decode cached object state", "object_type", params.ObjectType, "key", key, "error", err)
+			continue
+		}
+		if cached.ExpiresAt < time.Now().UnixNano() {
+			continue
+		}
+
+		c.hits.Add(1)
+
+		// populate the caches looked up before, e.g. the in-process cache from the shared one
+		if i > 0 {
+			c.setObjectState(ctx, c.caches[:i], params.ObjectType, key, value, objectFound(objState))
+		}
+
+		return objState, true
+	}
+
+	c.misses.Add(1)
+
+	return nil, false
+}
+
+func (c *CachedClient) store(ctx context.Context, params RetrieveObjectStateQueryParams, objState *ObjectState) {
+	key := params.Key()
+
+	value, err := c.encodeObjectState(objState)
+	if err != nil {
+		c.logger.Warn("failed to encode object state", "object_type", params.ObjectType, "key", key, "error", err)
+		return
+	}
+
+	c.setObjectState(ctx, c.caches, params.ObjectType, key, value, objectFound(objState))
+}
+
+// ApplyChangeSet applies a change set with the underlying client and invalidates the cached object states of the
+// object types it changes, along with the ones nesting them
+func (c *CachedClient) ApplyChangeSet(ctx context.Context, payload ChangeSetRequest) (*ChangeSetResponse, error) {
+	resp, err := c.client.ApplyChangeSet(ctx, payload)
+
+	// invalidate even if the change set failed, e.g. on version conflicts the cached object states are outdated
+	objectTypes := invalidatedObjectTypes(payload)
+	for _, cache := range c.caches {
+		if invalidateErr := cache.Invalidate(ctx, objectTypes...); invalidateErr != nil {
+			c.logger.Warn("failed to invalidate cached object states", "object_types", objectTypes, "error", invalidateErr)
+		}
+	}
+
+	return resp, err
+}
+
+// setObjectState caches an object state, the states of objects not found only in caches which may hold them
+func (c *CachedClient) setObjectState(ctx context.Context, caches []ObjectStateCache, objectType string, key string, value []byte, found bool) {
+	for _, cache := range caches {
+		if notFoundCache, ok := cache.(NotFoundObjectStateCache); !found && (!ok || !notFoundCache.CachesNotFound()) {
+			continue
+		}
+		if err := cache.Set(ctx, objectType, key, value); err != nil {
+			c.logger.Warn("failed to cache object state", "object_type", objectType, "key", key, "error", err)
+		}
+	}
+}
+
+func objectFound(objState *ObjectState) bool {
+	return objState.ObjectID > 0
+}
+
+func (c *CachedClient) encodeObjectState(objState *ObjectState) ([]byte, error) {
+	var object []byte
+	if objState.Object != nil {
+		var err error
+		object, err = json.Marshal(objState.Object.Data())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(cachedObjectState{
+		ObjectID:       objState.ObjectID,
+		ObjectType:     objState.ObjectType,
+		ObjectChangeID: objState.ObjectChangeID,
+		Object:         object,
+		ExpiresAt:      time.Now().Add(c.ttl).UnixNano(),
+	})
+}
+
+func (c *CachedClient) decodeObjectState(objectType string, value []byte) (*ObjectState, *cachedObjectState, error) {
+	var cached cachedObjectState
+	if err := json.Unmarshal(value, &cached); err != nil {
+		return nil, nil, err
+	}
+
+	objStateRaw := objectStateRaw{
+		ObjectID:       cached.ObjectID,
+		ObjectType:     cached.ObjectType,
+		ObjectChangeID: cached.ObjectChangeID,
+	}
+	if len(cached.Object) > 0 {
+		if err := json.Unmarshal(cached.Object, &objStateRaw.Object); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	objState, err := extractObjectState(&objStateRaw, objectType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ObjectState{
+		ObjectID:       cached.ObjectID,
+		ObjectType:     cached.ObjectType,
+		ObjectChangeID: cached.ObjectChangeID,
+		Object:         objState,
+	}, &cached, nil
+}
+
+// invalidatedObjectTypes returns the object types changed by a change set along with the ones which may nest them
+func invalidatedObjectTypes(payload ChangeSetRequest) []string {
+	seen := make(map[string]struct{})
+	objectTypes := make([]string, 0)
+	add := func(objectType string) {
+		if _, ok := seen[objectType]; ok {
+			return
+		}
+		seen[objectType] = struct{}{}
+		objectTypes = append(objectTypes, objectType)
+	}
+
+	for _, change := range payload.ChangeSet {
+		add(change.ObjectType)
+		for _, dependent := range netbox.DependentObjectTypes(change.ObjectType) {
+			add(dependent)
+		}
+	}
+
+	return objectTypes
+}
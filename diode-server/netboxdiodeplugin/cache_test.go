@@ -0,0 +1,374 @@
+package netboxdiodeplugin_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	mnp "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+)
+
+func TestCachedClientRetrieveObjectState(t *testing.T) {
+	tests := []struct {
+		name               string
+		params             netboxdiodeplugin.RetrieveObjectStateQueryParams
+		mockServerResponse string
+		expectedRequests   int64
+		expectedStats      netboxdiodeplugin.ObjectStateCacheStats
+	}{
+		{
+			name:               "DCIM device with nested objects",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimDeviceObjectType, Params: map[string]string{"q": "router01", "site__name": "site 01"}},
+			mockServerResponse: `{"object_type":"dcim.device","object_id":1,"object_change_id":10,"object":{"id":1,"name":"router01","status":{"value":"active","label":"Active"},"site":{"id":2,"name":"site 01","slug":"site-01"},"role":{"id":3,"name":"switch","slug":"switch"}}}`,
+			expectedRequests:   2,
+			expectedStats:      netboxdiodeplugin.ObjectStateCacheStats{Hits: 2, Misses: 1},
+		},
+		{
+			name:               "IPAM IP address assigned to an FHRP group",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.IpamIPAddressObjectType, Params: map[string]string{"q": "192.168.0.1/24"}},
+			mockServerResponse: `{"object_type":"ipam.ipaddress","object_id":1,"object_change_id":1,"object":{"id":1,"address":"192.168.0.1/24","assigned_object":{"fhrp_group":{"id":1,"protocol":"vrrp2","group_id":10}}}}`,
+			expectedRequests:   2,
+			expectedStats:      netboxdiodeplugin.ObjectStateCacheStats{Hits: 2, Misses: 1},
+		},
+		{
+			name:               "object not found",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 02"}},
+			mockServerResponse: `{"object_type":"dcim.site","object_change_id":0,"object":null}`,
+			// objects not found are not cached in-process
+			expectedRequests: 4,
+			expectedStats:    netboxdiodeplugin.ObjectStateCacheStats{Hits: 0, Misses: 3},
+		},
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanUpEnvVars()
+
+			var requests atomic.Int64
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/diode/object-state/", func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				_, _ = w.Write([]byte(tt.mockServerResponse))
+			})
+			ts := httptest.NewTLSServer(mux)
+			defer ts.Close()
+
+			_ = os.Setenv(netboxdiodeplugin.BaseURLEnvVarName, fmt.Sprintf("%s/api/diode", ts.URL))
+			_ = os.Setenv(netboxdiodeplugin.TLSSkipVerifyEnvVarName, "true")
+
+			client, err := netboxdiodeplugin.NewClient(logger, "foobar")
+			require.NoError(t, err)
+
+			cachedClient := netboxdiodeplugin.NewCachedClient(logger, client, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10))
+
+			expected, err := client.RetrieveObjectState(context.Background(), tt.params)
+			require.NoError(t, err)
+
+			for i := 0; i < 3; i++ {
+				resp, err := cachedClient.RetrieveObjectState(context.Background(), tt.params)
+				require.NoError(t, err)
+				assert.Equal(t, expected, resp)
+			}
+
+			assert.Equal(t, tt.expectedRequests, requests.Load())
+			assert.Equal(t, tt.expectedStats, cachedClient.Stats())
+		})
+	}
+}
+
+func TestCachedClientRetrieveObjectStates(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+	site01Params := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}}
+	site02Params := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 02"}}
+
+	mockClient := mnp.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(ctx, site01Params).Return(siteObjectState(1, "site 01"), nil).Once()
+	mockClient.EXPECT().RetrieveObjectStates(ctx, []netboxdiodeplugin.RetrieveObjectStateQueryParams{site02Params}).Return([]*netboxdiodeplugin.ObjectState{siteObjectState(2, "site 02")}, nil).Once()
+
+	cachedClient := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10))
+
+	_, err := cachedClient.RetrieveObjectState(ctx, site01Params)
+	require.NoError(t, err)
+
+	// only the object state not cached yet is retrieved
+	resp, err := cachedClient.RetrieveObjectStates(ctx, []netboxdiodeplugin.RetrieveObjectStateQueryParams{site01Params, site02Params})
+	require.NoError(t, err)
+	assert.Equal(t, []*netboxdiodeplugin.ObjectState{siteObjectState(1, "site 01"), siteObjectState(2, "site 02")}, resp)
+
+	resp, err = cachedClient.RetrieveObjectStates(ctx, []netboxdiodeplugin.RetrieveObjectStateQueryParams{site02Params})
+	require.NoError(t, err)
+	assert.Equal(t, []*netboxdiodeplugin.ObjectState{siteObjectState(2, "site 02")}, resp)
+
+	assert.Equal(t, netboxdiodeplugin.ObjectStateCacheStats{Hits: 2, Misses: 2}, cachedClient.Stats())
+}
+
+func TestCachedClientExpiry(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+	params := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}}
+
+	mockClient := mnp.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(ctx, params).Return(siteObjectState(1, "site 01"), nil).Twice()
+
+	cachedClient := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Millisecond, netboxdiodeplugin.NewLRUObjectStateCache(10))
+
+	_, err := cachedClient.RetrieveObjectState(ctx, params)
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = cachedClient.RetrieveObjectState(ctx, params)
+	require.NoError(t, err)
+
+	assert.Equal(t, netboxdiodeplugin.ObjectStateCacheStats{Hits: 0, Misses: 2}, cachedClient.Stats())
+}
+
+func TestCachedClientApplyChangeSetInvalidation(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+	siteParams := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}}
+	deviceParams := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimDeviceObjectType, Params: map[string]string{"q": "router01", "site__name": "site 01"}}
+	roleParams := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimDeviceRoleObjectType, Params: map[string]string{"q": "switch"}}
+	tagParams := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.ExtrasTagObjectType, Params: map[string]string{"q": "tag 01"}}
+
+	mockClient := mnp.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(ctx, siteParams).Return(siteObjectState(2, "site 01"), nil).Twice()
+	mockClient.EXPECT().RetrieveObjectState(ctx, deviceParams).Return(&netboxdiodeplugin.ObjectState{
+		ObjectID:   1,
+		ObjectType: netbox.DcimDeviceObjectType,
+		Object: &netbox.DcimDeviceDataWrapper{
+			Device: &netbox.DcimDevice{ID: 1, Name: "router01", Site: &netbox.DcimSite{ID: 2, Name: "site 01", Slug: "site-01"}},
+		},
+	}, nil).Twice()
+	mockClient.EXPECT().RetrieveObjectState(ctx, roleParams).Return(&netboxdiodeplugin.ObjectState{
+		ObjectID:   3,
+		ObjectType: netbox.DcimDeviceRoleObjectType,
+		Object:     &netbox.DcimDeviceRoleDataWrapper{DeviceRole: &netbox.DcimDeviceRole{ID: 3, Name: "switch", Slug: "switch"}},
+	}, nil).Once()
+	mockClient.EXPECT().RetrieveObjectState(ctx, tagParams).Return(&netboxdiodeplugin.ObjectState{
+		ObjectID:   4,
+		ObjectType: netbox.ExtrasTagObjectType,
+		Object:     &netbox.TagDataWrapper{Tag: &netbox.Tag{ID: 4, Name: "tag 01", Slug: "tag-01"}},
+	}, nil).Once()
+	mockClient.EXPECT().ApplyChangeSet(ctx, mock.Anything).Return(&netboxdiodeplugin.ChangeSetResponse{}, nil).Once()
+
+	cachedClient := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10))
+
+	for _, params := range []netboxdiodeplugin.RetrieveObjectStateQueryParams{siteParams, deviceParams, roleParams, tagParams} {
+		_, err := cachedClient.RetrieveObjectState(ctx, params)
+		require.NoError(t, err)
+	}
+
+	_, err := cachedClient.ApplyChangeSet(ctx, netboxdiodeplugin.ChangeSetRequest{
+		ChangeSetID: "cs1",
+		ChangeSet: []netboxdiodeplugin.Change{
+			{ChangeID: "1", ChangeType: netboxdiodeplugin.ChangeTypeUpdate, ObjectType: netbox.DcimSiteObjectType, ObjectID: ptrInt(2), Data: &netbox.DcimSite{ID: 2, Name: "site 01", Slug: "site-01"}},
+		},
+	})
+	require.NoError(t, err)
+
+	// the site and the device nesting it are retrieved again, the role and the tag are still cached
+	for _, params := range []netboxdiodeplugin.RetrieveObjectStateQueryParams{siteParams, deviceParams, roleParams, tagParams} {
+		_, err := cachedClient.RetrieveObjectState(ctx, params)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, netboxdiodeplugin.ObjectStateCacheStats{Hits: 2, Misses: 6}, cachedClient.Stats())
+}
+
+func TestCachedClientSharedCache(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+	params := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}}
+
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	mockClient := mnp.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(ctx, params).Return(siteObjectState(1, "site 01"), nil).Once()
+
+	// two reconciler replicas sharing object states through Redis
+	replica1 := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10), netboxdiodeplugin.NewRedisObjectStateCache(redisClient, time.Minute))
+	replica2 := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10), netboxdiodeplugin.NewRedisObjectStateCache(redisClient, time.Minute))
+
+	expected, err := replica1.RetrieveObjectState(ctx, params)
+	require.NoError(t, err)
+
+	assert.True(t, s.Exists(netboxdiodeplugin.RedisObjectStateCacheKeyPrefix+":dcim.site"))
+
+	for i := 0; i < 2; i++ {
+		resp, err := replica2.RetrieveObjectState(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, expected, resp)
+	}
+
+	assert.Equal(t, netboxdiodeplugin.ObjectStateCacheStats{Hits: 2, Misses: 0}, replica2.Stats())
+
+	mockClient.EXPECT().ApplyChangeSet(ctx, mock.Anything).Return(&netboxdiodeplugin.ChangeSetResponse{}, nil).Once()
+
+	_, err = replica1.ApplyChangeSet(ctx, netboxdiodeplugin.ChangeSetRequest{
+		ChangeSetID: "cs1",
+		ChangeSet: []netboxdiodeplugin.Change{
+			{ChangeID: "1", ChangeType: netboxdiodeplugin.ChangeTypeUpdate, ObjectType: netbox.DcimSiteObjectType, ObjectID: ptrInt(1), Data: &netbox.DcimSite{ID: 1, Name: "site 01", Slug: "site-01"}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, s.Exists(netboxdiodeplugin.RedisObjectStateCacheKeyPrefix+":dcim.site"))
+}
+
+func TestCachedClientBroadcastInvalidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+	siteParams := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}}
+	deviceParams := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimDeviceObjectType, Params: map[string]string{"q": "router01", "site__name": "site 01"}}
+
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	mockClient := mnp.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(ctx, siteParams).Return(siteObjectState(2, "site 01"), nil)
+	mockClient.EXPECT().RetrieveObjectState(ctx, deviceParams).Return(&netboxdiodeplugin.ObjectState{
+		ObjectID:   1,
+		ObjectType: netbox.DcimDeviceObjectType,
+		Object: &netbox.DcimDeviceDataWrapper{
+			Device: &netbox.DcimDevice{ID: 1, Name: "router01", Site: &netbox.DcimSite{ID: 2, Name: "site 01", Slug: "site-01"}},
+		},
+	}, nil)
+	mockClient.EXPECT().ApplyChangeSet(ctx, mock.Anything).Return(&netboxdiodeplugin.ChangeSetResponse{}, nil).Once()
+
+	// two reconciler replicas with in-process caches only, the second one watching the invalidations published
+	replica1 := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewBroadcastObjectStateCache(netboxdiodeplugin.NewLRUObjectStateCache(10), redisClient))
+	lru2 := netboxdiodeplugin.NewLRUObjectStateCache(10)
+	replica2 := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewBroadcastObjectStateCache(lru2, redisClient))
+
+	pubsub := redisClient.Subscribe(ctx, netboxdiodeplugin.RedisObjectStateCacheInvalidationsChannel)
+	defer pubsub.Close()
+	_, err := pubsub.Receive(ctx)
+	require.NoError(t, err)
+	go func() {
+		_ = netboxdiodeplugin.WatchObjectStateCacheInvalidations(ctx, logger, pubsub.Channel(), lru2)
+	}()
+
+	for _, params := range []netboxdiodeplugin.RetrieveObjectStateQueryParams{siteParams, deviceParams} {
+		_, err := replica2.RetrieveObjectState(ctx, params)
+		require.NoError(t, err)
+	}
+
+	_, err = replica1.ApplyChangeSet(ctx, netboxdiodeplugin.ChangeSetRequest{
+		ChangeSetID: "cs1",
+		ChangeSet: []netboxdiodeplugin.Change{
+			{ChangeID: "1", ChangeType: netboxdiodeplugin.ChangeTypeUpdate, ObjectType: netbox.DcimSiteObjectType, ObjectID: ptrInt(2), Data: &netbox.DcimSite{ID: 2, Name: "site 01", Slug: "site-01"}},
+		},
+	})
+	require.NoError(t, err)
+
+	// the site and the device nesting it are removed from the in-process cache of the second replica
+	for _, params := range []netboxdiodeplugin.RetrieveObjectStateQueryParams{siteParams, deviceParams} {
+		assert.Eventually(t, func() bool {
+			_, ok, err := lru2.Get(ctx, params.ObjectType, params.Key())
+			return err == nil && !ok
+		}, time.Second, 10*time.Millisecond, params.ObjectType)
+	}
+}
+
+func TestCachedClientObjectNotFound(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+	params := netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}}
+
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	notFound := &netboxdiodeplugin.ObjectState{ObjectType: netbox.DcimSiteObjectType}
+
+	mockClient := mnp.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(ctx, params).Return(notFound, nil).Once()
+
+	replica1 := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10), netboxdiodeplugin.NewRedisObjectStateCache(redisClient, time.Minute))
+	replica2 := netboxdiodeplugin.NewCachedClient(logger, mockClient, time.Minute, netboxdiodeplugin.NewLRUObjectStateCache(10), netboxdiodeplugin.NewRedisObjectStateCache(redisClient, time.Minute))
+
+	_, err := replica1.RetrieveObjectState(ctx, params)
+	require.NoError(t, err)
+
+	// the object not found is shared through Redis only
+	resp, err := replica2.RetrieveObjectState(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.ObjectID)
+
+	// the object created by the first replica invalidates the shared cache, the second replica retrieves it
+	mockClient.EXPECT().ApplyChangeSet(ctx, mock.Anything).Return(&netboxdiodeplugin.ChangeSetResponse{}, nil).Once()
+	_, err = replica1.ApplyChangeSet(ctx, netboxdiodeplugin.ChangeSetRequest{
+		ChangeSetID: "cs1",
+		ChangeSet: []netboxdiodeplugin.Change{
+			{ChangeID: "1", ChangeType: netboxdiodeplugin.ChangeTypeCreate, ObjectType: netbox.DcimSiteObjectType, Data: &netbox.DcimSite{Name: "site 01", Slug: "site-01"}},
+		},
+	})
+	require.NoError(t, err)
+
+	mockClient.EXPECT().RetrieveObjectState(ctx, params).Return(siteObjectState(1, "site 01"), nil).Once()
+
+	resp, err = replica2.RetrieveObjectState(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, siteObjectState(1, "site 01"), resp)
+	assert.Equal(t, netboxdiodeplugin.ObjectStateCacheStats{Hits: 1, Misses: 1}, replica2.TakeStats())
+	assert.Equal(t, netboxdiodeplugin.ObjectStateCacheStats{}, replica2.Stats(), "taking stats resets them")
+}
+
+func TestLRUObjectStateCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	cache := netboxdiodeplugin.NewLRUObjectStateCache(2)
+
+	require.NoError(t, cache.Set(ctx, netbox.DcimSiteObjectType, "a", []byte("a")))
+	require.NoError(t, cache.Set(ctx, netbox.DcimSiteObjectType, "b", []byte("b")))
+
+	// a is used more recently than b
+	_, ok, err := cache.Get(ctx, netbox.DcimSiteObjectType, "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, cache.Set(ctx, netbox.DcimSiteObjectType, "c", []byte("c")))
+
+	for key, cached := range map[string]bool{"a": true, "b": false, "c": true} {
+		_, ok, err := cache.Get(ctx, netbox.DcimSiteObjectType, key)
+		require.NoError(t, err)
+		assert.Equal(t, cached, ok, key)
+	}
+}
+
+func siteObjectState(id int, name string) *netboxdiodeplugin.ObjectState {
+	return &netboxdiodeplugin.ObjectState{
+		ObjectID:       id,
+		ObjectType:     netbox.DcimSiteObjectType,
+		ObjectChangeID: 1,
+		Object:         &netbox.DcimSiteDataWrapper{Site: &netbox.DcimSite{ID: id, Name: name, Slug: "site-01"}},
+	}
+}
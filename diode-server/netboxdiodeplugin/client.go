@@ -15,6 +15,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -109,6 +110,12 @@ func NewApplyChangeSetError(msg string, code int, response ChangeSetResponse) er
 	}
 }
 
+// IsObjectVersionConflict returns true if the change set was rejected because an object was modified in NetBox since
+// the object version sent with its change
+func (e *ApplyChangeSetError) IsObjectVersionConflict() bool {
+	return e.Code == http.StatusConflict
+}
+
 // ToIngestionError converts ApplyChangeSetError to *reconcilerpb.IngestionError
 func (e *ApplyChangeSetError) ToIngestionError() *reconcilerpb.IngestionError {
 	changeSetErrors := make([]*reconcilerpb.IngestionError_Details_Error, 0)
@@ -146,6 +153,10 @@ type NetBoxAPI interface {
 	// RetrieveObjectState retrieves the object state
 	RetrieveObjectState(context.Context, RetrieveObjectStateQueryParams) (*ObjectState, error)
 
+	// RetrieveObjectStates retrieves the object states of several objects in one round-trip, in the order of the
+	// query parameters
+	RetrieveObjectStates(context.Context, []RetrieveObjectStateQueryParams) ([]*ObjectState, error)
+
 	// ApplyChangeSet applies a change set
 	ApplyChangeSet(context.Context, ChangeSetRequest) (*ChangeSetResponse, error)
 }
@@ -155,6 +166,9 @@ type Client struct {
 	logger     *slog.Logger
 	httpClient *http.Client
 	baseURL    *url.URL
+
+	// set once the plugin turns out not to provide the bulk object state endpoint
+	bulkObjectStateUnsupported atomic.Bool
 }
 
 // NewHTTPTransport creates a http Transport Layer
@@ -268,6 +282,18 @@ type RetrieveObjectStateQueryParams struct {
 	Params     map[string]string
 }
 
+// Key returns a key identifying the object state retrieved with the query parameters
+func (p RetrieveObjectStateQueryParams) Key() string {
+	queryParams := url.Values{}
+	if p.ObjectID > 0 {
+		queryParams.Set("object_id", strconv.Itoa(p.ObjectID))
+	}
+	for k, v := range p.Params {
+		queryParams.Set(k, v)
+	}
+	return fmt.Sprintf("%s?%s", p.ObjectType, queryParams.Encode())
+}
+
 // RetrieveObjectState retrieves the object state
 func (c *Client) RetrieveObjectState(ctx context.Context, params RetrieveObjectStateQueryParams) (*ObjectState, error) {
 	endpointURL, err := url.Parse(fmt.Sprintf("%s/object-state/", c.baseURL.String()))
@@ -324,6 +350,119 @@ func (c *Client) RetrieveObjectState(ctx context.Context, params RetrieveObjectS
 	}, nil
 }
 
+type objectStateQuery struct {
+	ObjectType string            `json:"object_type"`
+	ObjectID   int               `json:"object_id,omitempty"`
+	Params     map[string]string `json:"params,omitempty"`
+}
+
+type objectStatesRequest struct {
+	Queries []objectStateQuery `json:"queries"`
+}
+
+type objectStatesResponse struct {
+	ObjectStates []objectStateRaw `json:"object_states"`
+}
+
+// RetrieveObjectStates retrieves the object states of several objects in one request, one request per object if the
+// plugin doesn't provide the bulk object state endpoint
+func (c *Client) RetrieveObjectStates(ctx context.Context, params []RetrieveObjectStateQueryParams) ([]*ObjectState, error) {
+	if len(params) == 0 {
+		return []*ObjectState{}, nil
+	}
+
+	if c.bulkObjectStateUnsupported.Load() {
+		return c.retrieveObjectStatesOneByOne(ctx, params)
+	}
+
+	endpointURL, err := url.Parse(fmt.Sprintf("%s/object-states/", c.baseURL.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	payload := objectStatesRequest{Queries: make([]objectStateQuery, 0, len(params))}
+	for _, p := range params {
+		payload.Queries = append(payload.Queries, objectStateQuery{
+			ObjectType: p.ObjectType,
+			ObjectID:   p.ObjectID,
+			Params:     p.Params,
+		})
+	}
+
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpointURL.String(), bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Warn("failed to close response body", "error", closeErr)
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		c.logger.Info("bulk object state endpoint not available, retrieving object states one by one", "status_code", resp.StatusCode)
+		c.bulkObjectStateUnsupported.Store(true)
+		return c.retrieveObjectStatesOneByOne(ctx, params)
+	}
+
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("failed to retrieve object states, status code: %d, response: %s", resp.StatusCode, string(respBodyBytes))
+	}
+
+	var objStatesResp objectStatesResponse
+	if err := json.Unmarshal(respBodyBytes, &objStatesResp); err != nil {
+		return nil, err
+	}
+
+	if len(objStatesResp.ObjectStates) != len(params) {
+		return nil, fmt.Errorf("expected %d object states, got %d", len(params), len(objStatesResp.ObjectStates))
+	}
+
+	objStates := make([]*ObjectState, 0, len(params))
+	for i, objStateRaw := range objStatesResp.ObjectStates {
+		objState, err := extractObjectState(&objStateRaw, params[i].ObjectType)
+		if err != nil {
+			return nil, err
+		}
+
+		objStates = append(objStates, &ObjectState{
+			ObjectID:       objStateRaw.ObjectID,
+			ObjectType:     objStateRaw.ObjectType,
+			ObjectChangeID: objStateRaw.ObjectChangeID,
+			Object:         objState,
+		})
+	}
+
+	return objStates, nil
+}
+
+func (c *Client) retrieveObjectStatesOneByOne(ctx context.Context, params []RetrieveObjectStateQueryParams) ([]*ObjectState, error) {
+	objStates := make([]*ObjectState, 0, len(params))
+	for _, p := range params {
+		objState, err := c.RetrieveObjectState(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		objStates = append(objStates, objState)
+	}
+	return objStates, nil
+}
+
 func extractObjectState(objState *objectStateRaw, objectType string) (netbox.ComparableData, error) {
 	if objState == nil {
 		return nil, fmt.Errorf("raw object state response is nil")
@@ -393,6 +532,17 @@ type ChangeSetRequest struct {
 	ChangeSet   []Change `json:"change_set"`
 }
 
+const (
+	// ChangeTypeCreate is the change type for a creation
+	ChangeTypeCreate = "create"
+
+	// ChangeTypeUpdate is the change type for an update
+	ChangeTypeUpdate = "update"
+
+	// ChangeTypeDelete is the change type for a deletion
+	ChangeTypeDelete = "delete"
+)
+
 // Change represents a change
 type Change struct {
 	ChangeID      string `json:"change_id"`
@@ -401,6 +551,46 @@ type Change struct {
 	ObjectID      *int   `json:"object_id,omitempty"`
 	ObjectVersion *int   `json:"object_version,omitempty"`
 	Data          any    `json:"data"`
+
+	// ClearedFields are the fields of the object cleared by the change, sent as null (tags as an empty list) when
+	// omitted from its data
+	ClearedFields []string `json:"-"`
+}
+
+// MarshalJSON marshals the change, its cleared fields omitted from its data being set to null
+func (c Change) MarshalJSON() ([]byte, error) {
+	type change Change
+	if len(c.ClearedFields) == 0 {
+		return json.Marshal(change(c))
+	}
+
+	b, err := json.Marshal(c.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var data map[string]any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode data of change %s: %v", c.ChangeID, err)
+	}
+
+	for _, field := range c.ClearedFields {
+		if _, ok := data[field]; ok {
+			continue
+		}
+		if field == netbox.TagsFieldName {
+			data[field] = []any{}
+			continue
+		}
+		data[field] = nil
+	}
+
+	cc := change(c)
+	cc.Data = data
+	return json.Marshal(cc)
 }
 
 // ChangeSetResponse represents an apply change set response
@@ -558,6 +748,36 @@ func wrapObjectState(dataType string, object any) (any, error) {
 		}{
 			VirtualDisk: object,
 		}, nil
+	case netbox.WirelessLANObjectType:
+		return struct {
+			WirelessLAN any
+		}{
+			WirelessLAN: object,
+		}, nil
+	case netbox.WirelessLANGroupObjectType:
+		return struct {
+			WirelessLANGroup any
+		}{
+			WirelessLANGroup: object,
+		}, nil
+	case netbox.WirelessLinkObjectType:
+		return struct {
+			WirelessLink any
+		}{
+			WirelessLink: object,
+		}, nil
+	case netbox.IpamFHRPGroupObjectType:
+		return struct {
+			FHRPGroup any
+		}{
+			FHRPGroup: object,
+		}, nil
+	case netbox.IpamFHRPGroupAssignmentObjectType:
+		return struct {
+			FHRPGroupAssignment any
+		}{
+			FHRPGroupAssignment: object,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported data type %s", dataType)
 	}
@@ -2,6 +2,8 @@ package netboxdiodeplugin_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -454,6 +456,126 @@ func TestRetrieveObjectState(t *testing.T) {
 			tlsSkipVerify: true,
 			shouldError:   false,
 		},
+		{
+			name:               "valid response for Wireless LAN Group",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.WirelessLANGroupObjectType, ObjectID: 1},
+			mockServerResponse: `{"object_type":"wireless.wirelesslangroup","object_change_id":1,"object":{"id":1,"name":"test","slug":"test"}}`,
+			apiKey:             "foobar",
+			response: &netboxdiodeplugin.ObjectState{
+				ObjectType:     netbox.WirelessLANGroupObjectType,
+				ObjectChangeID: 1,
+				Object: &netbox.WirelessLANGroupDataWrapper{
+					WirelessLANGroup: &netbox.WirelessLANGroup{
+						ID:   1,
+						Name: "test",
+						Slug: "test",
+					},
+				},
+			},
+			tlsSkipVerify: true,
+			shouldError:   false,
+		},
+		{
+			name:               "valid response for Wireless LAN",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.WirelessLANObjectType, ObjectID: 1},
+			mockServerResponse: `{"object_type":"wireless.wirelesslan","object_change_id":1,"object":{"id":1,"ssid":"test"}}`,
+			apiKey:             "foobar",
+			response: &netboxdiodeplugin.ObjectState{
+				ObjectType:     netbox.WirelessLANObjectType,
+				ObjectChangeID: 1,
+				Object: &netbox.WirelessLANDataWrapper{
+					WirelessLAN: &netbox.WirelessLAN{
+						ID:   1,
+						SSID: "test",
+					},
+				},
+			},
+			tlsSkipVerify: true,
+			shouldError:   false,
+		},
+		{
+			name:               "valid response for Wireless Link",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.WirelessLinkObjectType, ObjectID: 1},
+			mockServerResponse: `{"object_type":"wireless.wirelesslink","object_change_id":1,"object":{"id":1,"interface_a":{"id":1},"interface_b":{"id":2}}}`,
+			apiKey:             "foobar",
+			response: &netboxdiodeplugin.ObjectState{
+				ObjectType:     netbox.WirelessLinkObjectType,
+				ObjectChangeID: 1,
+				Object: &netbox.WirelessLinkDataWrapper{
+					WirelessLink: &netbox.WirelessLink{
+						ID:         1,
+						InterfaceA: &netbox.DcimInterface{ID: 1},
+						InterfaceB: &netbox.DcimInterface{ID: 2},
+					},
+				},
+			},
+			tlsSkipVerify: true,
+			shouldError:   false,
+		},
+		{
+			name:               "valid response for IPAM FHRP Group",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.IpamFHRPGroupObjectType, ObjectID: 1},
+			mockServerResponse: `{"object_type":"ipam.fhrpgroup","object_change_id":1,"object":{"id":1,"protocol":"vrrp2","group_id":10}}`,
+			apiKey:             "foobar",
+			response: &netboxdiodeplugin.ObjectState{
+				ObjectType:     netbox.IpamFHRPGroupObjectType,
+				ObjectChangeID: 1,
+				Object: &netbox.IpamFHRPGroupDataWrapper{
+					FHRPGroup: &netbox.IpamFHRPGroup{
+						ID:       1,
+						Protocol: "vrrp2",
+						GroupID:  ptrInt(10),
+					},
+				},
+			},
+			tlsSkipVerify: true,
+			shouldError:   false,
+		},
+		{
+			name:               "valid response for IPAM FHRP Group Assignment",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.IpamFHRPGroupAssignmentObjectType, ObjectID: 1},
+			mockServerResponse: `{"object_type":"ipam.fhrpgroupassignment","object_change_id":1,"object":{"id":1,"group":{"id":1},"interface":{"id":2},"priority":100}}`,
+			apiKey:             "foobar",
+			response: &netboxdiodeplugin.ObjectState{
+				ObjectType:     netbox.IpamFHRPGroupAssignmentObjectType,
+				ObjectChangeID: 1,
+				Object: &netbox.IpamFHRPGroupAssignmentDataWrapper{
+					FHRPGroupAssignment: &netbox.IpamFHRPGroupAssignment{
+						ID:        1,
+						Group:     &netbox.IpamFHRPGroup{ID: 1},
+						Interface: &netbox.DcimInterface{ID: 2},
+						Priority:  ptrInt(100),
+					},
+				},
+			},
+			tlsSkipVerify: true,
+			shouldError:   false,
+		},
+		{
+			name:               "valid response for IPAM IP Address assigned to FHRP Group",
+			params:             netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: netbox.IpamIPAddressObjectType, ObjectID: 1},
+			mockServerResponse: `{"object_type":"ipam.ipaddress","object_change_id":1,"object":{"id":1,"address":"192.168.0.1/24","assigned_object":{"fhrp_group":{"id":1,"protocol":"vrrp2","group_id":10}}}}`,
+			apiKey:             "foobar",
+			response: &netboxdiodeplugin.ObjectState{
+				ObjectType:     netbox.IpamIPAddressObjectType,
+				ObjectChangeID: 1,
+				Object: &netbox.IpamIPAddressDataWrapper{
+					IPAddress: &netbox.IpamIPAddress{
+						ID:      1,
+						Address: "192.168.0.1/24",
+						AssignedObject: &netbox.IPAddressFHRPGroup{
+							FHRPGroup: &netbox.IpamFHRPGroup{
+								ID:       1,
+								Protocol: "vrrp2",
+								GroupID:  ptrInt(10),
+							},
+						},
+					},
+				},
+			},
+			tlsSkipVerify: true,
+			shouldError:   false,
+		},
 		{
 			name: "valid response for DCIM device with query and additional attributes",
 			params: netboxdiodeplugin.RetrieveObjectStateQueryParams{
@@ -587,6 +709,7 @@ func TestApplyChangeSet(t *testing.T) {
 		mockStatusCode     int
 		response           *netboxdiodeplugin.ChangeSetResponse
 		shouldError        bool
+		versionConflict    bool
 	}{
 		{
 			name:   "valid apply change set response",
@@ -708,6 +831,30 @@ func TestApplyChangeSet(t *testing.T) {
 			response:           nil,
 			shouldError:        true,
 		},
+		{
+			name:   "object version conflict",
+			apiKey: "foobar",
+			changeSetRequest: netboxdiodeplugin.ChangeSetRequest{
+				ChangeSetID: "00000000-0000-0000-0000-000000000000",
+				ChangeSet: []netboxdiodeplugin.Change{
+					{
+						ChangeID:      "00000000-0000-0000-0000-000000000001",
+						ChangeType:    "update",
+						ObjectType:    "dcim.device",
+						ObjectID:      ptrInt(1),
+						ObjectVersion: ptrInt(10),
+						Data: &netbox.DcimDevice{
+							Name: "test",
+						},
+					},
+				},
+			},
+			mockServerResponse: `{"change_set_id":"00000000-0000-0000-0000-000000000000","result":"error","errors":[{"change_id":"00000000-0000-0000-0000-000000000001","object_version":"object has been modified"}]}`,
+			mockStatusCode:     http.StatusConflict,
+			response:           nil,
+			shouldError:        true,
+			versionConflict:    true,
+		},
 	}
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
@@ -738,6 +885,9 @@ func TestApplyChangeSet(t *testing.T) {
 			if tt.shouldError {
 				require.Error(t, err)
 				assert.Equal(t, tt.response, resp)
+
+				var applyChangeSetErr *netboxdiodeplugin.ApplyChangeSetError
+				assert.Equal(t, tt.versionConflict, errors.As(err, &applyChangeSetErr) && applyChangeSetErr.IsObjectVersionConflict())
 				return
 			}
 			require.NoError(t, err)
@@ -747,6 +897,158 @@ func TestApplyChangeSet(t *testing.T) {
 	}
 }
 
+func TestChangeMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		change netboxdiodeplugin.Change
+		want   string
+	}{
+		{
+			name: "no cleared fields",
+			change: netboxdiodeplugin.Change{
+				ChangeID:   "00000000-0000-0000-0000-000000000001",
+				ChangeType: "update",
+				ObjectType: "dcim.device",
+				ObjectID:   ptrInt(1),
+				Data:       &netbox.DcimDevice{ID: 1, Name: "router01"},
+			},
+			want: `{"change_id":"00000000-0000-0000-0000-000000000001","change_type":"update","object_type":"dcim.device","object_id":1,"data":{"id":1,"name":"router01"}}`,
+		},
+		{
+			name: "cleared fields omitted from data set to null",
+			change: netboxdiodeplugin.Change{
+				ChangeID:   "00000000-0000-0000-0000-000000000001",
+				ChangeType: "update",
+				ObjectType: "dcim.device",
+				ObjectID:   ptrInt(1),
+				Data: &netbox.DcimDevice{
+					ID:          1,
+					Name:        "router01",
+					Description: ptrString(""),
+					Tags:        []*netbox.Tag{},
+				},
+				ClearedFields: []string{"platform", "asset_tag", "description", "tags"},
+			},
+			want: `{"change_id":"00000000-0000-0000-0000-000000000001","change_type":"update","object_type":"dcim.device","object_id":1,"data":{"asset_tag":null,"description":"","id":1,"name":"router01","platform":null,"tags":[]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.change)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(b))
+		})
+	}
+}
+
+func TestRetrieveObjectStates(t *testing.T) {
+	params := []netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		{ObjectType: netbox.DcimSiteObjectType, Params: map[string]string{"q": "site 01"}},
+		{ObjectType: netbox.DcimDeviceObjectType, ObjectID: 1},
+	}
+	singleResponses := map[string]string{
+		netbox.DcimSiteObjectType:   `{"object_type":"dcim.site","object_change_id":1,"object":{"id":1,"name":"site 01","slug":"site-01"}}`,
+		netbox.DcimDeviceObjectType: `{"object_type":"dcim.device","object_change_id":2,"object":{"id":1,"name":"test"}}`,
+	}
+	expected := []*netboxdiodeplugin.ObjectState{
+		{
+			ObjectType:     netbox.DcimSiteObjectType,
+			ObjectChangeID: 1,
+			Object:         &netbox.DcimSiteDataWrapper{Site: &netbox.DcimSite{ID: 1, Name: "site 01", Slug: "site-01"}},
+		},
+		{
+			ObjectType:     netbox.DcimDeviceObjectType,
+			ObjectChangeID: 2,
+			Object:         &netbox.DcimDeviceDataWrapper{Device: &netbox.DcimDevice{ID: 1, Name: "test"}},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		bulkStatusCode       int
+		bulkResponse         string
+		expectedBulkRequests int64
+		expectedRequests     int64
+		shouldError          bool
+	}{
+		{
+			name:                 "bulk endpoint",
+			bulkStatusCode:       http.StatusOK,
+			bulkResponse:         fmt.Sprintf(`{"object_states":[%s,%s]}`, singleResponses[netbox.DcimSiteObjectType], singleResponses[netbox.DcimDeviceObjectType]),
+			expectedBulkRequests: 2,
+			expectedRequests:     0,
+		},
+		{
+			name:                 "bulk endpoint not available - retrieved one by one",
+			bulkStatusCode:       http.StatusNotFound,
+			expectedBulkRequests: 1,
+			expectedRequests:     4,
+		},
+		{
+			name:                 "object states missing from response",
+			bulkStatusCode:       http.StatusOK,
+			bulkResponse:         fmt.Sprintf(`{"object_states":[%s]}`, singleResponses[netbox.DcimSiteObjectType]),
+			expectedBulkRequests: 1,
+			shouldError:          true,
+		},
+		{
+			name:                 "server error",
+			bulkStatusCode:       http.StatusInternalServerError,
+			bulkResponse:         `{"errors":["internal error"]}`,
+			expectedBulkRequests: 1,
+			shouldError:          true,
+		},
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanUpEnvVars()
+
+			var bulkRequests, requests int64
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/diode/object-states/", func(w http.ResponseWriter, r *http.Request) {
+				bulkRequests++
+				assert.Equal(t, http.MethodPost, r.Method)
+
+				var body map[string][]map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Len(t, body["queries"], len(params))
+
+				w.WriteHeader(tt.bulkStatusCode)
+				_, _ = w.Write([]byte(tt.bulkResponse))
+			})
+			mux.HandleFunc("/api/diode/object-state/", func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				_, _ = w.Write([]byte(singleResponses[r.URL.Query().Get("object_type")]))
+			})
+			ts := httptest.NewTLSServer(mux)
+			defer ts.Close()
+
+			_ = os.Setenv(netboxdiodeplugin.BaseURLEnvVarName, fmt.Sprintf("%s/api/diode", ts.URL))
+			_ = os.Setenv(netboxdiodeplugin.TLSSkipVerifyEnvVarName, "true")
+
+			client, err := netboxdiodeplugin.NewClient(logger, "foobar")
+			require.NoError(t, err)
+
+			// the second call shows whether the bulk endpoint is still used
+			for i := 0; i < 2; i++ {
+				resp, err := client.RetrieveObjectStates(context.Background(), params)
+				if tt.shouldError {
+					require.Error(t, err)
+					break
+				}
+				require.NoError(t, err)
+				assert.Equal(t, expected, resp)
+			}
+
+			assert.Equal(t, tt.expectedBulkRequests, bulkRequests)
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}
+
 func cleanUpEnvVars() {
 	_ = os.Unsetenv(netboxdiodeplugin.BaseURLEnvVarName)
 	_ = os.Unsetenv(netboxdiodeplugin.TimeoutSecondsEnvVarName)
@@ -756,3 +1058,7 @@ func cleanUpEnvVars() {
 func ptrInt(i int) *int {
 	return &i
 }
+
+func ptrString(s string) *string {
+	return &s
+}
@@ -0,0 +1,105 @@
+package netboxdiodeplugin
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+type lruCacheEntry struct {
+	objectType string
+	key        string
+	value      []byte
+}
+
+// LRUObjectStateCache is an in-process object state cache evicting the least recently used object states
+type LRUObjectStateCache struct {
+	mu       sync.Mutex
+	size     int
+	notFound bool
+	entries  *list.List
+	index    map[string]*list.Element
+}
+
+// NewLRUObjectStateCache creates a new in-process object state cache holding up to size object states of existing
+// objects, the objects created by other replicas would be missed otherwise
+func NewLRUObjectStateCache(size int) *LRUObjectStateCache {
+	return &LRUObjectStateCache{
+		size:    size,
+		entries: list.New(),
+		index:   make(map[string]*list.Element),
+	}
+}
+
+// NewScopedLRUObjectStateCache creates a new in-process object state cache holding up to size object states, objects
+// not found included, for caches scoped to a single operation such as a transaction
+func NewScopedLRUObjectStateCache(size int) *LRUObjectStateCache {
+	c := NewLRUObjectStateCache(size)
+	c.notFound = true
+	return c
+}
+
+// CachesNotFound returns true if the states of objects not found are cached
+func (c *LRUObjectStateCache) CachesNotFound() bool {
+	return c.notFound
+}
+
+// Get returns the object state cached under key, false if there is none
+func (c *LRUObjectStateCache) Get(_ context.Context, _ string, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		return nil, false, nil
+	}
+	c.entries.MoveToFront(elem)
+
+	return elem.Value.(*lruCacheEntry).value, true, nil
+}
+
+// Set caches an object state under key
+func (c *LRUObjectStateCache) Set(_ context.Context, objectType string, key string, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.index[key]; ok {
+		elem.Value.(*lruCacheEntry).value = value
+		c.entries.MoveToFront(elem)
+		return nil
+	}
+
+	c.index[key] = c.entries.PushFront(&lruCacheEntry{objectType: objectType, key: key, value: value})
+
+	for c.entries.Len() > c.size {
+		c.remove(c.entries.Back())
+	}
+
+	return nil
+}
+
+// Invalidate removes all object states cached for the object types
+func (c *LRUObjectStateCache) Invalidate(_ context.Context, objectTypes ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	invalidated := make(map[string]struct{}, len(objectTypes))
+	for _, objectType := range objectTypes {
+		invalidated[objectType] = struct{}{}
+	}
+
+	for elem := c.entries.Front(); elem != nil; {
+		next := elem.Next()
+		if _, ok := invalidated[elem.Value.(*lruCacheEntry).objectType]; ok {
+			c.remove(elem)
+		}
+		elem = next
+	}
+
+	return nil
+}
+
+func (c *LRUObjectStateCache) remove(elem *list.Element) {
+	c.entries.Remove(elem)
+	delete(c.index, elem.Value.(*lruCacheEntry).key)
+}
@@ -1,13 +1,12 @@
-// Code generated by mockery v2.42.1. DO NOT EDIT.
+// Code generated by mockery v2.43.2. DO NOT EDIT.
 
 package mocks
 
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	netboxdiodeplugin "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // NetBoxAPI is an autogenerated mock type for the NetBoxAPI type
@@ -141,6 +140,65 @@ func (_c *NetBoxAPI_RetrieveObjectState_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// RetrieveObjectStates provides a mock function with given fields: _a0, _a1
+func (_m *NetBoxAPI) RetrieveObjectStates(_a0 context.Context, _a1 []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveObjectStates")
+	}
+
+	var r0 []*netboxdiodeplugin.ObjectState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []netboxdiodeplugin.RetrieveObjectStateQueryParams) []*netboxdiodeplugin.ObjectState); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*netboxdiodeplugin.ObjectState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []netboxdiodeplugin.RetrieveObjectStateQueryParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetBoxAPI_RetrieveObjectStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveObjectStates'
+type NetBoxAPI_RetrieveObjectStates_Call struct {
+	*mock.Call
+}
+
+// RetrieveObjectStates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []netboxdiodeplugin.RetrieveObjectStateQueryParams
+func (_e *NetBoxAPI_Expecter) RetrieveObjectStates(_a0 interface{}, _a1 interface{}) *NetBoxAPI_RetrieveObjectStates_Call {
+	return &NetBoxAPI_RetrieveObjectStates_Call{Call: _e.mock.On("RetrieveObjectStates", _a0, _a1)}
+}
+
+func (_c *NetBoxAPI_RetrieveObjectStates_Call) Run(run func(_a0 context.Context, _a1 []netboxdiodeplugin.RetrieveObjectStateQueryParams)) *NetBoxAPI_RetrieveObjectStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]netboxdiodeplugin.RetrieveObjectStateQueryParams))
+	})
+	return _c
+}
+
+func (_c *NetBoxAPI_RetrieveObjectStates_Call) Return(_a0 []*netboxdiodeplugin.ObjectState, _a1 error) *NetBoxAPI_RetrieveObjectStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetBoxAPI_RetrieveObjectStates_Call) RunAndReturn(run func(context.Context, []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error)) *NetBoxAPI_RetrieveObjectStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNetBoxAPI creates a new instance of NetBoxAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNetBoxAPI(t interface {
@@ -0,0 +1,258 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotFoundObjectStateCache is an autogenerated mock type for the NotFoundObjectStateCache type
+type NotFoundObjectStateCache struct {
+	mock.Mock
+}
+
+type NotFoundObjectStateCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotFoundObjectStateCache) EXPECT() *NotFoundObjectStateCache_Expecter {
+	return &NotFoundObjectStateCache_Expecter{mock: &_m.Mock}
+}
+
+// CachesNotFound provides a mock function with given fields:
+func (_m *NotFoundObjectStateCache) CachesNotFound() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CachesNotFound")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NotFoundObjectStateCache_CachesNotFound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CachesNotFound'
+type NotFoundObjectStateCache_CachesNotFound_Call struct {
+	*mock.Call
+}
+
+// CachesNotFound is a helper method to define mock.On call
+func (_e *NotFoundObjectStateCache_Expecter) CachesNotFound() *NotFoundObjectStateCache_CachesNotFound_Call {
+	return &NotFoundObjectStateCache_CachesNotFound_Call{Call: _e.mock.On("CachesNotFound")}
+}
+
+func (_c *NotFoundObjectStateCache_CachesNotFound_Call) Run(run func()) *NotFoundObjectStateCache_CachesNotFound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_CachesNotFound_Call) Return(_a0 bool) *NotFoundObjectStateCache_CachesNotFound_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_CachesNotFound_Call) RunAndReturn(run func() bool) *NotFoundObjectStateCache_CachesNotFound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, objectType, key
+func (_m *NotFoundObjectStateCache) Get(ctx context.Context, objectType string, key string) ([]byte, bool, error) {
+	ret := _m.Called(ctx, objectType, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []byte
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, bool, error)); ok {
+		return rf(ctx, objectType, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, objectType, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = rf(ctx, objectType, key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, objectType, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NotFoundObjectStateCache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type NotFoundObjectStateCache_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectType string
+//   - key string
+func (_e *NotFoundObjectStateCache_Expecter) Get(ctx interface{}, objectType interface{}, key interface{}) *NotFoundObjectStateCache_Get_Call {
+	return &NotFoundObjectStateCache_Get_Call{Call: _e.mock.On("Get", ctx, objectType, key)}
+}
+
+func (_c *NotFoundObjectStateCache_Get_Call) Run(run func(ctx context.Context, objectType string, key string)) *NotFoundObjectStateCache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_Get_Call) Return(_a0 []byte, _a1 bool, _a2 error) *NotFoundObjectStateCache_Get_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_Get_Call) RunAndReturn(run func(context.Context, string, string) ([]byte, bool, error)) *NotFoundObjectStateCache_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, objectTypes
+func (_m *NotFoundObjectStateCache) Invalidate(ctx context.Context, objectTypes ...string) error {
+	_va := make([]interface{}, len(objectTypes))
+	for _i := range objectTypes {
+		_va[_i] = objectTypes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) error); ok {
+		r0 = rf(ctx, objectTypes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotFoundObjectStateCache_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type NotFoundObjectStateCache_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectTypes ...string
+func (_e *NotFoundObjectStateCache_Expecter) Invalidate(ctx interface{}, objectTypes ...interface{}) *NotFoundObjectStateCache_Invalidate_Call {
+	return &NotFoundObjectStateCache_Invalidate_Call{Call: _e.mock.On("Invalidate",
+		append([]interface{}{ctx}, objectTypes...)...)}
+}
+
+func (_c *NotFoundObjectStateCache_Invalidate_Call) Run(run func(ctx context.Context, objectTypes ...string)) *NotFoundObjectStateCache_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_Invalidate_Call) Return(_a0 error) *NotFoundObjectStateCache_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_Invalidate_Call) RunAndReturn(run func(context.Context, ...string) error) *NotFoundObjectStateCache_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, objectType, key, value
+func (_m *NotFoundObjectStateCache) Set(ctx context.Context, objectType string, key string, value []byte) error {
+	ret := _m.Called(ctx, objectType, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, objectType, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotFoundObjectStateCache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type NotFoundObjectStateCache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectType string
+//   - key string
+//   - value []byte
+func (_e *NotFoundObjectStateCache_Expecter) Set(ctx interface{}, objectType interface{}, key interface{}, value interface{}) *NotFoundObjectStateCache_Set_Call {
+	return &NotFoundObjectStateCache_Set_Call{Call: _e.mock.On("Set", ctx, objectType, key, value)}
+}
+
+func (_c *NotFoundObjectStateCache_Set_Call) Run(run func(ctx context.Context, objectType string, key string, value []byte)) *NotFoundObjectStateCache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_Set_Call) Return(_a0 error) *NotFoundObjectStateCache_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotFoundObjectStateCache_Set_Call) RunAndReturn(run func(context.Context, string, string, []byte) error) *NotFoundObjectStateCache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotFoundObjectStateCache creates a new instance of NotFoundObjectStateCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotFoundObjectStateCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotFoundObjectStateCache {
+	mock := &NotFoundObjectStateCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,213 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ObjectStateCache is an autogenerated mock type for the ObjectStateCache type
+type ObjectStateCache struct {
+	mock.Mock
+}
+
+type ObjectStateCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ObjectStateCache) EXPECT() *ObjectStateCache_Expecter {
+	return &ObjectStateCache_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, objectType, key
+func (_m *ObjectStateCache) Get(ctx context.Context, objectType string, key string) ([]byte, bool, error) {
+	ret := _m.Called(ctx, objectType, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []byte
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, bool, error)); ok {
+		return rf(ctx, objectType, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, objectType, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = rf(ctx, objectType, key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, objectType, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ObjectStateCache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ObjectStateCache_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectType string
+//   - key string
+func (_e *ObjectStateCache_Expecter) Get(ctx interface{}, objectType interface{}, key interface{}) *ObjectStateCache_Get_Call {
+	return &ObjectStateCache_Get_Call{Call: _e.mock.On("Get", ctx, objectType, key)}
+}
+
+func (_c *ObjectStateCache_Get_Call) Run(run func(ctx context.Context, objectType string, key string)) *ObjectStateCache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStateCache_Get_Call) Return(_a0 []byte, _a1 bool, _a2 error) *ObjectStateCache_Get_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ObjectStateCache_Get_Call) RunAndReturn(run func(context.Context, string, string) ([]byte, bool, error)) *ObjectStateCache_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, objectTypes
+func (_m *ObjectStateCache) Invalidate(ctx context.Context, objectTypes ...string) error {
+	_va := make([]interface{}, len(objectTypes))
+	for _i := range objectTypes {
+		_va[_i] = objectTypes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) error); ok {
+		r0 = rf(ctx, objectTypes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStateCache_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type ObjectStateCache_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectTypes ...string
+func (_e *ObjectStateCache_Expecter) Invalidate(ctx interface{}, objectTypes ...interface{}) *ObjectStateCache_Invalidate_Call {
+	return &ObjectStateCache_Invalidate_Call{Call: _e.mock.On("Invalidate",
+		append([]interface{}{ctx}, objectTypes...)...)}
+}
+
+func (_c *ObjectStateCache_Invalidate_Call) Run(run func(ctx context.Context, objectTypes ...string)) *ObjectStateCache_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ObjectStateCache_Invalidate_Call) Return(_a0 error) *ObjectStateCache_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStateCache_Invalidate_Call) RunAndReturn(run func(context.Context, ...string) error) *ObjectStateCache_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, objectType, key, value
+func (_m *ObjectStateCache) Set(ctx context.Context, objectType string, key string, value []byte) error {
+	ret := _m.Called(ctx, objectType, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, objectType, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStateCache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type ObjectStateCache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectType string
+//   - key string
+//   - value []byte
+func (_e *ObjectStateCache_Expecter) Set(ctx interface{}, objectType interface{}, key interface{}, value interface{}) *ObjectStateCache_Set_Call {
+	return &ObjectStateCache_Set_Call{Call: _e.mock.On("Set", ctx, objectType, key, value)}
+}
+
+func (_c *ObjectStateCache_Set_Call) Run(run func(ctx context.Context, objectType string, key string, value []byte)) *ObjectStateCache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *ObjectStateCache_Set_Call) Return(_a0 error) *ObjectStateCache_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStateCache_Set_Call) RunAndReturn(run func(context.Context, string, string, []byte) error) *ObjectStateCache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewObjectStateCache creates a new instance of ObjectStateCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewObjectStateCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ObjectStateCache {
+	mock := &ObjectStateCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
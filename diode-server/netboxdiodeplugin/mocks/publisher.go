@@ -0,0 +1,88 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+// Publisher is an autogenerated mock type for the Publisher type
+type Publisher struct {
+	mock.Mock
+}
+
+type Publisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Publisher) EXPECT() *Publisher_Expecter {
+	return &Publisher_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, channel, message
+func (_m *Publisher) Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd {
+	ret := _m.Called(ctx, channel, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 *redis.IntCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) *redis.IntCmd); ok {
+		r0 = rf(ctx, channel, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.IntCmd)
+		}
+	}
+
+	return r0
+}
+
+// Publisher_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type Publisher_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - message interface{}
+func (_e *Publisher_Expecter) Publish(ctx interface{}, channel interface{}, message interface{}) *Publisher_Publish_Call {
+	return &Publisher_Publish_Call{Call: _e.mock.On("Publish", ctx, channel, message)}
+}
+
+func (_c *Publisher_Publish_Call) Run(run func(ctx context.Context, channel string, message interface{})) *Publisher_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Publisher_Publish_Call) Return(_a0 *redis.IntCmd) *Publisher_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Publisher_Publish_Call) RunAndReturn(run func(context.Context, string, interface{}) *redis.IntCmd) *Publisher_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublisher creates a new instance of Publisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Publisher {
+	mock := &Publisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,73 @@
+package netboxdiodeplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisObjectStateCacheKeyPrefix is the key prefix of the hashes holding cached object states per object type
+const RedisObjectStateCacheKeyPrefix = "diode.object-state-cache"
+
+// RedisObjectStateCache is an object state cache shared through Redis
+type RedisObjectStateCache struct {
+	client redis.Cmdable
+	ttl    time.Duration
+}
+
+// NewRedisObjectStateCache creates a new Redis object state cache, the object states of an object type are removed
+// once none was cached for ttl
+func NewRedisObjectStateCache(client redis.Cmdable, ttl time.Duration) *RedisObjectStateCache {
+	return &RedisObjectStateCache{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// CachesNotFound returns true as the cache is shared by all the replicas, which invalidate it when creating objects
+func (c *RedisObjectStateCache) CachesNotFound() bool {
+	return true
+}
+
+// Get returns the object state cached under key, false if there is none
+func (c *RedisObjectStateCache) Get(ctx context.Context, objectType string, key string) ([]byte, bool, error) {
+	value, err := c.client.HGet(ctx, redisObjectStateCacheKey(objectType), key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set caches an object state under key
+func (c *RedisObjectStateCache) Set(ctx context.Context, objectType string, key string, value []byte) error {
+	hashKey := redisObjectStateCacheKey(objectType)
+
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, hashKey, key, value)
+	pipe.Expire(ctx, hashKey, c.ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Invalidate removes all object states cached for the object types
+func (c *RedisObjectStateCache) Invalidate(ctx context.Context, objectTypes ...string) error {
+	if len(objectTypes) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(objectTypes))
+	for _, objectType := range objectTypes {
+		keys = append(keys, redisObjectStateCacheKey(objectType))
+	}
+	return c.client.Del(ctx, keys...).Err()
+}
+
+func redisObjectStateCacheKey(objectType string) string {
+	return fmt.Sprintf("%s:%s", RedisObjectStateCacheKeyPrefix, objectType)
+}
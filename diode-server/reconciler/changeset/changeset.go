@@ -2,6 +2,7 @@ package changeset
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -18,14 +19,22 @@ const (
 
 	// ChangeTypeUpdate is the change type for an update
 	ChangeTypeUpdate = "update"
+
+	// ChangeTypeDelete is the change type for a deletion
+	ChangeTypeDelete = "delete"
 )
 
 // IngestEntity represents an ingest entity
 type IngestEntity struct {
-	RequestID string `json:"request_id"`
-	DataType  string `json:"data_type"`
-	Entity    any    `json:"entity"`
-	State     int    `json:"state"`
+	RequestID       string `json:"request_id"`
+	ProducerAppName string `json:"producer_app_name"`
+	DataType        string `json:"data_type"`
+	Entity          any    `json:"entity"`
+	State           int    `json:"state"`
+
+	// StaleFields are the fields of the root object observed at source before their last applied observation, left
+	// untouched
+	StaleFields []string `json:"stale_fields,omitempty"`
 }
 
 // ObjectState represents a object state
@@ -44,48 +53,200 @@ type ChangeSet struct {
 
 // Change represents a change for the change set
 type Change struct {
-	ChangeID      string `json:"change_id"`
-	ChangeType    string `json:"change_type"`
-	ObjectType    string `json:"object_type"`
-	ObjectID      *int   `json:"object_id,omitempty"`
-	ObjectVersion *int   `json:"object_version,omitempty"`
-	Data          any    `json:"data"`
+	ChangeID      string   `json:"change_id"`
+	ChangeType    string   `json:"change_type"`
+	ObjectType    string   `json:"object_type"`
+	ObjectID      *int     `json:"object_id,omitempty"`
+	ObjectVersion *int     `json:"object_version,omitempty"`
+	Data          any      `json:"data"`
+	SkippedFields []string `json:"skipped_fields,omitempty"`
+	ClearedFields []string `json:"cleared_fields,omitempty"`
+
+	// Before is the state of the object in NetBox before an update or a deletion, as retrieved when preparing the
+	// change
+	Before json.RawMessage `json:"before,omitempty"`
+
+	// QueryParams are the query parameters identifying the object of a creation, used to look it up when reverted
+	QueryParams map[string]string `json:"query_params,omitempty"`
+
+	// Diff lists the fields changed with their values before and after the change
+	Diff []netbox.FieldChange `json:"diff,omitempty"`
+}
+
+// Option configures how a change set is prepared
+type Option func(*options)
+
+type options struct {
+	interfaceMACAddressMatching bool
+	fieldOwnership              map[string]netbox.FieldOwnershipPolicy
+	tagPolicy                   netbox.TagPolicy
+	matchingStrategies          map[string]netbox.MatchingStrategy
+	interfaceNaming             *netbox.InterfaceNaming
+	interfaceTypeRules          netbox.InterfaceTypeRules
+	deviceTypeLibrary           *netbox.DeviceTypeLibrary
+	parentPrefixes              bool
+	parentPrefixStatus          *string
+}
+
+// WithInterfaceMACAddressMatching enables looking up existing interfaces by MAC address when they can't be
+// matched by name (e.g. renamed ports)
+func WithInterfaceMACAddressMatching(enabled bool) Option {
+	return func(o *options) {
+		o.interfaceMACAddressMatching = enabled
+	}
+}
+
+// WithFieldOwnership sets the field ownership policies per object type, fields without an ownership are
+// authoritative
+func WithFieldOwnership(policies map[string]netbox.FieldOwnershipPolicy) Option {
+	return func(o *options) {
+		o.fieldOwnership = policies
+	}
+}
+
+// WithTagPolicy sets the tag policy merging the ingested tags of the object of the entity with its tags in NetBox,
+// tags are added to the ones in NetBox by default
+func WithTagPolicy(policy netbox.TagPolicy) Option {
+	return func(o *options) {
+		o.tagPolicy = policy
+	}
+}
+
+// WithMatchingStrategies sets the matching strategies per object type, objects without a matching strategy are
+// matched by name
+func WithMatchingStrategies(strategies map[string]netbox.MatchingStrategy) Option {
+	return func(o *options) {
+		o.matchingStrategies = strategies
+	}
+}
+
+// WithInterfaceNaming sets the naming conventions ingested interface names are canonicalised to, interface names are
+// kept as ingested without
+func WithInterfaceNaming(naming *netbox.InterfaceNaming) Option {
+	return func(o *options) {
+		o.interfaceNaming = naming
+	}
+}
+
+// WithInterfaceTypeRules sets the rules inferring the type of interfaces ingested without one, interfaces matching no
+// rule are created with the default type
+func WithInterfaceTypeRules(rules netbox.InterfaceTypeRules) Option {
+	return func(o *options) {
+		o.interfaceTypeRules = rules
+	}
+}
+
+// WithDeviceTypeLibrary sets the device type library reported device types are mapped to the canonical manufacturer,
+// model and slug of, device types not in the library are kept as reported
+func WithDeviceTypeLibrary(lib *netbox.DeviceTypeLibrary) Option {
+	return func(o *options) {
+		o.deviceTypeLibrary = lib
+	}
+}
+
+// WithParentPrefixes enables creating the prefix containing ingested IP addresses, derived from their mask, when it
+// doesn't exist, with the given status unless empty
+func WithParentPrefixes(enabled bool, status string) Option {
+	return func(o *options) {
+		o.parentPrefixes = enabled
+		o.parentPrefixStatus = nil
+		if status != "" {
+			o.parentPrefixStatus = &status
+		}
+	}
 }
 
 // Prepare prepares a change set
-func Prepare(entity IngestEntity, netboxAPI netboxdiodeplugin.NetBoxAPI) (*ChangeSet, error) {
+func Prepare(entity IngestEntity, netboxAPI netboxdiodeplugin.NetBoxAPI, opts ...Option) (*ChangeSet, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	// extract ingested entity (actual)
 	actual, err := extractIngestEntityData(entity)
 	if err != nil {
 		return nil, err
 	}
 
+	// the parent prefix is derived from the object as ingested, before placeholders are set for its nested objects
+	var parentPrefix netbox.ComparableData
+	if deriver, ok := actual.(netbox.ParentPrefixDeriver); ok && o.parentPrefixes {
+		parentPrefix, err = deriver.ParentPrefix(o.parentPrefixStatus)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := prepare(actual, entity.DataType, netboxAPI, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentPrefix != nil {
+		prefixChanges, err := prepare(parentPrefix, netbox.IpamPrefixObjectType, netboxAPI, o)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare parent prefix: %w", err)
+		}
+
+		// existing prefixes are left untouched, changes identical to the ones of the object (e.g. the creation of
+		// their site) are kept once
+		if createsObject(prefixChanges, netbox.IpamPrefixObjectType) {
+			cs, err := Merge(&ChangeSet{ChangeSet: prefixChanges}, &ChangeSet{ChangeSet: changes})
+			if err != nil {
+				return nil, err
+			}
+			changes = cs.ChangeSet
+		}
+	}
+
+	return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+}
+
+// prepare prepares the changes of an object and its nested objects
+func prepare(actual netbox.ComparableData, dataType string, netboxAPI netboxdiodeplugin.NetBoxAPI, o options) ([]Change, error) {
 	// get root object and all its nested objects (actual)
 	actualNestedObjects, err := actual.NestedObjects()
 	if err != nil {
 		return nil, err
 	}
 
+	applyOptions(actualNestedObjects, o)
+
 	// map out root object and all its nested objects (actual)
 	actualNestedObjectsMap := make(map[string]netbox.ComparableData)
 	for _, obj := range actualNestedObjects {
 		actualNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = obj
 	}
 
-	// retrieve root object all its nested objects from NetBox (intended)
+	// retrieve root object all its nested objects from NetBox (intended) in one batch along with the versions they
+	// were read at
+	intendedNestedObjects, objectVersionsList, err := retrieveObjectStates(netboxAPI, actualNestedObjects, o)
+	if err != nil {
+		return nil, err
+	}
+
 	intendedNestedObjectsMap := make(map[string]netbox.ComparableData)
-	for _, obj := range actualNestedObjects {
-		intended, err := retrieveObjectState(netboxAPI, obj)
-		if err != nil {
-			return nil, err
-		}
+	objectVersions := make(map[string]*int)
+	for i, obj := range actualNestedObjects {
+		intended := intendedNestedObjects[i]
 		intendedNestedObjectsMap[fmt.Sprintf("%p", obj.Data())] = intended
+		if intended != nil {
+			objectVersions[objectVersionKey(obj.DataType(), intended.ID())] = objectVersionsList[i]
+		}
+	}
+
+	// capture the state of the objects in NetBox and the query parameters of the ingested objects before they're
+	// patched, so the changes can be reverted
+	beforeStates, queryParams, err := objectStatesBeforePatch(actualNestedObjects, intendedNestedObjectsMap)
+	if err != nil {
+		return nil, err
 	}
 
 	// map out retrieved root object and all its nested objects (current)
 	var current netbox.ComparableData
 	for _, obj := range actualNestedObjects {
-		if obj.DataType() == entity.DataType {
+		if obj.DataType() == dataType {
 			current = intendedNestedObjectsMap[fmt.Sprintf("%p", obj.Data())]
 			break
 		}
@@ -102,37 +263,310 @@ func Prepare(entity IngestEntity, netboxAPI netboxdiodeplugin.NetBoxAPI) (*Chang
 	for _, obj := range objectsToReconcile {
 		operation := ChangeTypeCreate
 		var objectID *int
+		var objectVersion *int
 
 		id := obj.ID()
 		if id > 0 {
 			objectID = &id
+			objectVersion = objectVersions[objectVersionKey(obj.DataType(), id)]
 			operation = ChangeTypeUpdate
 		}
 
+		var skippedFields []string
+		if enforcer, ok := obj.(netbox.FieldOwnershipEnforcer); ok {
+			skippedFields = enforcer.SkippedFields()
+		}
+
+		var clearedFields []string
+		if clearer, ok := obj.(netbox.FieldClearer); ok {
+			clearedFields = clearer.ClearedFields()
+		}
+
+		key := fmt.Sprintf("%p", obj.Data())
+
+		var before json.RawMessage
+		var params map[string]string
+		var diff []netbox.FieldChange
+		if operation == ChangeTypeUpdate {
+			before = beforeStates[key]
+			if differ, ok := obj.(netbox.FieldDiffer); ok {
+				diff = differ.ChangedFields()
+			}
+		} else {
+			if params = queryParams[key]; params == nil {
+				// objects created while patching (e.g. tags) have no nested objects to be replaced by references
+				params = obj.ObjectStateQueryParams()
+			}
+			diff = netbox.DiffFields(nil, obj.Data())
+		}
+
 		changes = append(changes, Change{
 			ChangeID:      uuid.NewString(),
 			ChangeType:    operation,
 			ObjectType:    obj.DataType(),
 			ObjectID:      objectID,
-			ObjectVersion: nil,
+			ObjectVersion: objectVersion,
 			Data:          obj.Data(),
+			SkippedFields: skippedFields,
+			ClearedFields: clearedFields,
+			Before:        before,
+			QueryParams:   params,
+			Diff:          diff,
 		})
 	}
 
-	return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+	return changes, nil
 }
 
-func retrieveObjectState(netboxAPI netboxdiodeplugin.NetBoxAPI, change netbox.ComparableData) (netbox.ComparableData, error) {
-	params := netboxdiodeplugin.RetrieveObjectStateQueryParams{
-		ObjectID:   0,
-		ObjectType: change.DataType(),
-		Params:     change.ObjectStateQueryParams(),
+// objectStatesBeforePatch returns the JSON state in NetBox of the objects found and the query parameters of the
+// objects, keyed by the address of their data
+func objectStatesBeforePatch(objects []netbox.ComparableData, intendedNestedObjectsMap map[string]netbox.ComparableData) (map[string]json.RawMessage, map[string]map[string]string, error) {
+	beforeStates := make(map[string]json.RawMessage)
+	queryParams := make(map[string]map[string]string)
+	for _, obj := range objects {
+		key := fmt.Sprintf("%p", obj.Data())
+		queryParams[key] = obj.ObjectStateQueryParams()
+
+		intended := intendedNestedObjectsMap[key]
+		if intended == nil {
+			continue
+		}
+
+		b, err := json.Marshal(intended.Data())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal state of %s %d: %w", intended.DataType(), intended.ID(), err)
+		}
+		beforeStates[key] = b
+	}
+	return beforeStates, queryParams, nil
+}
+
+func createsObject(changes []Change, objectType string) bool {
+	for _, change := range changes {
+		if change.ChangeType == ChangeTypeCreate && change.ObjectType == objectType {
+			return true
+		}
+	}
+	return false
+}
+
+// ObjectStateQueryParams returns the query parameters identifying the root object of an ingest entity
+func ObjectStateQueryParams(entity IngestEntity, opts ...Option) (map[string]string, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	actual, err := extractIngestEntityData(entity)
+	if err != nil {
+		return nil, err
 	}
-	resp, err := netboxAPI.RetrieveObjectState(context.Background(), params)
+
+	// nested objects are resolved first as they may set placeholders the query parameters depend on
+	actualNestedObjects, err := actual.NestedObjects()
 	if err != nil {
 		return nil, err
 	}
 
+	applyOptions(actualNestedObjects, o)
+
+	return actual.ObjectStateQueryParams(), nil
+}
+
+// ObjectStateLookups returns the query parameters of the object states first retrieved when preparing the change set
+// of an ingest entity, e.g. to retrieve the ones of several ingest entities in one batch beforehand
+func ObjectStateLookups(entity IngestEntity, opts ...Option) ([]netboxdiodeplugin.RetrieveObjectStateQueryParams, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	actual, err := extractIngestEntityData(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	actualNestedObjects, err := actual.NestedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	applyOptions(actualNestedObjects, o)
+
+	params := make([]netboxdiodeplugin.RetrieveObjectStateQueryParams, 0, len(actualNestedObjects))
+	for _, obj := range actualNestedObjects {
+		if lookups := objectStateLookups(obj, o); len(lookups) > 0 {
+			params = append(params, lookups[0].params)
+		}
+	}
+
+	return params, nil
+}
+
+// applyOptions sets the field ownership policies and matching strategies of objects per their object type and the
+// interface type rules, and canonicalises interface names and device types
+func applyOptions(objects []netbox.ComparableData, o options) {
+	for _, obj := range objects {
+		if matcher, ok := obj.(netbox.DeviceTypeLibraryMatcher); ok && o.deviceTypeLibrary != nil {
+			matcher.MatchDeviceTypeLibrary(o.deviceTypeLibrary)
+		}
+		if inferrer, ok := obj.(netbox.InterfaceTypeInferrer); ok {
+			inferrer.SetInterfaceTypeRules(o.interfaceTypeRules)
+		}
+		if normaliser, ok := obj.(netbox.InterfaceNameNormaliser); ok && o.interfaceNaming != nil {
+			normaliser.NormaliseInterfaceName(o.interfaceNaming)
+		}
+		if enforcer, ok := obj.(netbox.FieldOwnershipEnforcer); ok {
+			enforcer.SetFieldOwnershipPolicy(o.fieldOwnership[obj.DataType()])
+		}
+		if merger, ok := obj.(netbox.TagMerger); ok {
+			merger.SetTagPolicy(o.tagPolicy)
+		}
+		if matcher, ok := obj.(netbox.ObjectMatcher); ok {
+			matcher.SetMatchingStrategy(o.matchingStrategies[obj.DataType()])
+		}
+	}
+}
+
+func objectVersionKey(objectType string, objectID int) string {
+	return fmt.Sprintf("%s:%d", objectType, objectID)
+}
+
+// retrieveObjectState retrieves the object state of a change along with its version, the ID of the last object
+// change recorded in NetBox, nil if unknown
+func retrieveObjectState(netboxAPI netboxdiodeplugin.NetBoxAPI, change netbox.ComparableData) (netbox.ComparableData, *int, error) {
+	resp, err := netboxAPI.RetrieveObjectState(context.Background(), objectStateQueryParams(change))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objectStateData(change, resp)
+}
+
+// retrieveObjectStates retrieves the object states of changes in batches along with their versions, in the order of
+// the changes. The first lookup of every change is made in one batch, changes not found are looked up again by their
+// next lookup, if any, in another batch
+func retrieveObjectStates(netboxAPI netboxdiodeplugin.NetBoxAPI, changes []netbox.ComparableData, o options) ([]netbox.ComparableData, []*int, error) {
+	lookups := make([][]objectStateLookup, 0, len(changes))
+	for _, change := range changes {
+		lookups = append(lookups, objectStateLookups(change, o))
+	}
+
+	resps := make([]*netboxdiodeplugin.ObjectState, len(changes))
+	for round := 0; ; round++ {
+		indexes := make([]int, 0)
+		params := make([]netboxdiodeplugin.RetrieveObjectStateQueryParams, 0)
+		for i := range changes {
+			if resps[i] != nil && resps[i].Object.IsValid() {
+				continue
+			}
+			if round < len(lookups[i]) {
+				indexes = append(indexes, i)
+				params = append(params, lookups[i][round].params)
+			}
+		}
+
+		if len(params) == 0 {
+			break
+		}
+
+		batch, err := retrieveObjectStatesBatch(netboxAPI, params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for j, i := range indexes {
+			resps[i] = batch[j]
+
+			if matcher, ok := changes[i].(netbox.ObjectMatcher); ok && batch[j].Object.IsValid() {
+				matcher.SetMatchedBy(lookups[i][round].matchingKey)
+			}
+		}
+	}
+
+	objectStates := make([]netbox.ComparableData, 0, len(changes))
+	objectVersions := make([]*int, 0, len(changes))
+	for i, change := range changes {
+		var dw netbox.ComparableData
+		var objectVersion *int
+		if resps[i] != nil {
+			var err error
+			dw, objectVersion, err = objectStateData(change, resps[i])
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		objectStates = append(objectStates, dw)
+		objectVersions = append(objectVersions, objectVersion)
+	}
+
+	return objectStates, objectVersions, nil
+}
+
+// objectStateLookup is a lookup of the object state of a change
+type objectStateLookup struct {
+	params      netboxdiodeplugin.RetrieveObjectStateQueryParams
+	matchingKey netbox.MatchingKey
+}
+
+// objectStateLookups returns the lookups of the object state of a change in the order they're tried: by the keys of
+// its matching strategy or its query parameters, then by its alternative key
+func objectStateLookups(change netbox.ComparableData, o options) []objectStateLookup {
+	lookups := make([]objectStateLookup, 0, 1)
+
+	if matcher, ok := change.(netbox.ObjectMatcher); ok && len(matcher.MatchingStrategy()) > 0 {
+		for _, queryParams := range matcher.MatchingObjectStateQueryParams() {
+			lookups = append(lookups, objectStateLookup{
+				params: netboxdiodeplugin.RetrieveObjectStateQueryParams{
+					ObjectType: change.DataType(),
+					Params:     queryParams.Params,
+				},
+				matchingKey: queryParams.Key,
+			})
+		}
+	} else {
+		lookups = append(lookups, objectStateLookup{params: objectStateQueryParams(change)})
+	}
+
+	if o.interfaceMACAddressMatching {
+		if fallback, ok := change.(netbox.FallbackQueryParamsProvider); ok {
+			if queryParams := fallback.FallbackObjectStateQueryParams(); queryParams != nil {
+				lookups = append(lookups, objectStateLookup{
+					params: netboxdiodeplugin.RetrieveObjectStateQueryParams{
+						ObjectType: change.DataType(),
+						Params:     queryParams,
+					},
+				})
+			}
+		}
+	}
+
+	return lookups
+}
+
+func retrieveObjectStatesBatch(netboxAPI netboxdiodeplugin.NetBoxAPI, params []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+	resps, err := netboxAPI.RetrieveObjectStates(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resps) != len(params) {
+		return nil, fmt.Errorf("expected %d object states, got %d", len(params), len(resps))
+	}
+
+	return resps, nil
+}
+
+func objectStateQueryParams(change netbox.ComparableData) netboxdiodeplugin.RetrieveObjectStateQueryParams {
+	return netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectID:   0,
+		ObjectType: change.DataType(),
+		Params:     change.ObjectStateQueryParams(),
+	}
+}
+
+// objectStateData extracts the object state of a change from a retrieved object state, nil if the object doesn't exist
+func objectStateData(change netbox.ComparableData, resp *netboxdiodeplugin.ObjectState) (netbox.ComparableData, *int, error) {
 	if resp.Object.IsValid() {
 		objectState := &ObjectState{
 			ObjectID:       resp.ObjectID,
@@ -141,10 +575,41 @@ func retrieveObjectState(netboxAPI netboxdiodeplugin.NetBoxAPI, change netbox.Co
 			Object:         resp.Object,
 		}
 
-		return extractNetBoxObjectStateData(*objectState)
+		dw, err := extractNetBoxObjectStateData(*objectState)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var objectVersion *int
+		if resp.ObjectChangeID > 0 {
+			objectVersion = &resp.ObjectChangeID
+		}
+
+		return dw, objectVersion, nil
 	}
 
-	return nil, nil
+	return nil, nil, nil
+}
+
+func retrieveObjectStateByQueryParams(netboxAPI netboxdiodeplugin.NetBoxAPI, objectType string, queryParams map[string]string) (netbox.ComparableData, *int, error) {
+	dw, err := netbox.NewDataWrapper(objectType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return retrieveObjectState(netboxAPI, &recordedObjectQuery{ComparableData: dw, queryParams: queryParams})
+}
+
+// recordedObjectQuery looks up an object state with previously recorded query parameters
+type recordedObjectQuery struct {
+	netbox.ComparableData
+
+	queryParams map[string]string
+}
+
+// ObjectStateQueryParams returns the recorded query parameters
+func (q *recordedObjectQuery) ObjectStateQueryParams() map[string]string {
+	return q.queryParams
 }
 
 func extractIngestEntityData(ingestEntity IngestEntity) (netbox.ComparableData, error) {
@@ -170,6 +635,15 @@ func extractIngestEntityData(ingestEntity IngestEntity) (netbox.ComparableData,
 		return nil, fmt.Errorf("invalid ingest entity")
 	}
 
+	if len(protoEntity.GetClearFields()) > 0 || len(protoEntity.GetRemoveTags()) > 0 {
+		if err := netbox.ValidateFieldsToClear(ingestEntity.DataType, protoEntity.GetClearFields()); err != nil {
+			return nil, err
+		}
+		if clearer, ok := dw.(netbox.FieldClearer); ok {
+			clearer.SetFieldsToClear(protoEntity.GetClearFields(), protoEntity.GetRemoveTags())
+		}
+	}
+
 	return dw, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
@@ -4327,11 +4328,971 @@ func TestDcimPrepare(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "[P16] ingest dcim.interface with MAC address in dotted notation - existing interface found with same MAC address - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:       "GigabitEthernet0/0/0",
+							Device:     &diodepb.Device{Name: "router01"},
+							Type:       "1000base-t",
+							MacAddress: strPtr("aabb.ccdd.eeff"),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/0", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "GigabitEthernet0/0/0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "router01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type:       strPtr("1000base-t"),
+							MACAddress: strPtr("aa:bb:cc:dd:ee:ff"),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P16] ingest dcim.interface with MAC address in hyphenated notation - existing interface not found - create interface with normalised MAC address",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:       "GigabitEthernet0/0/0",
+							Device:     &diodepb.Device{Name: "router01"},
+							Type:       "1000base-t",
+							MacAddress: strPtr("aa-bb-cc-dd-ee-ff"),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/0", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "dcim.interface",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.DcimInterface{
+							Name: "GigabitEthernet0/0/0",
+							Device: &netbox.DcimDevice{
+								ID: 1,
+							},
+							Type:       strPtr("1000base-t"),
+							MACAddress: strPtr("AA:BB:CC:DD:EE:FF"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P16] ingest dcim.interface with invalid MAC address - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:       "GigabitEthernet0/0/0",
+							Device:     &diodepb.Device{Name: "router01"},
+							Type:       "1000base-t",
+							MacAddress: strPtr("aa:bb:cc:dd:ee"),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			for _, m := range tt.retrieveObjectStates {
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+					ObjectType: m.objectType,
+					ObjectID:   m.objectID,
+					Params:     m.queryParams,
+				}).Return(&netboxdiodeplugin.ObjectState{
+					ObjectID:       m.objectID,
+					ObjectType:     m.objectType,
+					ObjectChangeID: m.objectChangeID,
+					Object:         m.object,
+				}, nil)
+			}
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet.ChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet.ChangeSet {
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].Data, cs.ChangeSet[i].Data)
+			}
+		})
+	}
+}
+
+func TestPrepareWithInterfaceMACAddressMatching(t *testing.T) {
+	type mockRetrieveObjectState struct {
+		objectType     string
+		objectID       int
+		queryParams    map[string]string
+		objectChangeID int
+		object         netbox.ComparableData
+	}
+	tests := []struct {
+		name                 string
+		ingestEntity         changeset.IngestEntity
+		opts                 []changeset.Option
+		retrieveObjectStates []mockRetrieveObjectState
+		wantChangeSet        changeset.ChangeSet
+		wantErr              bool
+	}{
+		{
+			name: "ingest renamed dcim.interface - existing interface not found by name, found by MAC address - rename interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:       "GigabitEthernet0/0/1",
+							Device:     &diodepb.Device{Name: "router01"},
+							Type:       "1000base-t",
+							MacAddress: strPtr("aabb.ccdd.eeff"),
+						},
+					},
+				},
+			},
+			opts: []changeset.Option{changeset.WithInterfaceMACAddressMatching(true)},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/1", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: nil,
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"mac_address": "AA:BB:CC:DD:EE:FF", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "GigabitEthernet0/0/0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "router01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type:       strPtr("1000base-t"),
+							MACAddress: strPtr("aa:bb:cc:dd:ee:ff"),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeUpdate,
+						ObjectType:    "dcim.interface",
+						ObjectID:      intPtr(1),
+						ObjectVersion: nil,
+						Data: &netbox.DcimInterface{
+							ID:   1,
+							Name: "GigabitEthernet0/0/1",
+							Device: &netbox.DcimDevice{
+								ID: 1,
+							},
+							Type:       strPtr("1000base-t"),
+							MACAddress: strPtr("AA:BB:CC:DD:EE:FF"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ingest renamed dcim.interface - MAC address matching disabled - create interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:       "GigabitEthernet0/0/1",
+							Device:     &diodepb.Device{Name: "router01"},
+							Type:       "1000base-t",
+							MacAddress: strPtr("aabb.ccdd.eeff"),
+						},
+					},
+				},
+			},
+			opts: []changeset.Option{changeset.WithInterfaceMACAddressMatching(false)},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/1", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "dcim.interface",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.DcimInterface{
+							Name: "GigabitEthernet0/0/1",
+							Device: &netbox.DcimDevice{
+								ID: 1,
+							},
+							Type:       strPtr("1000base-t"),
+							MACAddress: strPtr("AA:BB:CC:DD:EE:FF"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ingest dcim.interface without MAC address - existing interface not found by name - create interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:   "GigabitEthernet0/0/1",
+							Device: &diodepb.Device{Name: "router01"},
+							Type:   "1000base-t",
+						},
+					},
+				},
+			},
+			opts: []changeset.Option{changeset.WithInterfaceMACAddressMatching(true)},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/1", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "dcim.interface",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.DcimInterface{
+							Name: "GigabitEthernet0/0/1",
+							Device: &netbox.DcimDevice{
+								ID: 1,
+							},
+							Type: strPtr("1000base-t"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ingest renamed virtualization.vminterface - existing interface not found by name, found by MAC address - rename interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "virtualization.vminterface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Vminterface{
+						Vminterface: &diodepb.VMInterface{
+							Name:           "ens3",
+							VirtualMachine: &diodepb.VirtualMachine{Name: "vm01"},
+							MacAddress:     strPtr("aa-bb-cc-dd-ee-ff"),
+						},
+					},
+				},
+			},
+			opts: []changeset.Option{changeset.WithInterfaceMACAddressMatching(true)},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "virtualization.vminterface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ens3", "virtual_machine__name": "vm01", "virtual_machine__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.VirtualizationVMInterfaceDataWrapper{
+						VMInterface: nil,
+					},
+				},
+				{
+					objectType:     "virtualization.vminterface",
+					objectID:       0,
+					queryParams:    map[string]string{"mac_address": "AA:BB:CC:DD:EE:FF", "virtual_machine__name": "vm01", "virtual_machine__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.VirtualizationVMInterfaceDataWrapper{
+						VMInterface: &netbox.VirtualizationVMInterface{
+							ID:   1,
+							Name: "eth0",
+							VirtualMachine: &netbox.VirtualizationVirtualMachine{
+								ID:     1,
+								Name:   "vm01",
+								Status: strPtr(netbox.DefaultVirtualizationStatus),
+							},
+							MACAddress: strPtr("aa:bb:cc:dd:ee:ff"),
+						},
+					},
+				},
+				{
+					objectType:     "virtualization.virtualmachine",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "vm01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.VirtualizationVirtualMachineDataWrapper{
+						VirtualMachine: &netbox.VirtualizationVirtualMachine{
+							ID:   1,
+							Name: "vm01",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeUpdate,
+						ObjectType:    "virtualization.vminterface",
+						ObjectID:      intPtr(1),
+						ObjectVersion: nil,
+						Data: &netbox.VirtualizationVMInterface{
+							ID:   1,
+							Name: "ens3",
+							VirtualMachine: &netbox.VirtualizationVirtualMachine{
+								ID: 1,
+							},
+							MACAddress: strPtr("AA:BB:CC:DD:EE:FF"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
 
 			for _, m := range tt.retrieveObjectStates {
 				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
@@ -4346,7 +5307,7 @@ func TestDcimPrepare(t *testing.T) {
 				}, nil)
 			}
 
-			cs, err := changeset.Prepare(tt.ingestEntity, mockClient)
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, tt.opts...)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -4365,6 +5326,55 @@ func TestDcimPrepare(t *testing.T) {
 	}
 }
 
+func TestPrepareRetrievesObjectStatesInOneBatch(t *testing.T) {
+	mockClient := mocks.NewNetBoxAPI(t)
+
+	// the device, its site, device type, manufacturer and role, none of them existing yet
+	mockClient.EXPECT().RetrieveObjectStates(context.Background(), mock.MatchedBy(func(params []netboxdiodeplugin.RetrieveObjectStateQueryParams) bool {
+		return len(params) == 5
+	})).RunAndReturn(func(_ context.Context, params []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+		objStates := make([]*netboxdiodeplugin.ObjectState, 0, len(params))
+		for _, p := range params {
+			dw, err := netbox.NewDataWrapper(p.ObjectType)
+			if err != nil {
+				return nil, err
+			}
+			objStates = append(objStates, &netboxdiodeplugin.ObjectState{ObjectType: p.ObjectType, Object: dw})
+		}
+		return objStates, nil
+	}).Once()
+
+	cs, err := changeset.Prepare(changeset.IngestEntity{
+		RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+		DataType:  netbox.DcimDeviceObjectType,
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Device{
+				Device: &diodepb.Device{
+					Name: "Device A",
+					Site: &diodepb.Site{Name: "Site A"},
+				},
+			},
+		},
+	}, mockClient)
+	require.NoError(t, err)
+	assert.Len(t, cs.ChangeSet, 5)
+}
+
+// retrieveObjectStatesOneByOne serves the batch object state lookups of a mocked client with its single lookups
+func retrieveObjectStatesOneByOne(mockClient *mocks.NetBoxAPI) {
+	mockClient.EXPECT().RetrieveObjectStates(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, params []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+		objStates := make([]*netboxdiodeplugin.ObjectState, 0, len(params))
+		for _, p := range params {
+			objState, err := mockClient.RetrieveObjectState(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			objStates = append(objStates, objState)
+		}
+		return objStates, nil
+	}).Maybe()
+}
+
 func strPtr(s string) *string { return &s }
 func intPtr(d int) *int       { return &d }
 func int32Ptr(d int32) *int32 { return &d }
@@ -1837,11 +1837,909 @@ func TestIpamPrepare(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "[P3] ingest ipam.fhrpgroup with virtual IP - existing objects not found - create FHRP group and assign virtual IP to it",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroup{
+						FhrpGroup: &diodepb.FHRPGroup{
+							Protocol: "vrrp2",
+							GroupId:  10,
+							VirtualIps: []*diodepb.IPAddress{
+								{
+									Address: "192.168.0.1/24",
+									Role:    "vrrp",
+								},
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "ipam.ipaddress",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "192.168.0.1/24", "fhrp_group__protocol": "vrrp2", "fhrp_group__group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamIPAddressDataWrapper{
+						IPAddress: nil,
+					},
+				},
+				{
+					objectType:     "ipam.fhrpgroup",
+					objectID:       0,
+					queryParams:    map[string]string{"protocol": "vrrp2", "group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupDataWrapper{
+						FHRPGroup: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "ipam.fhrpgroup",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.IpamFHRPGroup{
+							Protocol: "vrrp2",
+							GroupID:  intPtr(10),
+							VirtualIPs: []*netbox.IpamIPAddress{
+								{
+									Address: "192.168.0.1/24",
+									AssignedObject: &netbox.IPAddressFHRPGroup{
+										FHRPGroup: &netbox.IpamFHRPGroup{
+											Protocol: "vrrp2",
+											GroupID:  intPtr(10),
+										},
+									},
+									Status: &netbox.DefaultIPAddressStatus,
+									Role:   strPtr("vrrp"),
+								},
+							},
+						},
+					},
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b6",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "ipam.ipaddress",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.IpamIPAddress{
+							Address: "192.168.0.1/24",
+							AssignedObject: &netbox.IPAddressFHRPGroup{
+								FHRPGroup: &netbox.IpamFHRPGroup{
+									Protocol: "vrrp2",
+									GroupID:  intPtr(10),
+								},
+							},
+							Status: &netbox.DefaultIPAddressStatus,
+							Role:   strPtr("vrrp"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P3] ingest ipam.fhrpgroup with virtual IP - existing FHRP group found, virtual IP not found - create virtual IP assigned to existing group",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroup{
+						FhrpGroup: &diodepb.FHRPGroup{
+							Protocol: "vrrp2",
+							GroupId:  10,
+							VirtualIps: []*diodepb.IPAddress{
+								{
+									Address: "192.168.0.1/24",
+									Role:    "vrrp",
+								},
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "ipam.ipaddress",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "192.168.0.1/24", "fhrp_group__protocol": "vrrp2", "fhrp_group__group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamIPAddressDataWrapper{
+						IPAddress: nil,
+					},
+				},
+				{
+					objectType:     "ipam.fhrpgroup",
+					objectID:       0,
+					queryParams:    map[string]string{"protocol": "vrrp2", "group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupDataWrapper{
+						FHRPGroup: &netbox.IpamFHRPGroup{
+							ID:       1,
+							Protocol: "vrrp2",
+							GroupID:  intPtr(10),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b6",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "ipam.ipaddress",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.IpamIPAddress{
+							Address: "192.168.0.1/24",
+							AssignedObject: &netbox.IPAddressFHRPGroup{
+								FHRPGroup: &netbox.IpamFHRPGroup{
+									ID:       1,
+									Protocol: "vrrp2",
+									GroupID:  intPtr(10),
+								},
+							},
+							Status: &netbox.DefaultIPAddressStatus,
+							Role:   strPtr("vrrp"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P3] ingest ipam.fhrpgroup with virtual IP - existing FHRP group and virtual IP found - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroup{
+						FhrpGroup: &diodepb.FHRPGroup{
+							Protocol: "vrrp2",
+							GroupId:  10,
+							VirtualIps: []*diodepb.IPAddress{
+								{
+									Address: "192.168.0.1/24",
+									Role:    "vrrp",
+								},
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "ipam.ipaddress",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "192.168.0.1/24", "fhrp_group__protocol": "vrrp2", "fhrp_group__group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamIPAddressDataWrapper{
+						IPAddress: &netbox.IpamIPAddress{
+							ID:      1,
+							Address: "192.168.0.1/24",
+							AssignedObject: &netbox.IPAddressFHRPGroup{
+								FHRPGroup: &netbox.IpamFHRPGroup{
+									ID:       1,
+									Protocol: "vrrp2",
+									GroupID:  intPtr(10),
+									Name:     strPtr("gateway"),
+								},
+							},
+							Status: &netbox.DefaultIPAddressStatus,
+							Role:   strPtr("vrrp"),
+						},
+					},
+				},
+				{
+					objectType:     "ipam.fhrpgroup",
+					objectID:       0,
+					queryParams:    map[string]string{"protocol": "vrrp2", "group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupDataWrapper{
+						FHRPGroup: &netbox.IpamFHRPGroup{
+							ID:       1,
+							Protocol: "vrrp2",
+							GroupID:  intPtr(10),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P3] ingest ipam.fhrpgroup with invalid protocol - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroup{
+						FhrpGroup: &diodepb.FHRPGroup{
+							Protocol: "vrrp4",
+							GroupId:  10,
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "[P4] ingest ipam.fhrpgroupassignment - existing FHRP group and interface found, assignment not found - assign group to interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroupassignment",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroupAssignment{
+						FhrpGroupAssignment: &diodepb.FHRPGroupAssignment{
+							Group: &diodepb.FHRPGroup{
+								Protocol: "vrrp2",
+								GroupId:  10,
+							},
+							Interface: &diodepb.Interface{
+								Name:   "GigabitEthernet0/0/0",
+								Device: &diodepb.Device{Name: "router02"},
+								Type:   "1000base-t",
+							},
+							Priority: int32Ptr(110),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "ipam.fhrpgroup",
+					objectID:       0,
+					queryParams:    map[string]string{"protocol": "vrrp2", "group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupDataWrapper{
+						FHRPGroup: &netbox.IpamFHRPGroup{
+							ID:       1,
+							Protocol: "vrrp2",
+							GroupID:  intPtr(10),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router02", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   2,
+							Name: "router02",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/0", "device__name": "router02", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   2,
+							Name: "GigabitEthernet0/0/0",
+							Device: &netbox.DcimDevice{
+								ID:   2,
+								Name: "router02",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("1000base-t"),
+						},
+					},
+				},
+				{
+					objectType:     "ipam.fhrpgroupassignment",
+					objectID:       0,
+					queryParams:    map[string]string{"group__protocol": "vrrp2", "group__group_id": "10", "interface__name": "GigabitEthernet0/0/0", "interface__device__name": "router02", "interface__device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupAssignmentDataWrapper{
+						FHRPGroupAssignment: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "ipam.fhrpgroupassignment",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.IpamFHRPGroupAssignment{
+							Group: &netbox.IpamFHRPGroup{
+								ID: 1,
+							},
+							Interface: &netbox.DcimInterface{
+								ID: 2,
+								Device: &netbox.DcimDevice{
+									ID: 2,
+								},
+							},
+							Priority: intPtr(110),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P4] ingest ipam.fhrpgroupassignment - existing assignment found - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroupassignment",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroupAssignment{
+						FhrpGroupAssignment: &diodepb.FHRPGroupAssignment{
+							Group: &diodepb.FHRPGroup{
+								Protocol: "vrrp2",
+								GroupId:  10,
+							},
+							Interface: &diodepb.Interface{
+								Name:   "GigabitEthernet0/0/0",
+								Device: &diodepb.Device{Name: "router02"},
+								Type:   "1000base-t",
+							},
+							Priority: int32Ptr(110),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "ipam.fhrpgroup",
+					objectID:       0,
+					queryParams:    map[string]string{"protocol": "vrrp2", "group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupDataWrapper{
+						FHRPGroup: &netbox.IpamFHRPGroup{
+							ID:       1,
+							Protocol: "vrrp2",
+							GroupID:  intPtr(10),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router02", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   2,
+							Name: "router02",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/0", "device__name": "router02", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   2,
+							Name: "GigabitEthernet0/0/0",
+							Device: &netbox.DcimDevice{
+								ID:   2,
+								Name: "router02",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("1000base-t"),
+						},
+					},
+				},
+				{
+					objectType:     "ipam.fhrpgroupassignment",
+					objectID:       0,
+					queryParams:    map[string]string{"group__protocol": "vrrp2", "group__group_id": "10", "interface__name": "GigabitEthernet0/0/0", "interface__device__name": "router02", "interface__device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupAssignmentDataWrapper{
+						FHRPGroupAssignment: &netbox.IpamFHRPGroupAssignment{
+							ID: 1,
+							Group: &netbox.IpamFHRPGroup{
+								ID:       1,
+								Protocol: "vrrp2",
+								GroupID:  intPtr(10),
+							},
+							Interface: &netbox.DcimInterface{
+								ID:   2,
+								Name: "GigabitEthernet0/0/0",
+								Device: &netbox.DcimDevice{
+									ID:   2,
+									Name: "router02",
+									Site: &netbox.DcimSite{
+										ID:   1,
+										Name: "undefined",
+									},
+								},
+							},
+							Priority: intPtr(110),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P4] ingest ipam.fhrpgroupassignment - FHRP group not found - create group and assign it to interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroupassignment",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroupAssignment{
+						FhrpGroupAssignment: &diodepb.FHRPGroupAssignment{
+							Group: &diodepb.FHRPGroup{
+								Protocol: "vrrp2",
+								GroupId:  10,
+							},
+							Interface: &diodepb.Interface{
+								Name:   "GigabitEthernet0/0/0",
+								Device: &diodepb.Device{Name: "router01"},
+								Type:   "1000base-t",
+							},
+							Priority: int32Ptr(110),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "ipam.fhrpgroup",
+					objectID:       0,
+					queryParams:    map[string]string{"protocol": "vrrp2", "group_id": "10"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupDataWrapper{
+						FHRPGroup: nil,
+					},
+				},
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "router01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "GigabitEthernet0/0/0", "device__name": "router01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "GigabitEthernet0/0/0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "router01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("1000base-t"),
+						},
+					},
+				},
+				{
+					objectType:     "ipam.fhrpgroupassignment",
+					objectID:       0,
+					queryParams:    map[string]string{"group__protocol": "vrrp2", "group__group_id": "10", "interface__name": "GigabitEthernet0/0/0", "interface__device__name": "router01", "interface__device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.IpamFHRPGroupAssignmentDataWrapper{
+						FHRPGroupAssignment: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "ipam.fhrpgroup",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.IpamFHRPGroup{
+							Protocol: "vrrp2",
+							GroupID:  intPtr(10),
+						},
+					},
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b6",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "ipam.fhrpgroupassignment",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.IpamFHRPGroupAssignment{
+							Group: &netbox.IpamFHRPGroup{
+								Protocol: "vrrp2",
+								GroupID:  intPtr(10),
+							},
+							Interface: &netbox.DcimInterface{
+								ID: 1,
+								Device: &netbox.DcimDevice{
+									ID: 1,
+								},
+							},
+							Priority: intPtr(110),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P4] ingest ipam.fhrpgroupassignment with invalid priority - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.fhrpgroupassignment",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_FhrpGroupAssignment{
+						FhrpGroupAssignment: &diodepb.FHRPGroupAssignment{
+							Group: &diodepb.FHRPGroup{
+								Protocol: "vrrp2",
+								GroupId:  10,
+							},
+							Interface: &diodepb.Interface{
+								Name:   "GigabitEthernet0/0/0",
+								Device: &diodepb.Device{Name: "router01"},
+							},
+							Priority: int32Ptr(300),
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
 
 			for _, m := range tt.retrieveObjectStates {
 				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
@@ -1628,6 +1628,7 @@ func TestVirtualizationPrepare(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
 
 			for _, m := range tt.retrieveObjectStates {
 				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
@@ -0,0 +1,1303 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestWirelessPrepare(t *testing.T) {
+	type mockRetrieveObjectState struct {
+		objectType     string
+		objectID       int
+		queryParams    map[string]string
+		objectChangeID int
+		object         netbox.ComparableData
+	}
+	tests := []struct {
+		name                 string
+		ingestEntity         changeset.IngestEntity
+		retrieveObjectStates []mockRetrieveObjectState
+		wantChangeSet        changeset.ChangeSet
+		wantErr              bool
+	}{
+		{
+			name: "[P1] ingest wireless.wirelesslangroup with name only - existing object not found - create",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslangroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLanGroup{
+						WirelessLanGroup: &diodepb.WirelessLANGroup{
+							Name: "Campus",
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "wireless.wirelesslangroup",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "Campus"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANGroupDataWrapper{
+						WirelessLANGroup: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "wireless.wirelesslangroup",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.WirelessLANGroup{
+							Name: "Campus",
+							Slug: "campus",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P1] ingest wireless.wirelesslangroup with name only - existing object found - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslangroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLanGroup{
+						WirelessLanGroup: &diodepb.WirelessLANGroup{
+							Name: "Campus",
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "wireless.wirelesslangroup",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "Campus"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANGroupDataWrapper{
+						WirelessLANGroup: &netbox.WirelessLANGroup{
+							ID:   1,
+							Name: "Campus",
+							Slug: "campus",
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P1] ingest empty wireless.wirelesslangroup - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslangroup",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLanGroup{
+						WirelessLanGroup: &diodepb.WirelessLANGroup{},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "[P2] ingest wireless.wirelesslan with ssid and group - existing objects not found - create group and wireless LAN",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslan",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLan{
+						WirelessLan: &diodepb.WirelessLAN{
+							Ssid:     "corp",
+							Group:    &diodepb.WirelessLANGroup{Name: "Campus"},
+							AuthType: "wpa-enterprise",
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "wireless.wirelesslangroup",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "Campus"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANGroupDataWrapper{
+						WirelessLANGroup: nil,
+					},
+				},
+				{
+					objectType:     "wireless.wirelesslan",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "corp", "group__name": "Campus"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANDataWrapper{
+						WirelessLAN: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "wireless.wirelesslangroup",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.WirelessLANGroup{
+							Name: "Campus",
+							Slug: "campus",
+						},
+					},
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "wireless.wirelesslan",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.WirelessLAN{
+							SSID: "corp",
+							Group: &netbox.WirelessLANGroup{
+								Name: "Campus",
+								Slug: "campus",
+							},
+							Status:   strPtr(netbox.DefaultWirelessLANStatus),
+							AuthType: strPtr("wpa-enterprise"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P2] ingest wireless.wirelesslan with ssid and group - existing objects found - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslan",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLan{
+						WirelessLan: &diodepb.WirelessLAN{
+							Ssid:  "corp",
+							Group: &diodepb.WirelessLANGroup{Name: "Campus"},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "wireless.wirelesslangroup",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "Campus"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANGroupDataWrapper{
+						WirelessLANGroup: &netbox.WirelessLANGroup{
+							ID:   1,
+							Name: "Campus",
+							Slug: "campus",
+						},
+					},
+				},
+				{
+					objectType:     "wireless.wirelesslan",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "corp", "group__name": "Campus"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANDataWrapper{
+						WirelessLAN: &netbox.WirelessLAN{
+							ID:   2,
+							SSID: "corp",
+							Group: &netbox.WirelessLANGroup{
+								ID:   1,
+								Name: "Campus",
+								Slug: "campus",
+							},
+							Status: strPtr(netbox.DefaultWirelessLANStatus),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P2] ingest wireless.wirelesslan with ssid only - existing object found - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslan",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLan{
+						WirelessLan: &diodepb.WirelessLAN{
+							Ssid: "corp",
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "wireless.wirelesslan",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "corp"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANDataWrapper{
+						WirelessLAN: &netbox.WirelessLAN{
+							ID:     1,
+							SSID:   "corp",
+							Status: strPtr(netbox.DefaultWirelessLANStatus),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P2] ingest wireless.wirelesslan with invalid auth type - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslan",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLan{
+						WirelessLan: &diodepb.WirelessLAN{
+							Ssid:     "corp",
+							AuthType: "wpa3",
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "[P2] ingest empty wireless.wirelesslan - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslan",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLan{
+						WirelessLan: &diodepb.WirelessLAN{},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "[P3] ingest dcim.interface with wireless LAN - existing interface found, wireless LAN not found - create wireless LAN and assign it to interface",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:   "wlan0",
+							Device: &diodepb.Device{Name: "ap01"},
+							Type:   "ieee802.11ax",
+							WirelessLans: []*diodepb.WirelessLAN{
+								{Ssid: "corp"},
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ap01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "ap01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "wlan0", "device__name": "ap01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "ap01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("ieee802.11ax"),
+						},
+					},
+				},
+				{
+					objectType:     "wireless.wirelesslan",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "corp"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANDataWrapper{
+						WirelessLAN: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "wireless.wirelesslan",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.WirelessLAN{
+							SSID:   "corp",
+							Status: strPtr(netbox.DefaultWirelessLANStatus),
+						},
+					},
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeUpdate,
+						ObjectType:    "dcim.interface",
+						ObjectID:      intPtr(1),
+						ObjectVersion: nil,
+						Data: &netbox.DcimInterface{
+							ID:   1,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID: 1,
+							},
+							Type: strPtr("ieee802.11ax"),
+							WirelessLANs: []*netbox.WirelessLAN{
+								{
+									SSID:   "corp",
+									Status: strPtr(netbox.DefaultWirelessLANStatus),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P3] ingest dcim.interface with wireless LAN - existing interface found with wireless LAN assigned - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:   "wlan0",
+							Device: &diodepb.Device{Name: "ap01"},
+							Type:   "ieee802.11ax",
+							WirelessLans: []*diodepb.WirelessLAN{
+								{Ssid: "corp"},
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ap01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "ap01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "wlan0", "device__name": "ap01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "ap01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("ieee802.11ax"),
+							WirelessLANs: []*netbox.WirelessLAN{
+								{
+									ID:   1,
+									SSID: "corp",
+								},
+							},
+						},
+					},
+				},
+				{
+					objectType:     "wireless.wirelesslan",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "corp"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLANDataWrapper{
+						WirelessLAN: &netbox.WirelessLAN{
+							ID:     1,
+							SSID:   "corp",
+							Status: strPtr(netbox.DefaultWirelessLANStatus),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P4] ingest wireless.wirelesslink between existing interfaces - existing link not found - create",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslink",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLink{
+						WirelessLink: &diodepb.WirelessLink{
+							InterfaceA: &diodepb.Interface{
+								Name:   "wlan0",
+								Device: &diodepb.Device{Name: "ap01"},
+								Type:   "ieee802.11ax",
+							},
+							InterfaceB: &diodepb.Interface{
+								Name:   "wlan0",
+								Device: &diodepb.Device{Name: "ap02"},
+								Type:   "ieee802.11ax",
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ap01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "ap01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ap02", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   2,
+							Name: "ap02",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "wlan0", "device__name": "ap01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "ap01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("ieee802.11ax"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "wlan0", "device__name": "ap02", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   2,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID:   2,
+								Name: "ap02",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("ieee802.11ax"),
+						},
+					},
+				},
+				{
+					objectType:     "wireless.wirelesslink",
+					objectID:       0,
+					queryParams:    map[string]string{"interface_a__name": "wlan0", "interface_a__device__name": "ap01", "interface_a__device__site__name": "undefined", "interface_b__name": "wlan0", "interface_b__device__name": "ap02", "interface_b__device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLinkDataWrapper{
+						WirelessLink: nil,
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet: []changeset.Change{
+					{
+						ChangeID:      "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+						ChangeType:    changeset.ChangeTypeCreate,
+						ObjectType:    "wireless.wirelesslink",
+						ObjectID:      nil,
+						ObjectVersion: nil,
+						Data: &netbox.WirelessLink{
+							InterfaceA: &netbox.DcimInterface{
+								ID: 1,
+								Device: &netbox.DcimDevice{
+									ID: 1,
+								},
+							},
+							InterfaceB: &netbox.DcimInterface{
+								ID: 2,
+								Device: &netbox.DcimDevice{
+									ID: 2,
+								},
+							},
+							Status: strPtr(netbox.DefaultWirelessLinkStatus),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P4] ingest wireless.wirelesslink between existing interfaces - existing link found - do nothing",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslink",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLink{
+						WirelessLink: &diodepb.WirelessLink{
+							InterfaceA: &diodepb.Interface{
+								Name:   "wlan0",
+								Device: &diodepb.Device{Name: "ap01"},
+								Type:   "ieee802.11ax",
+							},
+							InterfaceB: &diodepb.Interface{
+								Name:   "wlan0",
+								Device: &diodepb.Device{Name: "ap02"},
+								Type:   "ieee802.11ax",
+							},
+						},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:     "dcim.site",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:     1,
+							Name:   "undefined",
+							Slug:   "undefined",
+							Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.manufacturer",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimManufacturerDataWrapper{
+						Manufacturer: &netbox.DcimManufacturer{
+							ID:   1,
+							Name: "undefined",
+							Slug: "undefined",
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicetype",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined", "manufacturer__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceTypeDataWrapper{
+						DeviceType: &netbox.DcimDeviceType{
+							ID:    1,
+							Model: "undefined",
+							Slug:  "undefined",
+							Manufacturer: &netbox.DcimManufacturer{
+								ID:   1,
+								Name: "undefined",
+								Slug: "undefined",
+							},
+						},
+					},
+				},
+				{
+					objectType:     "dcim.devicerole",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceRoleDataWrapper{
+						DeviceRole: &netbox.DcimDeviceRole{
+							ID:    1,
+							Name:  "undefined",
+							Slug:  "undefined",
+							Color: strPtr("000000"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ap01", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "ap01",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.device",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "ap02", "site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   2,
+							Name: "ap02",
+							Site: &netbox.DcimSite{
+								ID:     1,
+								Name:   "undefined",
+								Slug:   "undefined",
+								Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							},
+							DeviceType: &netbox.DcimDeviceType{
+								ID:    1,
+								Model: "undefined",
+								Slug:  "undefined",
+								Manufacturer: &netbox.DcimManufacturer{
+									ID:   1,
+									Name: "undefined",
+									Slug: "undefined",
+								},
+							},
+							Role: &netbox.DcimDeviceRole{
+								ID:    1,
+								Name:  "undefined",
+								Slug:  "undefined",
+								Color: strPtr("000000"),
+							},
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "wlan0", "device__name": "ap01", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   1,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID:   1,
+								Name: "ap01",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("ieee802.11ax"),
+						},
+					},
+				},
+				{
+					objectType:     "dcim.interface",
+					objectID:       0,
+					queryParams:    map[string]string{"q": "wlan0", "device__name": "ap02", "device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.DcimInterfaceDataWrapper{
+						Interface: &netbox.DcimInterface{
+							ID:   2,
+							Name: "wlan0",
+							Device: &netbox.DcimDevice{
+								ID:   2,
+								Name: "ap02",
+								Site: &netbox.DcimSite{
+									ID:     1,
+									Name:   "undefined",
+									Slug:   "undefined",
+									Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+								},
+								DeviceType: &netbox.DcimDeviceType{
+									ID:    1,
+									Model: "undefined",
+									Slug:  "undefined",
+									Manufacturer: &netbox.DcimManufacturer{
+										ID:   1,
+										Name: "undefined",
+										Slug: "undefined",
+									},
+								},
+								Role: &netbox.DcimDeviceRole{
+									ID:    1,
+									Name:  "undefined",
+									Slug:  "undefined",
+									Color: strPtr("000000"),
+								},
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+							Type: strPtr("ieee802.11ax"),
+						},
+					},
+				},
+				{
+					objectType:     "wireless.wirelesslink",
+					objectID:       0,
+					queryParams:    map[string]string{"interface_a__name": "wlan0", "interface_a__device__name": "ap01", "interface_a__device__site__name": "undefined", "interface_b__name": "wlan0", "interface_b__device__name": "ap02", "interface_b__device__site__name": "undefined"},
+					objectChangeID: 0,
+					object: &netbox.WirelessLinkDataWrapper{
+						WirelessLink: &netbox.WirelessLink{
+							ID: 1,
+							InterfaceA: &netbox.DcimInterface{
+								ID:   1,
+								Name: "wlan0",
+								Device: &netbox.DcimDevice{
+									ID:   1,
+									Name: "ap01",
+									Site: &netbox.DcimSite{
+										ID:   1,
+										Name: "undefined",
+									},
+								},
+							},
+							InterfaceB: &netbox.DcimInterface{
+								ID:   2,
+								Name: "wlan0",
+								Device: &netbox.DcimDevice{
+									ID:   2,
+									Name: "ap02",
+									Site: &netbox.DcimSite{
+										ID:   1,
+										Name: "undefined",
+									},
+								},
+							},
+							Status: strPtr(netbox.DefaultWirelessLinkStatus),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[P4] ingest wireless.wirelesslink without interfaces - error",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "wireless.wirelesslink",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_WirelessLink{
+						WirelessLink: &diodepb.WirelessLink{},
+					},
+				},
+			},
+			retrieveObjectStates: []mockRetrieveObjectState{},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeSet:   []changeset.Change{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			for _, m := range tt.retrieveObjectStates {
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+					ObjectType: m.objectType,
+					ObjectID:   m.objectID,
+					Params:     m.queryParams,
+				}).Return(&netboxdiodeplugin.ObjectState{
+					ObjectID:       m.objectID,
+					ObjectType:     m.objectType,
+					ObjectChangeID: m.objectChangeID,
+					Object:         m.object,
+				}, nil)
+			}
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet.ChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet.ChangeSet {
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].Data, cs.ChangeSet[i].Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package changeset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+)
+
+// deletionOrder ranks object types so dependent objects are deleted before the objects they depend on,
+// object types not listed are deleted last
+var deletionOrder = map[string]int{
+	netbox.IpamFHRPGroupAssignmentObjectType:      0,
+	netbox.IpamIPAddressObjectType:                1,
+	netbox.WirelessLinkObjectType:                 1,
+	netbox.DcimInterfaceObjectType:                2,
+	netbox.VirtualizationVMInterfaceObjectType:    2,
+	netbox.VirtualizationVirtualDiskObjectType:    2,
+	netbox.DcimDeviceObjectType:                   3,
+	netbox.VirtualizationVirtualMachineObjectType: 3,
+	netbox.IpamFHRPGroupObjectType:                3,
+	netbox.IpamPrefixObjectType:                   3,
+	netbox.WirelessLANObjectType:                  3,
+	netbox.VirtualizationClusterObjectType:        4,
+	netbox.DcimDeviceTypeObjectType:               4,
+	netbox.DcimPlatformObjectType:                 5,
+	netbox.VirtualizationClusterGroupObjectType:   5,
+	netbox.VirtualizationClusterTypeObjectType:    5,
+	netbox.WirelessLANGroupObjectType:             5,
+}
+
+// DeletionOrder returns the rank of the object type in the order objects are deleted
+func DeletionOrder(objectType string) int {
+	if order, ok := deletionOrder[objectType]; ok {
+		return order
+	}
+	return len(deletionOrder)
+}
+
+// PrepareDelete prepares a change set deleting the object identified by the object type and query parameters
+func PrepareDelete(objectType string, queryParams map[string]string, netboxAPI netboxdiodeplugin.NetBoxAPI) (*ChangeSet, error) {
+	changes := make([]Change, 0)
+
+	current, objectVersion, err := retrieveObjectStateByQueryParams(netboxAPI, objectType, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// object has already been removed from NetBox
+	if current == nil {
+		return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+	}
+
+	before, err := json.Marshal(current.Data())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state of %s %d: %w", objectType, current.ID(), err)
+	}
+
+	objectID := current.ID()
+	changes = append(changes, Change{
+		ChangeID:      uuid.NewString(),
+		ChangeType:    ChangeTypeDelete,
+		ObjectType:    objectType,
+		ObjectID:      &objectID,
+		ObjectVersion: objectVersion,
+		Data:          nil,
+		Before:        before,
+	})
+
+	return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+}
@@ -0,0 +1,105 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareDelete(t *testing.T) {
+	vmParams := map[string]string{"q": "vm01", "site__name": "undefined"}
+	tests := []struct {
+		name          string
+		objectType    string
+		queryParams   map[string]string
+		objectID      int
+		object        netbox.ComparableData
+		wantChangeSet changeset.ChangeSet
+		wantErr       bool
+	}{
+		{
+			name:        "virtual machine found - delete",
+			objectType:  "virtualization.virtualmachine",
+			queryParams: vmParams,
+			objectID:    7,
+			object: &netbox.VirtualizationVirtualMachineDataWrapper{
+				VirtualMachine: &netbox.VirtualizationVirtualMachine{
+					ID:   7,
+					Name: "vm01",
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSet: []changeset.Change{
+					{
+						ChangeType: changeset.ChangeTypeDelete,
+						ObjectType: "virtualization.virtualmachine",
+						ObjectID:   intPtr(7),
+						Data:       nil,
+					},
+				},
+			},
+		},
+		{
+			name:        "virtual machine not found - do nothing",
+			objectType:  "virtualization.virtualmachine",
+			queryParams: vmParams,
+			object: &netbox.VirtualizationVirtualMachineDataWrapper{
+				VirtualMachine: nil,
+			},
+			wantChangeSet: changeset.ChangeSet{ChangeSet: []changeset.Change{}},
+		},
+		{
+			name:        "unsupported object type - error",
+			objectType:  "dcim.unknown",
+			queryParams: map[string]string{"q": "unknown"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+
+			if tt.object != nil {
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+					ObjectType: tt.objectType,
+					ObjectID:   0,
+					Params:     tt.queryParams,
+				}).Return(&netboxdiodeplugin.ObjectState{
+					ObjectID:   tt.objectID,
+					ObjectType: tt.objectType,
+					Object:     tt.object,
+				}, nil)
+			}
+
+			cs, err := changeset.PrepareDelete(tt.objectType, tt.queryParams, mockClient)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet.ChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet.ChangeSet {
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ObjectID, cs.ChangeSet[i].ObjectID)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].Data, cs.ChangeSet[i].Data)
+			}
+		})
+	}
+}
+
+func TestDeletionOrder(t *testing.T) {
+	assert.Less(t, changeset.DeletionOrder("ipam.ipaddress"), changeset.DeletionOrder("dcim.interface"))
+	assert.Less(t, changeset.DeletionOrder("virtualization.vminterface"), changeset.DeletionOrder("virtualization.virtualmachine"))
+	assert.Less(t, changeset.DeletionOrder("dcim.device"), changeset.DeletionOrder("dcim.site"))
+}
@@ -0,0 +1,174 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithDeviceTypeLibrary(t *testing.T) {
+	lib := netbox.NewDeviceTypeLibrary([]netbox.DeviceTypeLibraryEntry{
+		{Manufacturer: "Cisco", Model: "Catalyst 3850-48P", Slug: "cisco-ws-c3850-48p", PartNumber: "WS-C3850-48P"},
+		{Manufacturer: "Juniper", Model: "MX204", PartNumber: "MX204"},
+		{Manufacturer: "Acme", Model: "MX204"},
+		{Manufacturer: "HPE", ManufacturerSlug: "hpe", Model: "Aruba 3810M 16SFP+ 2-slot", PartNumber: "JL075A"},
+	})
+
+	deviceTypeEntity := func(deviceType *diodepb.DeviceType) changeset.IngestEntity {
+		return changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "dcim.devicetype",
+			Entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_DeviceType{
+					DeviceType: deviceType,
+				},
+			},
+		}
+	}
+
+	catalyst := &netbox.DcimDeviceType{
+		Model:        "Catalyst 3850-48P",
+		Slug:         "cisco-ws-c3850-48p",
+		PartNumber:   strPtr("WS-C3850-48P"),
+		Manufacturer: &netbox.DcimManufacturer{Name: "Cisco", Slug: "cisco"},
+	}
+
+	tests := []struct {
+		name           string
+		ingestEntity   changeset.IngestEntity
+		wantDeviceType *netbox.DcimDeviceType
+	}{
+		{
+			name:           "matched by part number - manufacturer set",
+			ingestEntity:   deviceTypeEntity(&diodepb.DeviceType{Model: "WS-C3850-48P"}),
+			wantDeviceType: catalyst,
+		},
+		{
+			name:           "matched by part number without prefix",
+			ingestEntity:   deviceTypeEntity(&diodepb.DeviceType{Model: "C3850-48P", Manufacturer: &diodepb.Manufacturer{Name: "cisco"}}),
+			wantDeviceType: catalyst,
+		},
+		{
+			name:           "matched by model written differently",
+			ingestEntity:   deviceTypeEntity(&diodepb.DeviceType{Model: "catalyst 3850 48P"}),
+			wantDeviceType: catalyst,
+		},
+		{
+			name:           "matched by model prefixed with manufacturer",
+			ingestEntity:   deviceTypeEntity(&diodepb.DeviceType{Model: "Cisco WS-C3850-48P", Manufacturer: &diodepb.Manufacturer{Name: "Cisco"}}),
+			wantDeviceType: catalyst,
+		},
+		{
+			name:         "ambiguous model - kept as reported",
+			ingestEntity: deviceTypeEntity(&diodepb.DeviceType{Model: "MX204"}),
+			wantDeviceType: &netbox.DcimDeviceType{
+				Model:        "MX204",
+				Slug:         "mx204",
+				Manufacturer: &netbox.DcimManufacturer{Name: "undefined", Slug: "undefined"},
+			},
+		},
+		{
+			name:         "ambiguous model - manufacturer disambiguates",
+			ingestEntity: deviceTypeEntity(&diodepb.DeviceType{Model: "MX204", Manufacturer: &diodepb.Manufacturer{Name: "Juniper"}}),
+			wantDeviceType: &netbox.DcimDeviceType{
+				Model:        "MX204",
+				Slug:         "juniper-mx204",
+				PartNumber:   strPtr("MX204"),
+				Manufacturer: &netbox.DcimManufacturer{Name: "Juniper", Slug: "juniper"},
+			},
+		},
+		{
+			name:         "canonical manufacturer slug",
+			ingestEntity: deviceTypeEntity(&diodepb.DeviceType{Model: "JL075A"}),
+			wantDeviceType: &netbox.DcimDeviceType{
+				Model:        "Aruba 3810M 16SFP+ 2-slot",
+				Slug:         "hpe-aruba-3810m-16sfp-2-slot",
+				PartNumber:   strPtr("JL075A"),
+				Manufacturer: &netbox.DcimManufacturer{Name: "HPE", Slug: "hpe"},
+			},
+		},
+		{
+			name:         "other manufacturer - kept as reported",
+			ingestEntity: deviceTypeEntity(&diodepb.DeviceType{Model: "WS-C3850-48P", Manufacturer: &diodepb.Manufacturer{Name: "Arista"}}),
+			wantDeviceType: &netbox.DcimDeviceType{
+				Model:        "WS-C3850-48P",
+				Slug:         "ws-c3850-48p",
+				Manufacturer: &netbox.DcimManufacturer{Name: "Arista", Slug: "arista"},
+			},
+		},
+		{
+			name:         "unknown model - kept as reported",
+			ingestEntity: deviceTypeEntity(&diodepb.DeviceType{Model: "ISR4321"}),
+			wantDeviceType: &netbox.DcimDeviceType{
+				Model:        "ISR4321",
+				Slug:         "isr4321",
+				Manufacturer: &netbox.DcimManufacturer{Name: "undefined", Slug: "undefined"},
+			},
+		},
+		{
+			name: "device type of a device",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{
+							Name:       "switch01",
+							DeviceType: &diodepb.DeviceType{Model: "WS-C3850-48P"},
+						},
+					},
+				},
+			},
+			wantDeviceType: catalyst,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			queryParams := make(map[string]map[string]string)
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				queryParams[params.ObjectType] = params.Params
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			})
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithDeviceTypeLibrary(lib))
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]string{
+				"q":                  tt.wantDeviceType.Model,
+				"manufacturer__name": tt.wantDeviceType.Manufacturer.Name,
+			}, queryParams[netbox.DcimDeviceTypeObjectType])
+			assert.Equal(t, map[string]string{"q": tt.wantDeviceType.Manufacturer.Name}, queryParams[netbox.DcimManufacturerObjectType])
+
+			changes := make(map[string]changeset.Change)
+			for _, change := range cs.ChangeSet {
+				changes[change.ObjectType] = change
+			}
+
+			require.Contains(t, changes, netbox.DcimManufacturerObjectType)
+			assert.Equal(t, tt.wantDeviceType.Manufacturer, changes[netbox.DcimManufacturerObjectType].Data)
+
+			require.Contains(t, changes, netbox.DcimDeviceTypeObjectType)
+			deviceType, ok := changes[netbox.DcimDeviceTypeObjectType].Data.(*netbox.DcimDeviceType)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantDeviceType.Model, deviceType.Model)
+			assert.Equal(t, tt.wantDeviceType.Slug, deviceType.Slug)
+			assert.Equal(t, tt.wantDeviceType.PartNumber, deviceType.PartNumber)
+		})
+	}
+}
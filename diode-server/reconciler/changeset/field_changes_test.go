@@ -0,0 +1,124 @@
+package changeset_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareFieldDiff(t *testing.T) {
+	undefinedManufacturer := &netbox.DcimManufacturer{ID: 1, Name: "undefined", Slug: "undefined"}
+	undefinedDeviceType := &netbox.DcimDeviceType{ID: 1, Model: "undefined", Slug: "undefined", Manufacturer: undefinedManufacturer}
+	undefinedRole := &netbox.DcimDeviceRole{ID: 1, Name: "undefined", Slug: "undefined", Color: strPtr("000000")}
+	siteA := &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive)))}
+	siteB := &netbox.DcimSite{ID: 2, Name: "Site B", Slug: "site-b", Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive)))}
+
+	tests := []struct {
+		name         string
+		ingestEntity changeset.IngestEntity
+		existing     map[string]netbox.ComparableData
+		wantType     string
+		wantDiff     []netbox.FieldChange
+	}{
+		{
+			name: "existing device - changed fields and nested object",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{
+							Name:   "router01",
+							Site:   &diodepb.Site{Name: "Site B"},
+							Serial: strPtr("SN-2"),
+							Tags:   []*diodepb.Tag{{Name: "managed"}},
+						},
+					},
+				},
+			},
+			existing: map[string]netbox.ComparableData{
+				netbox.DcimSiteObjectType:         &netbox.DcimSiteDataWrapper{Site: siteB},
+				netbox.DcimManufacturerObjectType: &netbox.DcimManufacturerDataWrapper{Manufacturer: undefinedManufacturer},
+				netbox.DcimDeviceTypeObjectType:   &netbox.DcimDeviceTypeDataWrapper{DeviceType: undefinedDeviceType},
+				netbox.DcimDeviceRoleObjectType:   &netbox.DcimDeviceRoleDataWrapper{DeviceRole: undefinedRole},
+				netbox.DcimDeviceObjectType: &netbox.DcimDeviceDataWrapper{
+					Device: &netbox.DcimDevice{
+						ID:          1,
+						Name:        "router01",
+						Site:        siteA,
+						DeviceType:  undefinedDeviceType,
+						Role:        undefinedRole,
+						Serial:      strPtr("SN-1"),
+						Description: strPtr("core router"),
+						Status:      (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+					},
+				},
+			},
+			wantType: changeset.ChangeTypeUpdate,
+			wantDiff: []netbox.FieldChange{
+				{Field: "site", Before: json.RawMessage(`{"id":1}`), After: json.RawMessage(`{"id":2}`)},
+				{Field: "serial", Before: json.RawMessage(`"SN-1"`), After: json.RawMessage(`"SN-2"`)},
+				{Field: "tags", After: json.RawMessage(`["managed"]`)},
+			},
+		},
+		{
+			name: "new site - all fields set",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.site",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Site{
+						Site: &diodepb.Site{Name: "Site A", Facility: strPtr("DC1")},
+					},
+				},
+			},
+			wantType: changeset.ChangeTypeCreate,
+			wantDiff: []netbox.FieldChange{
+				{Field: "name", After: json.RawMessage(`"Site A"`)},
+				{Field: "slug", After: json.RawMessage(`"site-a"`)},
+				{Field: "status", After: json.RawMessage(`"active"`)},
+				{Field: "facility", After: json.RawMessage(`"DC1"`)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				if obj, ok := tt.existing[params.ObjectType]; ok {
+					return &netboxdiodeplugin.ObjectState{ObjectID: obj.ID(), ObjectType: params.ObjectType, Object: obj}, nil
+				}
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			}).Maybe()
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient)
+			require.NoError(t, err)
+
+			var change *changeset.Change
+			for i := range cs.ChangeSet {
+				if cs.ChangeSet[i].ObjectType == tt.ingestEntity.DataType {
+					change = &cs.ChangeSet[i]
+				}
+			}
+			require.NotNil(t, change)
+			assert.Equal(t, tt.wantType, change.ChangeType)
+			assert.Equal(t, tt.wantDiff, change.Diff)
+		})
+	}
+}
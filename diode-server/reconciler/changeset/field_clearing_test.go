@@ -0,0 +1,312 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithFieldsToClear(t *testing.T) {
+	siteEntity := func(clearFields []string, removeTags []string) changeset.IngestEntity {
+		return changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "dcim.site",
+			Entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Site{
+					Site: &diodepb.Site{Name: "Site A"},
+				},
+				ClearFields: clearFields,
+				RemoveTags:  removeTags,
+			},
+		}
+	}
+
+	existingSite := &netbox.DcimSite{
+		ID:          1,
+		Name:        "Site A",
+		Slug:        "site-a",
+		Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+		Facility:    strPtr("DC1"),
+		Description: strPtr("curated description"),
+		Tags: []*netbox.Tag{
+			{ID: 1, Name: "managed", Slug: "managed"},
+			{ID: 2, Name: "lab", Slug: "lab"},
+		},
+	}
+
+	undefinedSite := &netbox.DcimSite{
+		ID:     1,
+		Name:   "undefined",
+		Slug:   "undefined",
+		Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+	}
+	undefinedManufacturer := &netbox.DcimManufacturer{ID: 1, Name: "undefined", Slug: "undefined"}
+	undefinedDeviceType := &netbox.DcimDeviceType{ID: 1, Model: "undefined", Slug: "undefined", Manufacturer: undefinedManufacturer}
+	undefinedRole := &netbox.DcimDeviceRole{ID: 1, Name: "undefined", Slug: "undefined", Color: strPtr("000000")}
+
+	tests := []struct {
+		name          string
+		ingestEntity  changeset.IngestEntity
+		policies      map[string]netbox.FieldOwnershipPolicy
+		existing      map[string]netbox.ComparableData
+		wantChangeSet []changeset.Change
+		wantErr       bool
+	}{
+		{
+			name:         "existing site - fields cleared and tag removed",
+			ingestEntity: siteEntity([]string{"facility", "description"}, []string{"lab"}),
+			existing: map[string]netbox.ComparableData{
+				netbox.DcimSiteObjectType: &netbox.DcimSiteDataWrapper{Site: existingSite},
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeUpdate,
+					ObjectType: "dcim.site",
+					ObjectID:   intPtr(1),
+					Data: &netbox.DcimSite{
+						ID:          1,
+						Name:        "Site A",
+						Slug:        "site-a",
+						Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						Facility:    strPtr(""),
+						Description: strPtr(""),
+						Tags: []*netbox.Tag{
+							{ID: 1, Name: "managed", Slug: "managed"},
+						},
+					},
+					ClearedFields: []string{"facility", "description"},
+				},
+			},
+		},
+		{
+			name:         "existing site - tags cleared",
+			ingestEntity: siteEntity([]string{"tags"}, nil),
+			existing: map[string]netbox.ComparableData{
+				netbox.DcimSiteObjectType: &netbox.DcimSiteDataWrapper{Site: existingSite},
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeUpdate,
+					ObjectType: "dcim.site",
+					ObjectID:   intPtr(1),
+					Data: &netbox.DcimSite{
+						ID:          1,
+						Name:        "Site A",
+						Slug:        "site-a",
+						Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						Facility:    strPtr("DC1"),
+						Description: strPtr("curated description"),
+						Tags:        []*netbox.Tag{},
+					},
+					ClearedFields: []string{"tags"},
+				},
+			},
+		},
+		{
+			name:         "existing site - fields and tags already empty - do nothing",
+			ingestEntity: siteEntity([]string{"comments", "time_zone"}, []string{"production"}),
+			existing: map[string]netbox.ComparableData{
+				netbox.DcimSiteObjectType: &netbox.DcimSiteDataWrapper{Site: existingSite},
+			},
+			wantChangeSet: []changeset.Change{},
+		},
+		{
+			name:         "existing site - never touch field kept",
+			ingestEntity: siteEntity([]string{"description"}, nil),
+			policies: map[string]netbox.FieldOwnershipPolicy{
+				"dcim.site": {"description": netbox.FieldOwnershipNeverTouch},
+			},
+			existing: map[string]netbox.ComparableData{
+				netbox.DcimSiteObjectType: &netbox.DcimSiteDataWrapper{Site: existingSite},
+			},
+			wantChangeSet: []changeset.Change{},
+		},
+		{
+			name:         "new site - nothing to clear",
+			ingestEntity: siteEntity([]string{"description"}, []string{"lab"}),
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeCreate,
+					ObjectType: "dcim.site",
+					Data: &netbox.DcimSite{
+						Name:   "Site A",
+						Slug:   "site-a",
+						Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+					},
+				},
+			},
+		},
+		{
+			name: "existing device - asset tag and platform set to null",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{Name: "router01"},
+					},
+					ClearFields: []string{"asset_tag", "platform"},
+				},
+			},
+			existing: map[string]netbox.ComparableData{
+				netbox.DcimSiteObjectType:         &netbox.DcimSiteDataWrapper{Site: undefinedSite},
+				netbox.DcimManufacturerObjectType: &netbox.DcimManufacturerDataWrapper{Manufacturer: undefinedManufacturer},
+				netbox.DcimDeviceTypeObjectType:   &netbox.DcimDeviceTypeDataWrapper{DeviceType: undefinedDeviceType},
+				netbox.DcimDeviceRoleObjectType:   &netbox.DcimDeviceRoleDataWrapper{DeviceRole: undefinedRole},
+				netbox.DcimDeviceObjectType: &netbox.DcimDeviceDataWrapper{
+					Device: &netbox.DcimDevice{
+						ID:         1,
+						Name:       "router01",
+						Site:       undefinedSite,
+						DeviceType: undefinedDeviceType,
+						Role:       undefinedRole,
+						Platform:   &netbox.DcimPlatform{ID: 1, Name: "ios", Slug: "ios"},
+						AssetTag:   strPtr("A-1234"),
+						Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+					},
+				},
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeUpdate,
+					ObjectType: "dcim.device",
+					ObjectID:   intPtr(1),
+					Data: &netbox.DcimDevice{
+						ID:         1,
+						Name:       "router01",
+						Site:       &netbox.DcimSite{ID: 1},
+						DeviceType: &netbox.DcimDeviceType{ID: 1},
+						Role:       &netbox.DcimDeviceRole{ID: 1},
+						Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+					},
+					ClearedFields: []string{"platform", "asset_tag"},
+				},
+			},
+		},
+		{
+			name:         "unknown field",
+			ingestEntity: siteEntity([]string{"region"}, nil),
+			wantErr:      true,
+		},
+		{
+			name:         "required field",
+			ingestEntity: siteEntity([]string{"name"}, nil),
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				if obj, ok := tt.existing[params.ObjectType]; ok {
+					return &netboxdiodeplugin.ObjectState{ObjectID: obj.ID(), ObjectType: params.ObjectType, Object: obj}, nil
+				}
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			}).Maybe()
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithFieldOwnership(tt.policies))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet {
+				assert.Equal(t, tt.wantChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet[i].ObjectID, cs.ChangeSet[i].ObjectID)
+				assert.Equal(t, tt.wantChangeSet[i].Data, cs.ChangeSet[i].Data)
+				assert.Equal(t, tt.wantChangeSet[i].ClearedFields, cs.ChangeSet[i].ClearedFields)
+			}
+		})
+	}
+}
+
+func TestValidateFieldsToClear(t *testing.T) {
+	tests := []struct {
+		name       string
+		objectType string
+		fields     []string
+		wantErr    bool
+	}{
+		{
+			name:       "optional fields and tags",
+			objectType: netbox.DcimInterfaceObjectType,
+			fields:     []string{"label", "mtu", "mac_address", "description", "tags"},
+		},
+		{
+			name:       "optional nested object",
+			objectType: netbox.VirtualizationVirtualMachineObjectType,
+			fields:     []string{"cluster", "platform"},
+		},
+		{
+			name:       "required interface type",
+			objectType: netbox.DcimInterfaceObjectType,
+			fields:     []string{"type"},
+			wantErr:    true,
+		},
+		{
+			name:       "required interface device",
+			objectType: netbox.DcimInterfaceObjectType,
+			fields:     []string{"device"},
+			wantErr:    true,
+		},
+		{
+			name:       "interface flag defaulted by NetBox",
+			objectType: netbox.DcimInterfaceObjectType,
+			fields:     []string{"enabled"},
+			wantErr:    true,
+		},
+		{
+			name:       "required device status",
+			objectType: netbox.DcimDeviceObjectType,
+			fields:     []string{"status"},
+			wantErr:    true,
+		},
+		{
+			name:       "required device site",
+			objectType: netbox.DcimDeviceObjectType,
+			fields:     []string{"site"},
+			wantErr:    true,
+		},
+		{
+			name:       "required FHRP group assignment priority",
+			objectType: netbox.IpamFHRPGroupAssignmentObjectType,
+			fields:     []string{"priority"},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown field",
+			objectType: netbox.DcimSiteObjectType,
+			fields:     []string{"region"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := netbox.ValidateFieldsToClear(tt.objectType, tt.fields)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithFieldOwnership(t *testing.T) {
+	siteEntity := changeset.IngestEntity{
+		RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+		DataType:  "dcim.site",
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Site{
+				Site: &diodepb.Site{
+					Name:        "Site A",
+					Facility:    strPtr("DC1"),
+					Description: strPtr("ingested description"),
+					Comments:    strPtr("ingested comments"),
+				},
+			},
+		},
+	}
+
+	policies := map[string]netbox.FieldOwnershipPolicy{
+		"dcim.site": {
+			"facility":    netbox.FieldOwnershipFillIfEmpty,
+			"description": netbox.FieldOwnershipNeverTouch,
+			"comments":    netbox.FieldOwnershipFillIfEmpty,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		ingestEntity  changeset.IngestEntity
+		policies      map[string]netbox.FieldOwnershipPolicy
+		existingSite  *netbox.DcimSite
+		wantChangeSet []changeset.Change
+	}{
+		{
+			name:         "existing site - never touch and non-empty fill if empty fields kept",
+			ingestEntity: siteEntity,
+			policies:     policies,
+			existingSite: &netbox.DcimSite{
+				ID:          1,
+				Name:        "Site A",
+				Slug:        "site-a",
+				Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+				Description: strPtr("curated description"),
+				Comments:    strPtr("curated comments"),
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeUpdate,
+					ObjectType: "dcim.site",
+					ObjectID:   intPtr(1),
+					Data: &netbox.DcimSite{
+						ID:          1,
+						Name:        "Site A",
+						Slug:        "site-a",
+						Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						Facility:    strPtr("DC1"),
+						Description: strPtr("curated description"),
+						Comments:    strPtr("curated comments"),
+					},
+					SkippedFields: []string{"description", "comments"},
+				},
+			},
+		},
+		{
+			name:         "existing site - only owned fields differ - do nothing",
+			ingestEntity: siteEntity,
+			policies:     policies,
+			existingSite: &netbox.DcimSite{
+				ID:          1,
+				Name:        "Site A",
+				Slug:        "site-a",
+				Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+				Facility:    strPtr("DC2"),
+				Description: strPtr("curated description"),
+				Comments:    strPtr("curated comments"),
+			},
+			wantChangeSet: []changeset.Change{},
+		},
+		{
+			name:         "existing site - no policy - ingested values win",
+			ingestEntity: siteEntity,
+			existingSite: &netbox.DcimSite{
+				ID:          1,
+				Name:        "Site A",
+				Slug:        "site-a",
+				Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+				Description: strPtr("curated description"),
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeUpdate,
+					ObjectType: "dcim.site",
+					ObjectID:   intPtr(1),
+					Data: &netbox.DcimSite{
+						ID:          1,
+						Name:        "Site A",
+						Slug:        "site-a",
+						Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						Facility:    strPtr("DC1"),
+						Description: strPtr("ingested description"),
+						Comments:    strPtr("ingested comments"),
+					},
+				},
+			},
+		},
+		{
+			name:         "new site - never touch fields not set",
+			ingestEntity: siteEntity,
+			policies:     policies,
+			existingSite: nil,
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeCreate,
+					ObjectType: "dcim.site",
+					Data: &netbox.DcimSite{
+						Name:     "Site A",
+						Slug:     "site-a",
+						Status:   (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+						Facility: strPtr("DC1"),
+						Comments: strPtr("ingested comments"),
+					},
+					SkippedFields: []string{"description"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+				ObjectType: "dcim.site",
+				Params:     map[string]string{"q": "Site A"},
+			}).Return(&netboxdiodeplugin.ObjectState{
+				ObjectType: "dcim.site",
+				Object:     &netbox.DcimSiteDataWrapper{Site: tt.existingSite},
+			}, nil)
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithFieldOwnership(tt.policies))
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet {
+				assert.Equal(t, tt.wantChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet[i].ObjectID, cs.ChangeSet[i].ObjectID)
+				assert.Equal(t, tt.wantChangeSet[i].Data, cs.ChangeSet[i].Data)
+				assert.Equal(t, tt.wantChangeSet[i].SkippedFields, cs.ChangeSet[i].SkippedFields)
+			}
+		})
+	}
+}
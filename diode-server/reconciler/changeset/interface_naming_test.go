@@ -0,0 +1,325 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithInterfaceNaming(t *testing.T) {
+	naming := &netbox.InterfaceNaming{
+		Platforms: map[string]netbox.InterfaceNamingConvention{
+			"nxos": netbox.InterfaceNamingCiscoNXOS,
+			"eos":  netbox.InterfaceNamingAristaEOS,
+		},
+		Manufacturers: map[string]netbox.InterfaceNamingConvention{
+			"Cisco":   netbox.InterfaceNamingCiscoIOS,
+			"Juniper": netbox.InterfaceNamingJunos,
+		},
+	}
+
+	interfaceEntity := func(name string, label *string, device *diodepb.Device) changeset.IngestEntity {
+		return changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "dcim.interface",
+			Entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Interface{
+					Interface: &diodepb.Interface{
+						Name:   name,
+						Label:  label,
+						Device: device,
+					},
+				},
+			},
+		}
+	}
+
+	ciscoDevice := &diodepb.Device{
+		Name:       "router01",
+		DeviceType: &diodepb.DeviceType{Model: "ISR4321", Manufacturer: &diodepb.Manufacturer{Name: "cisco"}},
+	}
+
+	tests := []struct {
+		name         string
+		ingestEntity changeset.IngestEntity
+		naming       *netbox.InterfaceNaming
+		wantName     string
+		wantLabel    *string
+	}{
+		{
+			name:         "cisco ios abbreviation expanded - ingested name preserved as label",
+			ingestEntity: interfaceEntity("Gi0/1", nil, ciscoDevice),
+			naming:       naming,
+			wantName:     "GigabitEthernet0/1",
+			wantLabel:    strPtr("Gi0/1"),
+		},
+		{
+			name:         "cisco ios lower case name with space",
+			ingestEntity: interfaceEntity("gi 0/1", nil, ciscoDevice),
+			naming:       naming,
+			wantName:     "GigabitEthernet0/1",
+			wantLabel:    strPtr("gi 0/1"),
+		},
+		{
+			name:         "canonical name - no label",
+			ingestEntity: interfaceEntity("GigabitEthernet0/1", nil, ciscoDevice),
+			naming:       naming,
+			wantName:     "GigabitEthernet0/1",
+		},
+		{
+			name:         "ingested label kept",
+			ingestEntity: interfaceEntity("Po10", strPtr("uplink"), ciscoDevice),
+			naming:       naming,
+			wantName:     "Port-channel10",
+			wantLabel:    strPtr("uplink"),
+		},
+		{
+			name: "platform takes precedence over manufacturer",
+			ingestEntity: interfaceEntity("eth1/1", nil, &diodepb.Device{
+				Name:       "switch01",
+				DeviceType: &diodepb.DeviceType{Model: "N9K-C93180YC-EX", Manufacturer: &diodepb.Manufacturer{Name: "Cisco"}},
+				Platform:   &diodepb.Platform{Name: "NXOS"},
+			}),
+			naming:    naming,
+			wantName:  "Ethernet1/1",
+			wantLabel: strPtr("eth1/1"),
+		},
+		{
+			name: "arista eos",
+			ingestEntity: interfaceEntity("Et49/1", nil, &diodepb.Device{
+				Name:     "leaf01",
+				Platform: &diodepb.Platform{Name: "eos"},
+			}),
+			naming:    naming,
+			wantName:  "Ethernet49/1",
+			wantLabel: strPtr("Et49/1"),
+		},
+		{
+			name: "junos",
+			ingestEntity: interfaceEntity("GE-0/0/0.100", nil, &diodepb.Device{
+				Name:       "mx01",
+				DeviceType: &diodepb.DeviceType{Model: "MX204", Manufacturer: &diodepb.Manufacturer{Name: "Juniper"}},
+			}),
+			naming:    naming,
+			wantName:  "ge-0/0/0.100",
+			wantLabel: strPtr("GE-0/0/0.100"),
+		},
+		{
+			name:         "unknown interface type kept",
+			ingestEntity: interfaceEntity("Foo0/1", nil, ciscoDevice),
+			naming:       naming,
+			wantName:     "Foo0/1",
+		},
+		{
+			name:         "no convention for device - kept",
+			ingestEntity: interfaceEntity("Gi0/1", nil, &diodepb.Device{Name: "router01"}),
+			naming:       naming,
+			wantName:     "Gi0/1",
+		},
+		{
+			name:         "default convention",
+			ingestEntity: interfaceEntity("Gi0/1", nil, &diodepb.Device{Name: "router01"}),
+			naming:       &netbox.InterfaceNaming{Default: netbox.InterfaceNamingCiscoIOS},
+			wantName:     "GigabitEthernet0/1",
+			wantLabel:    strPtr("Gi0/1"),
+		},
+		{
+			name:         "no interface naming - kept",
+			ingestEntity: interfaceEntity("Gi0/1", nil, ciscoDevice),
+			wantName:     "Gi0/1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			var interfaceQueryParams map[string]string
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				if params.ObjectType == netbox.DcimInterfaceObjectType {
+					interfaceQueryParams = params.Params
+				}
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			})
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithInterfaceNaming(tt.naming))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantName, interfaceQueryParams["q"])
+
+			interfaceChange := cs.ChangeSet[len(cs.ChangeSet)-1]
+			require.Equal(t, netbox.DcimInterfaceObjectType, interfaceChange.ObjectType)
+
+			interf, ok := interfaceChange.Data.(*netbox.DcimInterface)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantName, interf.Name)
+			assert.Equal(t, tt.wantLabel, interf.Label)
+		})
+	}
+}
+
+func TestPrepareVMInterfaceWithInterfaceNaming(t *testing.T) {
+	mockClient := mocks.NewNetBoxAPI(t)
+	retrieveObjectStatesOneByOne(mockClient)
+
+	var interfaceQueryParams map[string]string
+	mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+		if params.ObjectType == netbox.VirtualizationVMInterfaceObjectType {
+			interfaceQueryParams = params.Params
+		}
+		dw, err := netbox.NewDataWrapper(params.ObjectType)
+		if err != nil {
+			return nil, err
+		}
+		return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+	})
+
+	ingestEntity := changeset.IngestEntity{
+		RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+		DataType:  "virtualization.vminterface",
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Vminterface{
+				Vminterface: &diodepb.VMInterface{
+					Name: "et1",
+					VirtualMachine: &diodepb.VirtualMachine{
+						Name:     "veos01",
+						Platform: &diodepb.Platform{Name: "vEOS"},
+					},
+				},
+			},
+		},
+	}
+
+	naming := &netbox.InterfaceNaming{
+		Platforms: map[string]netbox.InterfaceNamingConvention{"veos": netbox.InterfaceNamingAristaEOS},
+	}
+
+	cs, err := changeset.Prepare(ingestEntity, mockClient, changeset.WithInterfaceNaming(naming))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Ethernet1", interfaceQueryParams["q"])
+
+	interfaceChange := cs.ChangeSet[len(cs.ChangeSet)-1]
+	require.Equal(t, netbox.VirtualizationVMInterfaceObjectType, interfaceChange.ObjectType)
+
+	vmInterface, ok := interfaceChange.Data.(*netbox.VirtualizationVMInterface)
+	require.True(t, ok)
+	assert.Equal(t, "Ethernet1", vmInterface.Name)
+}
+
+func TestPrepareWithInterfaceNamingExistingInterface(t *testing.T) {
+	naming := &netbox.InterfaceNaming{Default: netbox.InterfaceNamingCiscoIOS}
+
+	// object states are patched in place, they are built for each test
+	newDevice := func() *netbox.DcimDevice {
+		return &netbox.DcimDevice{
+			ID:         1,
+			Name:       "router01",
+			Site:       &netbox.DcimSite{ID: 1, Name: "undefined", Slug: "undefined", Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive)))},
+			DeviceType: &netbox.DcimDeviceType{ID: 1, Model: "undefined", Slug: "undefined", Manufacturer: &netbox.DcimManufacturer{ID: 1, Name: "undefined", Slug: "undefined"}},
+			Role:       &netbox.DcimDeviceRole{ID: 1, Name: "undefined", Slug: "undefined", Color: strPtr("000000")},
+			Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		existingLabel *string
+		policies      map[string]netbox.FieldOwnershipPolicy
+		wantChange    bool
+		wantLabel     *string
+	}{
+		{
+			name:          "label set in NetBox kept",
+			existingLabel: strPtr("core uplink"),
+		},
+		{
+			name:       "no label in NetBox - ingested name preserved as label",
+			wantChange: true,
+			wantLabel:  strPtr("Gi0/1"),
+		},
+		{
+			name:          "empty label in NetBox - ingested name preserved as label",
+			existingLabel: strPtr(""),
+			wantChange:    true,
+			wantLabel:     strPtr("Gi0/1"),
+		},
+		{
+			name:     "label never touched",
+			policies: map[string]netbox.FieldOwnershipPolicy{netbox.DcimInterfaceObjectType: {"label": netbox.FieldOwnershipNeverTouch}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := newDevice()
+			existing := &netbox.DcimInterface{
+				ID:     2,
+				Name:   "GigabitEthernet0/1",
+				Label:  tt.existingLabel,
+				Device: device,
+				Type:   strPtr("1000base-t"),
+			}
+
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				switch params.ObjectType {
+				case netbox.DcimInterfaceObjectType:
+					return &netboxdiodeplugin.ObjectState{ObjectID: 2, ObjectType: params.ObjectType, Object: &netbox.DcimInterfaceDataWrapper{Interface: existing}}, nil
+				case netbox.DcimDeviceObjectType:
+					return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, Object: &netbox.DcimDeviceDataWrapper{Device: device}}, nil
+				case netbox.DcimSiteObjectType:
+					return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, Object: &netbox.DcimSiteDataWrapper{Site: device.Site}}, nil
+				case netbox.DcimDeviceTypeObjectType:
+					return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, Object: &netbox.DcimDeviceTypeDataWrapper{DeviceType: device.DeviceType}}, nil
+				case netbox.DcimManufacturerObjectType:
+					return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, Object: &netbox.DcimManufacturerDataWrapper{Manufacturer: device.DeviceType.Manufacturer}}, nil
+				case netbox.DcimDeviceRoleObjectType:
+					return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, Object: &netbox.DcimDeviceRoleDataWrapper{DeviceRole: device.Role}}, nil
+				}
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			})
+
+			ingestEntity := changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{Name: "Gi0/1", Device: &diodepb.Device{Name: "router01"}},
+					},
+				},
+			}
+
+			cs, err := changeset.Prepare(ingestEntity, mockClient, changeset.WithInterfaceNaming(naming), changeset.WithFieldOwnership(tt.policies))
+			require.NoError(t, err)
+
+			if !tt.wantChange {
+				assert.Empty(t, cs.ChangeSet)
+				return
+			}
+
+			require.Len(t, cs.ChangeSet, 1)
+			interf, ok := cs.ChangeSet[0].Data.(*netbox.DcimInterface)
+			require.True(t, ok)
+			assert.Equal(t, "GigabitEthernet0/1", interf.Name)
+			assert.Equal(t, tt.wantLabel, interf.Label)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithInterfaceTypeRules(t *testing.T) {
+	customRule, err := netbox.NewInterfaceTypeRule("^et-", nil, nil, "100gbase-x-qsfp28")
+	require.NoError(t, err)
+	jumboRule, err := netbox.NewInterfaceTypeRule("", nil, intPtr(9216), "10gbase-t")
+	require.NoError(t, err)
+
+	rules := append(netbox.InterfaceTypeRules{customRule, jumboRule}, netbox.DefaultInterfaceTypeRules()...)
+
+	interfaceEntity := func(interfacePb *diodepb.Interface) changeset.IngestEntity {
+		interfacePb.Device = &diodepb.Device{Name: "router01"}
+		return changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "dcim.interface",
+			Entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Interface{
+					Interface: interfacePb,
+				},
+			},
+		}
+	}
+
+	site := func() *netbox.DcimSite {
+		return &netbox.DcimSite{
+			ID:     1,
+			Name:   "undefined",
+			Slug:   "undefined",
+			Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+		}
+	}
+	manufacturer := func() *netbox.DcimManufacturer {
+		return &netbox.DcimManufacturer{
+			ID:   1,
+			Name: "undefined",
+			Slug: "undefined",
+		}
+	}
+	deviceType := func() *netbox.DcimDeviceType {
+		return &netbox.DcimDeviceType{
+			ID:           1,
+			Model:        "undefined",
+			Slug:         "undefined",
+			Manufacturer: manufacturer(),
+		}
+	}
+	role := func() *netbox.DcimDeviceRole {
+		return &netbox.DcimDeviceRole{
+			ID:    1,
+			Name:  "undefined",
+			Slug:  "undefined",
+			Color: strPtr("000000"),
+		}
+	}
+	device := func() *netbox.DcimDevice {
+		return &netbox.DcimDevice{
+			ID:         1,
+			Name:       "router01",
+			Site:       site(),
+			DeviceType: deviceType(),
+			Role:       role(),
+			Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+		}
+	}
+	existingInterface := func(name string, interfaceType *string) *netbox.DcimInterface {
+		return &netbox.DcimInterface{
+			ID:     1,
+			Name:   name,
+			Type:   interfaceType,
+			Device: device(),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		ingestEntity      changeset.IngestEntity
+		rules             netbox.InterfaceTypeRules
+		fieldOwnership    map[string]netbox.FieldOwnershipPolicy
+		existingInterface *netbox.DcimInterface
+		wantChangeType    string
+		wantType          *string
+	}{
+		{
+			name:           "new interface - type inferred from name",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "GigabitEthernet0/1"}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("1000base-t"),
+		},
+		{
+			name:           "new interface - sub-interface is virtual",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "Te1/0/1.100"}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("virtual"),
+		},
+		{
+			name:           "new interface - aggregate",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "ae0"}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("lag"),
+		},
+		{
+			name:           "new interface - loopback",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "Loopback0"}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("virtual"),
+		},
+		{
+			name:           "new interface - type inferred from speed",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "Ethernet1/1", Speed: int32Ptr(25_000_000)}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("25gbase-x-sfp28"),
+		},
+		{
+			name:           "new interface - configured rule before built-in ones",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "et-0/0/1", Speed: int32Ptr(40_000_000)}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("100gbase-x-qsfp28"),
+		},
+		{
+			name:           "new interface - type inferred from MTU",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "eth0", Mtu: int32Ptr(9216)}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("10gbase-t"),
+		},
+		{
+			name:           "new interface - no rule matches - default type",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "eth0"}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr(netbox.DefaultInterfaceType),
+		},
+		{
+			name:           "new interface - ingested type kept",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "GigabitEthernet0/1", Type: "1000base-x-sfp"}),
+			rules:          rules,
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr("1000base-x-sfp"),
+		},
+		{
+			name:           "new interface - no rules - default type",
+			ingestEntity:   interfaceEntity(&diodepb.Interface{Name: "GigabitEthernet0/1"}),
+			wantChangeType: changeset.ChangeTypeCreate,
+			wantType:       strPtr(netbox.DefaultInterfaceType),
+		},
+		{
+			name:              "existing interface with default type - inferred type set",
+			ingestEntity:      interfaceEntity(&diodepb.Interface{Name: "GigabitEthernet0/1"}),
+			rules:             rules,
+			existingInterface: existingInterface("GigabitEthernet0/1", strPtr(netbox.DefaultInterfaceType)),
+			wantChangeType:    changeset.ChangeTypeUpdate,
+			wantType:          strPtr("1000base-t"),
+		},
+		{
+			name:              "existing interface with default type - type never touched - do nothing",
+			ingestEntity:      interfaceEntity(&diodepb.Interface{Name: "GigabitEthernet0/1"}),
+			rules:             rules,
+			fieldOwnership:    map[string]netbox.FieldOwnershipPolicy{"dcim.interface": {"type": netbox.FieldOwnershipNeverTouch}},
+			existingInterface: existingInterface("GigabitEthernet0/1", strPtr(netbox.DefaultInterfaceType)),
+		},
+		{
+			name:              "existing interface with type - do nothing",
+			ingestEntity:      interfaceEntity(&diodepb.Interface{Name: "GigabitEthernet0/1"}),
+			rules:             rules,
+			existingInterface: existingInterface("GigabitEthernet0/1", strPtr("1000base-x-sfp")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				object, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				switch params.ObjectType {
+				case netbox.DcimInterfaceObjectType:
+					object = &netbox.DcimInterfaceDataWrapper{Interface: tt.existingInterface}
+				case netbox.DcimSiteObjectType:
+					object = &netbox.DcimSiteDataWrapper{Site: site()}
+				case netbox.DcimManufacturerObjectType:
+					object = &netbox.DcimManufacturerDataWrapper{Manufacturer: manufacturer()}
+				case netbox.DcimDeviceTypeObjectType:
+					object = &netbox.DcimDeviceTypeDataWrapper{DeviceType: deviceType()}
+				case netbox.DcimDeviceRoleObjectType:
+					object = &netbox.DcimDeviceRoleDataWrapper{DeviceRole: role()}
+				case netbox.DcimDeviceObjectType:
+					object = &netbox.DcimDeviceDataWrapper{Device: device()}
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: object}, nil
+			})
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithInterfaceTypeRules(tt.rules), changeset.WithFieldOwnership(tt.fieldOwnership))
+			require.NoError(t, err)
+
+			var interfaceChanges []changeset.Change
+			for _, change := range cs.ChangeSet {
+				if change.ObjectType == netbox.DcimInterfaceObjectType {
+					interfaceChanges = append(interfaceChanges, change)
+				}
+			}
+
+			if tt.wantChangeType == "" {
+				assert.Empty(t, interfaceChanges)
+				return
+			}
+
+			require.Len(t, interfaceChanges, 1)
+			assert.Equal(t, tt.wantChangeType, interfaceChanges[0].ChangeType)
+
+			interf, ok := interfaceChanges[0].Data.(*netbox.DcimInterface)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantType, interf.Type)
+		})
+	}
+}
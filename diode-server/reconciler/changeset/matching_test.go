@@ -0,0 +1,209 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithMatchingStrategies(t *testing.T) {
+	site := func() *netbox.DcimSite {
+		return &netbox.DcimSite{
+			ID:     1,
+			Name:   "undefined",
+			Slug:   "undefined",
+			Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+		}
+	}
+	manufacturer := func() *netbox.DcimManufacturer {
+		return &netbox.DcimManufacturer{
+			ID:   1,
+			Name: "undefined",
+			Slug: "undefined",
+		}
+	}
+	deviceType := func() *netbox.DcimDeviceType {
+		return &netbox.DcimDeviceType{
+			ID:           1,
+			Model:        "undefined",
+			Slug:         "undefined",
+			Manufacturer: manufacturer(),
+		}
+	}
+	role := func() *netbox.DcimDeviceRole {
+		return &netbox.DcimDeviceRole{
+			ID:    1,
+			Name:  "undefined",
+			Slug:  "undefined",
+			Color: strPtr("000000"),
+		}
+	}
+	existingDevice := func(name string) *netbox.DcimDevice {
+		return &netbox.DcimDevice{
+			ID:         1,
+			Name:       name,
+			Site:       site(),
+			DeviceType: deviceType(),
+			Role:       role(),
+			Serial:     strPtr("SN123"),
+			Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+		}
+	}
+	wantDevice := func(id int) *netbox.DcimDevice {
+		return &netbox.DcimDevice{
+			ID:         id,
+			Name:       "router02",
+			Site:       &netbox.DcimSite{ID: 1},
+			DeviceType: &netbox.DcimDeviceType{ID: 1},
+			Role:       &netbox.DcimDeviceRole{ID: 1},
+			Serial:     strPtr("SN123"),
+			Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+		}
+	}
+
+	ingestEntity := changeset.IngestEntity{
+		RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+		DataType:  "dcim.device",
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Device{
+				Device: &diodepb.Device{
+					Name:   "router02",
+					Serial: strPtr("SN123"),
+				},
+			},
+		},
+	}
+
+	bySerial := netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectType: "dcim.device",
+		Params:     map[string]string{"serial": "SN123"},
+	}
+	byName := netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectType: "dcim.device",
+		Params:     map[string]string{"q": "router02", "site__name": "undefined"},
+	}
+
+	tests := []struct {
+		name          string
+		strategies    map[string]netbox.MatchingStrategy
+		devices       map[string]*netbox.DcimDevice
+		wantChangeSet []changeset.Change
+	}{
+		{
+			name:       "matched by serial - device renamed",
+			strategies: map[string]netbox.MatchingStrategy{"dcim.device": {netbox.MatchingKeySerial, netbox.MatchingKeyName}},
+			devices: map[string]*netbox.DcimDevice{
+				"serial": existingDevice("router01"),
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeUpdate,
+					ObjectType: "dcim.device",
+					ObjectID:   intPtr(1),
+					Data:       wantDevice(1),
+				},
+			},
+		},
+		{
+			name:       "serial not found - matched by name - do nothing",
+			strategies: map[string]netbox.MatchingStrategy{"dcim.device": {netbox.MatchingKeySerial, netbox.MatchingKeyName}},
+			devices: map[string]*netbox.DcimDevice{
+				"serial": nil,
+				"name":   existingDevice("router02"),
+			},
+			wantChangeSet: []changeset.Change{},
+		},
+		{
+			name:       "no key matches - create",
+			strategies: map[string]netbox.MatchingStrategy{"dcim.device": {netbox.MatchingKeySerial, netbox.MatchingKeyAssetTag, netbox.MatchingKeyName}},
+			devices: map[string]*netbox.DcimDevice{
+				"serial": nil,
+				"name":   nil,
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeCreate,
+					ObjectType: "dcim.device",
+					Data:       wantDevice(0),
+				},
+			},
+		},
+		{
+			name: "no strategy - matched by name - create",
+			devices: map[string]*netbox.DcimDevice{
+				"name": nil,
+			},
+			wantChangeSet: []changeset.Change{
+				{
+					ChangeType: changeset.ChangeTypeCreate,
+					ObjectType: "dcim.device",
+					Data:       wantDevice(0),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			for _, m := range []struct {
+				params netboxdiodeplugin.RetrieveObjectStateQueryParams
+				object netbox.ComparableData
+			}{
+				{
+					params: netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: "dcim.site", Params: map[string]string{"q": "undefined"}},
+					object: &netbox.DcimSiteDataWrapper{Site: site()},
+				},
+				{
+					params: netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: "dcim.manufacturer", Params: map[string]string{"q": "undefined"}},
+					object: &netbox.DcimManufacturerDataWrapper{Manufacturer: manufacturer()},
+				},
+				{
+					params: netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: "dcim.devicetype", Params: map[string]string{"q": "undefined", "manufacturer__name": "undefined"}},
+					object: &netbox.DcimDeviceTypeDataWrapper{DeviceType: deviceType()},
+				},
+				{
+					params: netboxdiodeplugin.RetrieveObjectStateQueryParams{ObjectType: "dcim.devicerole", Params: map[string]string{"q": "undefined"}},
+					object: &netbox.DcimDeviceRoleDataWrapper{DeviceRole: role()},
+				},
+			} {
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), m.params).Return(&netboxdiodeplugin.ObjectState{
+					ObjectType: m.params.ObjectType,
+					Object:     m.object,
+				}, nil)
+			}
+
+			for key, device := range tt.devices {
+				params := byName
+				if key == "serial" {
+					params = bySerial
+				}
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), params).Return(&netboxdiodeplugin.ObjectState{
+					ObjectType: "dcim.device",
+					Object:     &netbox.DcimDeviceDataWrapper{Device: device},
+				}, nil)
+			}
+
+			cs, err := changeset.Prepare(ingestEntity, mockClient, changeset.WithMatchingStrategies(tt.strategies))
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet {
+				assert.Equal(t, tt.wantChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet[i].ObjectID, cs.ChangeSet[i].ObjectID)
+				assert.Equal(t, tt.wantChangeSet[i].Data, cs.ChangeSet[i].Data)
+			}
+		})
+	}
+}
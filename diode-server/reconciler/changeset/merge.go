@@ -0,0 +1,106 @@
+package changeset
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/hashstructure/v2"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// changeKey identifies the changes of the same object prepared for different ingest entities: creations by the query
+// parameters identifying the object to create, other changes by the ID of their object, changes without either by
+// their data
+type changeKey struct {
+	ChangeType  string
+	ObjectType  string
+	ObjectID    *int
+	QueryParams map[string]string
+	Data        any
+}
+
+// Merge merges change sets into a single change set, keeping the changes of the same object across change sets once
+// (e.g. the creation of a device ingested both as an entity and as the device of an interface). The first change of an
+// object is kept in place for the objects depending on it, with the fields it leaves empty set from the other changes
+// of the object
+func Merge(changeSets ...*ChangeSet) (*ChangeSet, error) {
+	changes := make([]Change, 0)
+	indexes := make(map[uint64]int)
+
+	for _, cs := range changeSets {
+		if cs == nil {
+			continue
+		}
+
+		for _, change := range cs.ChangeSet {
+			key := changeKey{ChangeType: change.ChangeType, ObjectType: change.ObjectType}
+			switch {
+			case change.ChangeType == ChangeTypeCreate && len(change.QueryParams) > 0:
+				key.QueryParams = change.QueryParams
+			case change.ChangeType != ChangeTypeCreate && change.ObjectID != nil:
+				key.ObjectID = change.ObjectID
+			default:
+				key.Data = change.Data
+			}
+
+			hash, err := hashstructure.Hash(key, hashstructure.FormatV2, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to hash change %s: %v", change.ChangeID, err)
+			}
+
+			if i, ok := indexes[hash]; ok {
+				mergeChange(&changes[i], change)
+				continue
+			}
+			indexes[hash] = len(changes)
+
+			changes = append(changes, change)
+		}
+	}
+
+	return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+}
+
+// mergeChange sets the fields of the object of a change left empty from another change of the same object, along with
+// their diff and the fields the other change clears
+func mergeChange(kept *Change, other Change) {
+	filled := mergeData(kept.Data, other.Data)
+
+	for _, fieldChange := range other.Diff {
+		if slices.Contains(filled, fieldChange.Field) {
+			kept.Diff = append(kept.Diff, fieldChange)
+		}
+	}
+
+	for _, field := range other.ClearedFields {
+		if !slices.Contains(kept.ClearedFields, field) {
+			kept.ClearedFields = append(kept.ClearedFields, field)
+		}
+	}
+}
+
+// mergeData sets the empty fields of an object from another object of the same type, both being pointers to structs,
+// returning the names of the fields set as in the NetBox API
+func mergeData(kept any, other any) []string {
+	kv := reflect.ValueOf(kept)
+	ov := reflect.ValueOf(other)
+	if kv.Kind() != reflect.Pointer || kv.IsNil() || kv.Elem().Kind() != reflect.Struct || ov.Type() != kv.Type() || ov.IsNil() {
+		return nil
+	}
+	kv = kv.Elem()
+	ov = ov.Elem()
+
+	var filled []string
+	for i := 0; i < kv.NumField(); i++ {
+		field := kv.Field(i)
+		if !field.CanSet() || !field.IsZero() || ov.Field(i).IsZero() {
+			continue
+		}
+		field.Set(ov.Field(i))
+		filled = append(filled, netbox.FieldName(kv.Type().Field(i)))
+	}
+	return filled
+}
@@ -0,0 +1,124 @@
+package changeset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestMerge(t *testing.T) {
+	siteCreate := func(changeID string) changeset.Change {
+		return changeset.Change{
+			ChangeID:   changeID,
+			ChangeType: changeset.ChangeTypeCreate,
+			ObjectType: netbox.DcimSiteObjectType,
+			Data: &netbox.DcimSite{
+				Name:   "Site A",
+				Slug:   "site-a",
+				Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+			},
+		}
+	}
+
+	deviceCreate := func(changeID, name string) changeset.Change {
+		return changeset.Change{
+			ChangeID:   changeID,
+			ChangeType: changeset.ChangeTypeCreate,
+			ObjectType: netbox.DcimDeviceObjectType,
+			Data: &netbox.DcimDevice{
+				Name: name,
+				Site: &netbox.DcimSite{Name: "Site A", Slug: "site-a"},
+			},
+		}
+	}
+
+	siteUpdate := changeset.Change{
+		ChangeID:   "5",
+		ChangeType: changeset.ChangeTypeUpdate,
+		ObjectType: netbox.DcimSiteObjectType,
+		ObjectID:   intPtr(1),
+		Data: &netbox.DcimSite{
+			ID:     1,
+			Name:   "Site A",
+			Slug:   "site-a",
+			Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+		},
+	}
+
+	cs, err := changeset.Merge(
+		&changeset.ChangeSet{ChangeSetID: "cs1", ChangeSet: []changeset.Change{siteCreate("1"), deviceCreate("2", "router01")}},
+		nil,
+		&changeset.ChangeSet{ChangeSetID: "cs2", ChangeSet: []changeset.Change{siteCreate("3"), deviceCreate("4", "router02")}},
+		&changeset.ChangeSet{ChangeSetID: "cs3", ChangeSet: []changeset.Change{siteUpdate}},
+	)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, cs.ChangeSetID)
+	assert.NotContains(t, []string{"cs1", "cs2", "cs3"}, cs.ChangeSetID)
+
+	changeIDs := make([]string, 0)
+	for _, change := range cs.ChangeSet {
+		changeIDs = append(changeIDs, change.ChangeID)
+	}
+	assert.Equal(t, []string{"1", "2", "4", "5"}, changeIDs)
+}
+
+func TestMergeSameObject(t *testing.T) {
+	interfaceDevice := changeset.Change{
+		ChangeID:    "1",
+		ChangeType:  changeset.ChangeTypeCreate,
+		ObjectType:  netbox.DcimDeviceObjectType,
+		Data:        &netbox.DcimDevice{Name: "r1", Site: &netbox.DcimSite{Name: "S", Slug: "s"}},
+		QueryParams: map[string]string{"q": "r1", "site__name": "S"},
+		Diff:        []netbox.FieldChange{{Field: "name", After: []byte(`"r1"`)}},
+	}
+	interfaceCreate := changeset.Change{
+		ChangeID:    "2",
+		ChangeType:  changeset.ChangeTypeCreate,
+		ObjectType:  netbox.DcimInterfaceObjectType,
+		Data:        &netbox.DcimInterface{Name: "eth0", Device: interfaceDevice.Data.(*netbox.DcimDevice)},
+		QueryParams: map[string]string{"q": "eth0", "device__name": "r1", "device__site__name": "S"},
+	}
+	device := changeset.Change{
+		ChangeID:    "3",
+		ChangeType:  changeset.ChangeTypeCreate,
+		ObjectType:  netbox.DcimDeviceObjectType,
+		Data:        &netbox.DcimDevice{Name: "r1", Site: &netbox.DcimSite{Name: "S", Slug: "s"}, Serial: strPtr("SN1")},
+		QueryParams: map[string]string{"q": "r1", "site__name": "S"},
+		Diff: []netbox.FieldChange{
+			{Field: "name", After: []byte(`"r1"`)},
+			{Field: "serial", After: []byte(`"SN1"`)},
+		},
+	}
+	otherDevice := changeset.Change{
+		ChangeID:    "4",
+		ChangeType:  changeset.ChangeTypeCreate,
+		ObjectType:  netbox.DcimDeviceObjectType,
+		Data:        &netbox.DcimDevice{Name: "r1", Site: &netbox.DcimSite{Name: "T", Slug: "t"}},
+		QueryParams: map[string]string{"q": "r1", "site__name": "T"},
+	}
+
+	cs, err := changeset.Merge(
+		&changeset.ChangeSet{ChangeSetID: "cs1", ChangeSet: []changeset.Change{interfaceDevice, interfaceCreate}},
+		&changeset.ChangeSet{ChangeSetID: "cs2", ChangeSet: []changeset.Change{device}},
+		&changeset.ChangeSet{ChangeSetID: "cs3", ChangeSet: []changeset.Change{otherDevice}},
+	)
+	require.NoError(t, err)
+
+	changeIDs := make([]string, 0)
+	for _, change := range cs.ChangeSet {
+		changeIDs = append(changeIDs, change.ChangeID)
+	}
+	assert.Equal(t, []string{"1", "2", "4"}, changeIDs, "device created once, before its interface")
+
+	merged := cs.ChangeSet[0]
+	assert.Equal(t, strPtr("SN1"), merged.Data.(*netbox.DcimDevice).Serial)
+	assert.Equal(t, []netbox.FieldChange{
+		{Field: "name", After: []byte(`"r1"`)},
+		{Field: "serial", After: []byte(`"SN1"`)},
+	}, merged.Diff)
+}
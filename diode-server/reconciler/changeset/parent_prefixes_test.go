@@ -0,0 +1,157 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithParentPrefixes(t *testing.T) {
+	ipAddressEntity := func(address string) changeset.IngestEntity {
+		return changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "ipam.ipaddress",
+			Entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_IpAddress{
+					IpAddress: &diodepb.IPAddress{
+						Address: address,
+						AssignedObject: &diodepb.IPAddress_Interface{
+							Interface: &diodepb.Interface{
+								Name: "GigabitEthernet0/0/0",
+								Device: &diodepb.Device{
+									Name: "router01",
+									Site: &diodepb.Site{Name: "Site A"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		ingestEntity   changeset.IngestEntity
+		enabled        bool
+		status         string
+		existingPrefix *netbox.IpamPrefix
+		wantPrefix     *netbox.IpamPrefix
+		wantNoSite     bool
+	}{
+		{
+			name:         "prefix not found - create prefix",
+			ingestEntity: ipAddressEntity("192.168.10.21/24"),
+			enabled:      true,
+			wantPrefix: &netbox.IpamPrefix{
+				Prefix: "192.168.10.0/24",
+				Site:   &netbox.DcimSite{Name: "Site A", Slug: "site-a", Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive)))},
+				Status: strPtr("active"),
+			},
+		},
+		{
+			name:         "prefix not found - create prefix with configured status",
+			ingestEntity: ipAddressEntity("2001:db8:a::21/64"),
+			enabled:      true,
+			status:       "reserved",
+			wantPrefix: &netbox.IpamPrefix{
+				Prefix: "2001:db8:a::/64",
+				Site:   &netbox.DcimSite{Name: "Site A", Slug: "site-a", Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive)))},
+				Status: strPtr("reserved"),
+			},
+		},
+		{
+			name: "prefix not found - create prefix without site for unassigned address",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "ipam.ipaddress",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_IpAddress{
+						IpAddress: &diodepb.IPAddress{Address: "192.168.10.21/24"},
+					},
+				},
+			},
+			enabled: true,
+			wantPrefix: &netbox.IpamPrefix{
+				Prefix: "192.168.10.0/24",
+				Status: strPtr("active"),
+			},
+			wantNoSite: true,
+		},
+		{
+			name:         "prefix found - do nothing",
+			ingestEntity: ipAddressEntity("192.168.10.21/24"),
+			enabled:      true,
+			existingPrefix: &netbox.IpamPrefix{
+				ID:     3,
+				Prefix: "192.168.10.0/24",
+				Site:   &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive)))},
+				Status: strPtr("container"),
+			},
+		},
+		{
+			name:         "host address - no prefix",
+			ingestEntity: ipAddressEntity("192.168.10.21/32"),
+			enabled:      true,
+		},
+		{
+			name:         "disabled - no prefix",
+			ingestEntity: ipAddressEntity("192.168.10.21/24"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				if params.ObjectType == netbox.IpamPrefixObjectType && tt.existingPrefix != nil {
+					return &netboxdiodeplugin.ObjectState{
+						ObjectID:   tt.existingPrefix.ID,
+						ObjectType: params.ObjectType,
+						Object:     &netbox.IpamPrefixDataWrapper{Prefix: tt.existingPrefix},
+					}, nil
+				}
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			}).Maybe()
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithParentPrefixes(tt.enabled, tt.status))
+			require.NoError(t, err)
+
+			changes := make(map[string][]changeset.Change)
+			for _, change := range cs.ChangeSet {
+				changes[change.ObjectType] = append(changes[change.ObjectType], change)
+			}
+
+			require.Len(t, changes[netbox.IpamIPAddressObjectType], 1)
+			if tt.wantNoSite {
+				assert.NotContains(t, changes, netbox.DcimSiteObjectType, "no placeholder site created")
+			} else {
+				require.Len(t, changes[netbox.DcimSiteObjectType], 1, "site created once")
+			}
+
+			if tt.wantPrefix == nil {
+				assert.NotContains(t, changes, netbox.IpamPrefixObjectType)
+				return
+			}
+
+			require.Len(t, changes[netbox.IpamPrefixObjectType], 1)
+			prefixChange := changes[netbox.IpamPrefixObjectType][0]
+			assert.Equal(t, changeset.ChangeTypeCreate, prefixChange.ChangeType)
+			assert.Equal(t, tt.wantPrefix, prefixChange.Data)
+		})
+	}
+}
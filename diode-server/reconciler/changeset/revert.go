@@ -0,0 +1,243 @@
+package changeset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+)
+
+// PrepareRevert prepares a change set reverting an applied change set, its changes being reverted in reverse order:
+// objects it created are deleted, fields of objects it updated are restored to their values before it and objects it
+// deleted are recreated. Objects removed from NetBox since are left as is, objects changed since fail the revert.
+func PrepareRevert(cs *ChangeSet, netboxAPI netboxdiodeplugin.NetBoxAPI) (*ChangeSet, error) {
+	changes := make([]Change, 0, len(cs.ChangeSet))
+
+	for i := len(cs.ChangeSet) - 1; i >= 0; i-- {
+		change, err := revertChange(cs.ChangeSet[i], netboxAPI)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revert change %s: %w", cs.ChangeSet[i].ChangeID, err)
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+
+	return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+}
+
+// revertChange returns the change reverting a change, nil if there is nothing to revert
+func revertChange(change Change, netboxAPI netboxdiodeplugin.NetBoxAPI) (*Change, error) {
+	switch change.ChangeType {
+	case ChangeTypeCreate:
+		if len(change.QueryParams) == 0 {
+			return nil, errors.New("query parameters of the created object not recorded")
+		}
+
+		current, objectVersion, err := retrieveObjectStateByQueryParams(netboxAPI, change.ObjectType, change.QueryParams)
+		if err != nil || current == nil {
+			return nil, err
+		}
+
+		// the created object is only deleted with the values it was created with, objects created since and referencing
+		// it aren't looked for
+		if err := verifyUnchanged(change, current); err != nil {
+			return nil, err
+		}
+
+		objectID := current.ID()
+		return &Change{
+			ChangeID:      uuid.NewString(),
+			ChangeType:    ChangeTypeDelete,
+			ObjectType:    change.ObjectType,
+			ObjectID:      &objectID,
+			ObjectVersion: objectVersion,
+			Data:          nil,
+		}, nil
+	case ChangeTypeUpdate:
+		if change.ObjectID == nil || len(change.Before) == 0 {
+			return nil, errors.New("state of the object before the change not recorded")
+		}
+
+		current, objectVersion, err := retrieveObjectStateByID(netboxAPI, change.ObjectType, *change.ObjectID)
+		if err != nil || current == nil {
+			return nil, err
+		}
+
+		if len(change.Diff) == 0 {
+			return nil, errors.New("fields changed by the change not recorded")
+		}
+
+		if err := verifyUnchanged(change, current); err != nil {
+			return nil, err
+		}
+
+		before, err := objectStateReferences(change.Before)
+		if err != nil {
+			return nil, err
+		}
+
+		// only the fields changed are restored, those which were empty before the change being cleared
+		data := map[string]any{"id": *change.ObjectID}
+		var clearedFields []string
+		for _, fieldChange := range change.Diff {
+			if value, ok := before[fieldChange.Field]; ok && value != nil {
+				data[fieldChange.Field] = value
+				continue
+			}
+			clearedFields = append(clearedFields, fieldChange.Field)
+		}
+		slices.Sort(clearedFields)
+
+		return &Change{
+			ChangeID:      uuid.NewString(),
+			ChangeType:    ChangeTypeUpdate,
+			ObjectType:    change.ObjectType,
+			ObjectID:      change.ObjectID,
+			ObjectVersion: objectVersion,
+			Data:          data,
+			ClearedFields: clearedFields,
+		}, nil
+	case ChangeTypeDelete:
+		if len(change.Before) == 0 {
+			return nil, errors.New("state of the object before the change not recorded")
+		}
+
+		before, err := objectStateReferences(change.Before)
+		if err != nil {
+			return nil, err
+		}
+		delete(before, "id")
+
+		return &Change{
+			ChangeID:   uuid.NewString(),
+			ChangeType: ChangeTypeCreate,
+			ObjectType: change.ObjectType,
+			Data:       before,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown change type %s", change.ChangeType)
+	}
+}
+
+// retrieveObjectStateByID retrieves the object state of an object by its ID along with its version
+func retrieveObjectStateByID(netboxAPI netboxdiodeplugin.NetBoxAPI, objectType string, objectID int) (netbox.ComparableData, *int, error) {
+	dw, err := netbox.NewDataWrapper(objectType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := netboxAPI.RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectType: objectType,
+		ObjectID:   objectID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objectStateData(dw, resp)
+}
+
+// objectStateReferences decodes the JSON state of an object, its nested objects being replaced by references to their
+// IDs as in the changes prepared from ingested entities
+func objectStateReferences(state json.RawMessage) (map[string]any, error) {
+	data, err := decodeObjectData(state)
+	if err != nil {
+		return nil, err
+	}
+
+	for field, value := range data {
+		if field == netbox.TagsFieldName {
+			continue
+		}
+		data[field] = netbox.ObjectReference(value)
+	}
+
+	return data, nil
+}
+
+// verifyUnchanged returns an error if a field changed by a change no longer has the value it was set to in the
+// current state of its object, the object having been changed since. Nested objects created along with the change
+// have no ID to compare, they are only required to still be set.
+func verifyUnchanged(change Change, current netbox.ComparableData) error {
+	currentValues := make(map[string]json.RawMessage)
+	for _, fieldChange := range netbox.DiffFields(nil, current.Data()) {
+		currentValues[fieldChange.Field] = fieldChange.After
+	}
+
+	for _, fieldChange := range change.Diff {
+		after, err := decodeFieldValue(fieldChange.After)
+		if err != nil {
+			return err
+		}
+		value, err := decodeFieldValue(currentValues[fieldChange.Field])
+		if err != nil {
+			return err
+		}
+
+		if !resolvedReference(after) {
+			if value != nil {
+				continue
+			}
+		} else if reflect.DeepEqual(after, value) {
+			continue
+		}
+
+		return fmt.Errorf("field %s of %s changed since the change was applied", fieldChange.Field, change.ObjectType)
+	}
+
+	return nil
+}
+
+// resolvedReference returns false for a value referencing a nested object without ID, i.e. not found in NetBox when
+// the change was prepared, looking for nested objects in the values of objects without ID (e.g. assigned objects)
+func resolvedReference(value any) bool {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return true
+	}
+	if _, ok := obj["id"]; ok {
+		return true
+	}
+
+	for _, v := range obj {
+		if _, ok := v.(map[string]any); !ok {
+			return false
+		}
+		if !resolvedReference(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeFieldValue(b json.RawMessage) (any, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode field value: %w", err)
+	}
+	return value, nil
+}
+
+func decodeObjectData(b []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var data map[string]any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode object data: %w", err)
+	}
+	return data, nil
+}
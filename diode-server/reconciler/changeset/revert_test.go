@@ -0,0 +1,284 @@
+package changeset_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareRevert(t *testing.T) {
+	existingSite := &netbox.DcimSite{
+		ID:       1,
+		Name:     "Site A",
+		Slug:     "site-a",
+		Status:   (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+		Facility: strPtr("DC1"),
+	}
+
+	deviceEntity := changeset.IngestEntity{
+		RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+		DataType:  "dcim.device",
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Device{
+				Device: &diodepb.Device{
+					Name: "router01",
+					Site: &diodepb.Site{Name: "Site A", Facility: strPtr("DC2"), Description: strPtr("edge site")},
+				},
+			},
+		},
+	}
+
+	mockClient := mocks.NewNetBoxAPI(t)
+	retrieveObjectStatesOneByOne(mockClient)
+
+	createdDevice := &netbox.DcimDevice{
+		ID:         7,
+		Name:       "router01",
+		Site:       &netbox.DcimSite{ID: 1},
+		Role:       &netbox.DcimDeviceRole{ID: 2, Name: "undefined", Slug: "undefined"},
+		DeviceType: &netbox.DcimDeviceType{ID: 3, Model: "undefined", Slug: "undefined"},
+		Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+	}
+	updatedSite := &netbox.DcimSite{
+		ID:          1,
+		Name:        "Site A",
+		Slug:        "site-a",
+		Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+		Facility:    strPtr("DC2"),
+		Description: strPtr("edge site"),
+	}
+
+	var reverting bool
+	mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+		switch {
+		case reverting && params.ObjectType == netbox.DcimSiteObjectType && params.ObjectID == updatedSite.ID:
+			return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, ObjectChangeID: 12, Object: &netbox.DcimSiteDataWrapper{Site: updatedSite}}, nil
+		case params.ObjectType == netbox.DcimSiteObjectType && !reverting:
+			return &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: params.ObjectType, ObjectChangeID: 12, Object: &netbox.DcimSiteDataWrapper{Site: existingSite}}, nil
+		case reverting && params.ObjectType == netbox.DcimDeviceObjectType && params.Params["q"] == "router01" && params.Params["site__name"] == "Site A":
+			return &netboxdiodeplugin.ObjectState{ObjectID: 7, ObjectType: params.ObjectType, ObjectChangeID: 13, Object: &netbox.DcimDeviceDataWrapper{Device: createdDevice}}, nil
+		}
+		dw, err := netbox.NewDataWrapper(params.ObjectType)
+		if err != nil {
+			return nil, err
+		}
+		return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+	}).Maybe()
+
+	cs, err := changeset.Prepare(deviceEntity, mockClient)
+	require.NoError(t, err)
+
+	changes := make(map[string]changeset.Change)
+	for _, change := range cs.ChangeSet {
+		changes[change.ObjectType] = change
+	}
+
+	siteChange := changes[netbox.DcimSiteObjectType]
+	require.Equal(t, changeset.ChangeTypeUpdate, siteChange.ChangeType)
+	assert.JSONEq(t, `{"id":1,"name":"Site A","slug":"site-a","status":"active","facility":"DC1"}`, string(siteChange.Before))
+	assert.Nil(t, siteChange.QueryParams)
+
+	deviceChange := changes[netbox.DcimDeviceObjectType]
+	require.Equal(t, changeset.ChangeTypeCreate, deviceChange.ChangeType)
+	assert.Nil(t, deviceChange.Before)
+	assert.Equal(t, map[string]string{"q": "router01", "site__name": "Site A"}, deviceChange.QueryParams)
+
+	// the change set is reverted as stored in the ingestion logs
+	b, err := json.Marshal(cs)
+	require.NoError(t, err)
+
+	var stored changeset.ChangeSet
+	require.NoError(t, json.Unmarshal(b, &stored))
+
+	reverting = true
+	revertCS, err := changeset.PrepareRevert(&stored, mockClient)
+	require.NoError(t, err)
+
+	revertChanges := make(map[string]changeset.Change)
+	var order []string
+	for _, change := range revertCS.ChangeSet {
+		revertChanges[change.ObjectType] = change
+		order = append(order, change.ObjectType)
+	}
+	assert.Equal(t, netbox.DcimDeviceObjectType, order[0], "created device deleted first")
+
+	deleteDevice := revertChanges[netbox.DcimDeviceObjectType]
+	assert.Equal(t, changeset.ChangeTypeDelete, deleteDevice.ChangeType)
+	assert.Equal(t, intPtr(7), deleteDevice.ObjectID)
+	assert.Equal(t, intPtr(13), deleteDevice.ObjectVersion)
+	assert.Nil(t, deleteDevice.Data)
+
+	restoreSite := revertChanges[netbox.DcimSiteObjectType]
+	assert.Equal(t, changeset.ChangeTypeUpdate, restoreSite.ChangeType)
+	assert.Equal(t, intPtr(1), restoreSite.ObjectID)
+	assert.Equal(t, intPtr(12), restoreSite.ObjectVersion)
+	restoredSite, err := json.Marshal(restoreSite.Data)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"facility":"DC1"}`, string(restoredSite))
+	assert.Equal(t, []string{"description"}, restoreSite.ClearedFields)
+}
+
+func TestPrepareRevertChanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		change      changeset.Change
+		objectState *netboxdiodeplugin.ObjectState
+		wantChange  *changeset.Change
+		wantErr     bool
+	}{
+		{
+			name: "deleted object restored with references to nested objects",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeDelete,
+				ObjectType: netbox.IpamIPAddressObjectType,
+				ObjectID:   intPtr(3),
+				Before:     json.RawMessage(`{"id":3,"address":"192.168.0.1/24","assigned_object":{"interface":{"id":4,"name":"eth0","device":{"id":7,"name":"router01"}}},"tags":[{"id":1,"name":"managed","slug":"managed"}]}`),
+			},
+			wantChange: &changeset.Change{
+				ChangeType: changeset.ChangeTypeCreate,
+				ObjectType: netbox.IpamIPAddressObjectType,
+				Data: map[string]any{
+					"address":         "192.168.0.1/24",
+					"assigned_object": map[string]any{"interface": map[string]any{"id": json.Number("4")}},
+					"tags":            []any{map[string]any{"id": json.Number("1"), "name": "managed", "slug": "managed"}},
+				},
+			},
+		},
+		{
+			name: "created object already removed - nothing to revert",
+			change: changeset.Change{
+				ChangeID:    "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType:  changeset.ChangeTypeCreate,
+				ObjectType:  netbox.DcimSiteObjectType,
+				QueryParams: map[string]string{"q": "Site A"},
+			},
+			objectState: &netboxdiodeplugin.ObjectState{ObjectType: netbox.DcimSiteObjectType, Object: &netbox.DcimSiteDataWrapper{}},
+		},
+		{
+			name: "updated object removed - nothing to revert",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+				Before:     json.RawMessage(`{"id":1,"name":"Site A","slug":"site-a"}`),
+			},
+			objectState: &netboxdiodeplugin.ObjectState{ObjectType: netbox.DcimSiteObjectType, Object: &netbox.DcimSiteDataWrapper{}},
+		},
+		{
+			name: "changed fields of updated object restored",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+				Before:     json.RawMessage(`{"id":1,"name":"Site A","slug":"site-a","facility":"DC1","description":"core site"}`),
+				Diff:       []netbox.FieldChange{{Field: "facility", Before: json.RawMessage(`"DC1"`), After: json.RawMessage(`"DC2"`)}},
+			},
+			objectState: &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: netbox.DcimSiteObjectType, Object: &netbox.DcimSiteDataWrapper{Site: &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Facility: strPtr("DC2"), Description: strPtr("edited since")}}},
+			wantChange: &changeset.Change{
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+				Data:       map[string]any{"id": 1, "facility": "DC1"},
+			},
+		},
+		{
+			name: "changed field of updated object changed since",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+				Before:     json.RawMessage(`{"id":1,"name":"Site A","slug":"site-a","facility":"DC1"}`),
+				Diff:       []netbox.FieldChange{{Field: "facility", Before: json.RawMessage(`"DC1"`), After: json.RawMessage(`"DC2"`)}},
+			},
+			objectState: &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: netbox.DcimSiteObjectType, Object: &netbox.DcimSiteDataWrapper{Site: &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Facility: strPtr("DC3")}}},
+			wantErr:     true,
+		},
+		{
+			name: "update without changed fields",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+				Before:     json.RawMessage(`{"id":1,"name":"Site A","slug":"site-a","facility":"DC1"}`),
+			},
+			objectState: &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: netbox.DcimSiteObjectType, Object: &netbox.DcimSiteDataWrapper{Site: &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Facility: strPtr("DC2")}}},
+			wantErr:     true,
+		},
+		{
+			name: "created object changed since",
+			change: changeset.Change{
+				ChangeID:    "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType:  changeset.ChangeTypeCreate,
+				ObjectType:  netbox.DcimSiteObjectType,
+				QueryParams: map[string]string{"q": "Site A"},
+				Diff: []netbox.FieldChange{
+					{Field: "name", After: json.RawMessage(`"Site A"`)},
+					{Field: "slug", After: json.RawMessage(`"site-a"`)},
+					{Field: "facility", After: json.RawMessage(`"DC1"`)},
+				},
+			},
+			objectState: &netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: netbox.DcimSiteObjectType, Object: &netbox.DcimSiteDataWrapper{Site: &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a"}}},
+			wantErr:     true,
+		},
+		{
+			name: "update without state before the change",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "creation without query parameters",
+			change: changeset.Change{
+				ChangeID:   "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+				ChangeType: changeset.ChangeTypeCreate,
+				ObjectType: netbox.DcimSiteObjectType,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			if tt.objectState != nil {
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).Return(tt.objectState, nil)
+			}
+
+			cs, err := changeset.PrepareRevert(&changeset.ChangeSet{ChangeSetID: "cs-1", ChangeSet: []changeset.Change{tt.change}}, mockClient)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.wantChange == nil {
+				assert.Empty(t, cs.ChangeSet)
+				return
+			}
+
+			require.Len(t, cs.ChangeSet, 1)
+			assert.Equal(t, tt.wantChange.ChangeType, cs.ChangeSet[0].ChangeType)
+			assert.Equal(t, tt.wantChange.ObjectType, cs.ChangeSet[0].ObjectType)
+			assert.Equal(t, tt.wantChange.ObjectID, cs.ChangeSet[0].ObjectID)
+			assert.Equal(t, tt.wantChange.Data, cs.ChangeSet[0].Data)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package changeset
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+)
+
+// StaleAction is the action taken on objects which are no longer reported by their producer
+type StaleAction string
+
+const (
+	// StaleActionTag tags stale objects
+	StaleActionTag StaleAction = "tag"
+
+	// StaleActionOffline sets the status of stale objects to offline
+	StaleActionOffline StaleAction = "offline"
+
+	// StaleActionDecommissioning sets the status of stale objects to decommissioning
+	StaleActionDecommissioning StaleAction = "decommissioning"
+)
+
+// staleStatusObjectTypes lists the object types supporting the offline and decommissioning statuses,
+// other object types are tagged instead
+var staleStatusObjectTypes = []string{
+	netbox.DcimDeviceObjectType,
+	netbox.VirtualizationVirtualMachineObjectType,
+}
+
+// ParseStaleAction parses a stale action
+func ParseStaleAction(s string) (StaleAction, error) {
+	switch a := StaleAction(s); a {
+	case StaleActionTag, StaleActionOffline, StaleActionDecommissioning:
+		return a, nil
+	default:
+		return "", fmt.Errorf("invalid stale action %q", s)
+	}
+}
+
+// StaleObjectData is the partial object data sent to NetBox when marking an object as stale
+type StaleObjectData struct {
+	Status *string       `json:"status,omitempty"`
+	Tags   []*netbox.Tag `json:"tags,omitempty"`
+}
+
+// PrepareStale prepares a change set marking the object identified by the object type and query parameters as stale
+func PrepareStale(objectType string, queryParams map[string]string, action StaleAction, tagName string, netboxAPI netboxdiodeplugin.NetBoxAPI) (*ChangeSet, error) {
+	changes := make([]Change, 0)
+
+	current, objectVersion, err := retrieveObjectStateByQueryParams(netboxAPI, objectType, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// object has already been removed from NetBox
+	if current == nil {
+		return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+	}
+
+	var currentData StaleObjectData
+	before, err := json.Marshal(current.Data())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state of %s %d: %w", objectType, current.ID(), err)
+	}
+	if err := json.Unmarshal(before, &currentData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object state: %v", err)
+	}
+
+	var data StaleObjectData
+
+	if action != StaleActionTag && slices.Contains(staleStatusObjectTypes, objectType) {
+		if currentData.Status != nil && *currentData.Status == string(action) {
+			return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+		}
+
+		status := string(action)
+		data.Status = &status
+	} else {
+		for _, t := range currentData.Tags {
+			if t.Name == tagName {
+				return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+			}
+		}
+
+		tag := &netbox.Tag{
+			Name: tagName,
+			Slug: slug.Make(tagName),
+		}
+
+		existingTag, _, err := retrieveObjectState(netboxAPI, &netbox.TagDataWrapper{Tag: tag})
+		if err != nil {
+			return nil, err
+		}
+
+		if existingTag != nil {
+			tag = existingTag.Data().(*netbox.Tag)
+		} else {
+			changes = append(changes, Change{
+				ChangeID:      uuid.NewString(),
+				ChangeType:    ChangeTypeCreate,
+				ObjectType:    netbox.ExtrasTagObjectType,
+				ObjectID:      nil,
+				ObjectVersion: nil,
+				Data:          tag,
+				QueryParams:   (&netbox.TagDataWrapper{Tag: tag}).ObjectStateQueryParams(),
+				Diff:          netbox.DiffFields(nil, tag),
+			})
+		}
+
+		data.Tags = append(currentData.Tags, tag)
+	}
+
+	diff, err := staleDiff(current.Data(), before, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID := current.ID()
+	changes = append(changes, Change{
+		ChangeID:      uuid.NewString(),
+		ChangeType:    ChangeTypeUpdate,
+		ObjectType:    objectType,
+		ObjectID:      &objectID,
+		ObjectVersion: objectVersion,
+		Data:          &data,
+		Before:        before,
+		Diff:          diff,
+	})
+
+	return &ChangeSet{ChangeSetID: uuid.NewString(), ChangeSet: changes}, nil
+}
+
+// staleDiff returns the fields of an object changed by marking it as stale, from its current state and its JSON
+// encoding to the state with the stale object data set
+func staleDiff(current any, before json.RawMessage, data *StaleObjectData) ([]netbox.FieldChange, error) {
+	after := reflect.New(reflect.TypeOf(current).Elem()).Interface()
+	if err := json.Unmarshal(before, after); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object state: %v", err)
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal stale object data: %v", err)
+	}
+	if err := json.Unmarshal(b, after); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stale object data: %v", err)
+	}
+
+	return netbox.DiffFields(current, after), nil
+}
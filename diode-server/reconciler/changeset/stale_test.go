@@ -0,0 +1,392 @@
+package changeset_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestObjectStateQueryParams(t *testing.T) {
+	tests := []struct {
+		name         string
+		ingestEntity changeset.IngestEntity
+		want         map[string]string
+		wantErr      bool
+	}{
+		{
+			name: "device with site",
+			ingestEntity: changeset.IngestEntity{
+				DataType: "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{
+							Name: "router01",
+							Site: &diodepb.Site{Name: "Site A"},
+						},
+					},
+				},
+			},
+			want: map[string]string{"q": "router01", "site__name": "Site A"},
+		},
+		{
+			name: "device without site",
+			ingestEntity: changeset.IngestEntity{
+				DataType: "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{
+							Name: "router01",
+						},
+					},
+				},
+			},
+			want: map[string]string{"q": "router01", "site__name": "undefined"},
+		},
+		{
+			name: "invalid entity",
+			ingestEntity: changeset.IngestEntity{
+				DataType: "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: nil,
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := changeset.ObjectStateQueryParams(tt.ingestEntity)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, params)
+		})
+	}
+}
+
+func TestPrepareStale(t *testing.T) {
+	type mockRetrieveObjectState struct {
+		objectType  string
+		queryParams map[string]string
+		objectID    int
+		object      netbox.ComparableData
+	}
+	deviceParams := map[string]string{"q": "router01", "site__name": "undefined"}
+	ipAddressParams := map[string]string{"q": "192.168.0.1/24"}
+	tests := []struct {
+		name                 string
+		objectType           string
+		queryParams          map[string]string
+		action               changeset.StaleAction
+		retrieveObjectStates []mockRetrieveObjectState
+		wantChangeSet        changeset.ChangeSet
+		wantErr              bool
+	}{
+		{
+			name:        "device no longer in NetBox - do nothing",
+			objectType:  "dcim.device",
+			queryParams: deviceParams,
+			action:      changeset.StaleActionOffline,
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:  "dcim.device",
+					queryParams: deviceParams,
+					object:      &netbox.DcimDeviceDataWrapper{Device: nil},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{ChangeSet: []changeset.Change{}},
+		},
+		{
+			name:        "active device - set status to offline",
+			objectType:  "dcim.device",
+			queryParams: deviceParams,
+			action:      changeset.StaleActionOffline,
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:  "dcim.device",
+					queryParams: deviceParams,
+					objectID:    1,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:     1,
+							Name:   "router01",
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSet: []changeset.Change{
+					{
+						ChangeType: changeset.ChangeTypeUpdate,
+						ObjectType: "dcim.device",
+						ObjectID:   intPtr(1),
+						Data: &changeset.StaleObjectData{
+							Status: strPtr("offline"),
+						},
+						Diff: []netbox.FieldChange{
+							{Field: "status", Before: json.RawMessage(`"active"`), After: json.RawMessage(`"offline"`)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "device already decommissioning - do nothing",
+			objectType:  "dcim.device",
+			queryParams: deviceParams,
+			action:      changeset.StaleActionDecommissioning,
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:  "dcim.device",
+					queryParams: deviceParams,
+					objectID:    1,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:     1,
+							Name:   "router01",
+							Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusDecommissioning))),
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{ChangeSet: []changeset.Change{}},
+		},
+		{
+			name:        "ip address without status action support - stale tag not found - create tag and tag ip address",
+			objectType:  "ipam.ipaddress",
+			queryParams: ipAddressParams,
+			action:      changeset.StaleActionOffline,
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:  "ipam.ipaddress",
+					queryParams: ipAddressParams,
+					objectID:    2,
+					object: &netbox.IpamIPAddressDataWrapper{
+						IPAddress: &netbox.IpamIPAddress{
+							ID:      2,
+							Address: "192.168.0.1/24",
+							Tags: []*netbox.Tag{
+								{ID: 3, Name: "discovered", Slug: "discovered"},
+							},
+						},
+					},
+				},
+				{
+					objectType:  "extras.tag",
+					queryParams: map[string]string{"q": "stale"},
+					object:      &netbox.TagDataWrapper{Tag: nil},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSet: []changeset.Change{
+					{
+						ChangeType:  changeset.ChangeTypeCreate,
+						ObjectType:  "extras.tag",
+						Data:        &netbox.Tag{Name: "stale", Slug: "stale"},
+						QueryParams: map[string]string{"q": "stale"},
+						Diff: []netbox.FieldChange{
+							{Field: "name", After: json.RawMessage(`"stale"`)},
+							{Field: "slug", After: json.RawMessage(`"stale"`)},
+						},
+					},
+					{
+						ChangeType: changeset.ChangeTypeUpdate,
+						ObjectType: "ipam.ipaddress",
+						ObjectID:   intPtr(2),
+						Data: &changeset.StaleObjectData{
+							Tags: []*netbox.Tag{
+								{ID: 3, Name: "discovered", Slug: "discovered"},
+								{Name: "stale", Slug: "stale"},
+							},
+						},
+						Diff: []netbox.FieldChange{
+							{Field: "tags", Before: json.RawMessage(`["discovered"]`), After: json.RawMessage(`["discovered","stale"]`)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "device - stale tag found - tag device",
+			objectType:  "dcim.device",
+			queryParams: deviceParams,
+			action:      changeset.StaleActionTag,
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:  "dcim.device",
+					queryParams: deviceParams,
+					objectID:    1,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+						},
+					},
+				},
+				{
+					objectType:  "extras.tag",
+					queryParams: map[string]string{"q": "stale"},
+					objectID:    4,
+					object: &netbox.TagDataWrapper{
+						Tag: &netbox.Tag{ID: 4, Name: "stale", Slug: "stale"},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{
+				ChangeSet: []changeset.Change{
+					{
+						ChangeType: changeset.ChangeTypeUpdate,
+						ObjectType: "dcim.device",
+						ObjectID:   intPtr(1),
+						Data: &changeset.StaleObjectData{
+							Tags: []*netbox.Tag{
+								{ID: 4, Name: "stale", Slug: "stale"},
+							},
+						},
+						Diff: []netbox.FieldChange{
+							{Field: "tags", After: json.RawMessage(`["stale"]`)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "device already tagged - do nothing",
+			objectType:  "dcim.device",
+			queryParams: deviceParams,
+			action:      changeset.StaleActionTag,
+			retrieveObjectStates: []mockRetrieveObjectState{
+				{
+					objectType:  "dcim.device",
+					queryParams: deviceParams,
+					objectID:    1,
+					object: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:   1,
+							Name: "router01",
+							Tags: []*netbox.Tag{
+								{ID: 4, Name: "stale", Slug: "stale"},
+							},
+						},
+					},
+				},
+			},
+			wantChangeSet: changeset.ChangeSet{ChangeSet: []changeset.Change{}},
+		},
+		{
+			name:        "unsupported object type - error",
+			objectType:  "dcim.unknown",
+			queryParams: map[string]string{"q": "unknown"},
+			action:      changeset.StaleActionTag,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+
+			for _, m := range tt.retrieveObjectStates {
+				mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+					ObjectType: m.objectType,
+					ObjectID:   0,
+					Params:     m.queryParams,
+				}).Return(&netboxdiodeplugin.ObjectState{
+					ObjectID:   m.objectID,
+					ObjectType: m.objectType,
+					Object:     m.object,
+				}, nil)
+			}
+
+			cs, err := changeset.PrepareStale(tt.objectType, tt.queryParams, tt.action, "stale", mockClient)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.wantChangeSet.ChangeSet), len(cs.ChangeSet))
+
+			for i := range tt.wantChangeSet.ChangeSet {
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ChangeType, cs.ChangeSet[i].ChangeType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ObjectType, cs.ChangeSet[i].ObjectType)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].ObjectID, cs.ChangeSet[i].ObjectID)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].Data, cs.ChangeSet[i].Data)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].QueryParams, cs.ChangeSet[i].QueryParams)
+				assert.Equal(t, tt.wantChangeSet.ChangeSet[i].Diff, cs.ChangeSet[i].Diff)
+
+				if cs.ChangeSet[i].ChangeType == changeset.ChangeTypeUpdate {
+					before, err := json.Marshal(tt.retrieveObjectStates[0].object.Data())
+					require.NoError(t, err)
+					assert.JSONEq(t, string(before), string(cs.ChangeSet[i].Before))
+				}
+			}
+		})
+	}
+}
+
+func TestPrepareRevertStale(t *testing.T) {
+	activeDevice := &netbox.DcimDevice{
+		ID:     1,
+		Name:   "router01",
+		Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+	}
+	offlineDevice := &netbox.DcimDevice{
+		ID:     1,
+		Name:   "router01",
+		Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusOffline))),
+	}
+
+	mockClient := mocks.NewNetBoxAPI(t)
+	mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectType: netbox.DcimDeviceObjectType,
+		Params:     map[string]string{"q": "router01", "site__name": "undefined"},
+	}).Return(&netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: netbox.DcimDeviceObjectType, Object: &netbox.DcimDeviceDataWrapper{Device: activeDevice}}, nil)
+	mockClient.EXPECT().RetrieveObjectState(context.Background(), netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectType: netbox.DcimDeviceObjectType,
+		ObjectID:   1,
+	}).Return(&netboxdiodeplugin.ObjectState{ObjectID: 1, ObjectType: netbox.DcimDeviceObjectType, Object: &netbox.DcimDeviceDataWrapper{Device: offlineDevice}}, nil)
+
+	cs, err := changeset.PrepareStale(netbox.DcimDeviceObjectType, map[string]string{"q": "router01", "site__name": "undefined"}, changeset.StaleActionOffline, "stale", mockClient)
+	require.NoError(t, err)
+
+	// the change set is reverted as stored in the ingestion logs
+	b, err := json.Marshal(cs)
+	require.NoError(t, err)
+
+	var stored changeset.ChangeSet
+	require.NoError(t, json.Unmarshal(b, &stored))
+
+	revertCS, err := changeset.PrepareRevert(&stored, mockClient)
+	require.NoError(t, err)
+	require.Len(t, revertCS.ChangeSet, 1)
+	assert.Equal(t, changeset.ChangeTypeUpdate, revertCS.ChangeSet[0].ChangeType)
+	assert.Equal(t, intPtr(1), revertCS.ChangeSet[0].ObjectID)
+	assert.Equal(t, map[string]any{"id": 1, "status": "active"}, revertCS.ChangeSet[0].Data)
+}
+
+func TestParseStaleAction(t *testing.T) {
+	for _, s := range []string{"tag", "offline", "decommissioning"} {
+		action, err := changeset.ParseStaleAction(s)
+		require.NoError(t, err)
+		assert.Equal(t, changeset.StaleAction(s), action)
+	}
+
+	_, err := changeset.ParseStaleAction("delete")
+	require.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package changeset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestPrepareWithTagPolicy(t *testing.T) {
+	managed := &netbox.Tag{ID: 1, Name: "managed", Slug: "managed"}
+	diodeCore := &netbox.Tag{ID: 2, Name: "diode:core", Slug: "diode-core"}
+	diodeEdge := &netbox.Tag{ID: 3, Name: "diode:edge", Slug: "diode-edge"}
+	existingTags := []*netbox.Tag{managed, diodeCore, diodeEdge}
+
+	siteEntity := func(tags ...string) changeset.IngestEntity {
+		site := &diodepb.Site{Name: "Site A"}
+		for _, tag := range tags {
+			site.Tags = append(site.Tags, &diodepb.Tag{Name: tag})
+		}
+		return changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "dcim.site",
+			Entity:    &diodepb.Entity{Entity: &diodepb.Entity_Site{Site: site}},
+		}
+	}
+
+	existingSite := func() *netbox.DcimSite {
+		return &netbox.DcimSite{
+			ID:     1,
+			Name:   "Site A",
+			Slug:   "site-a",
+			Status: (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+			Tags:   []*netbox.Tag{managed, diodeCore, diodeEdge},
+		}
+	}
+
+	undefinedManufacturer := &netbox.DcimManufacturer{ID: 1, Name: "undefined", Slug: "undefined"}
+	undefinedDeviceType := &netbox.DcimDeviceType{ID: 1, Model: "undefined", Slug: "undefined", Manufacturer: undefinedManufacturer}
+	undefinedRole := &netbox.DcimDeviceRole{ID: 1, Name: "undefined", Slug: "undefined", Color: strPtr("000000")}
+
+	tests := []struct {
+		name              string
+		ingestEntity      changeset.IngestEntity
+		policy            netbox.TagPolicy
+		existing          func() map[string]netbox.ComparableData
+		wantTags          []*netbox.Tag
+		wantChange        bool
+		wantClearedFields []string
+	}{
+		{
+			name:         "union - tags in NetBox kept",
+			ingestEntity: siteEntity("diode:core"),
+			policy:       netbox.TagPolicy{Strategy: netbox.TagMergeStrategyUnion},
+		},
+		{
+			name:         "replace - tags not ingested removed",
+			ingestEntity: siteEntity("diode:core"),
+			policy:       netbox.TagPolicy{Strategy: netbox.TagMergeStrategyReplace},
+			wantChange:   true,
+			wantTags:     []*netbox.Tag{diodeCore},
+		},
+		{
+			name:              "replace - no tags ingested - all tags removed",
+			ingestEntity:      siteEntity(),
+			policy:            netbox.TagPolicy{Strategy: netbox.TagMergeStrategyReplace},
+			wantChange:        true,
+			wantClearedFields: []string{"tags"},
+		},
+		{
+			name:         "managed prefix - managed tags not ingested removed",
+			ingestEntity: siteEntity("diode:core"),
+			policy:       netbox.TagPolicy{Strategy: netbox.TagMergeStrategyManagedPrefix, Prefix: "diode:"},
+			wantChange:   true,
+			wantTags:     []*netbox.Tag{managed, diodeCore},
+		},
+		{
+			name:         "managed prefix - all managed tags ingested",
+			ingestEntity: siteEntity("diode:edge", "diode:core"),
+			policy:       netbox.TagPolicy{Strategy: netbox.TagMergeStrategyManagedPrefix, Prefix: "diode:"},
+		},
+		{
+			name: "replace - tags of nested objects kept",
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{Name: "router01", Site: &diodepb.Site{Name: "Site A"}},
+					},
+				},
+			},
+			policy: netbox.TagPolicy{Strategy: netbox.TagMergeStrategyReplace},
+			existing: func() map[string]netbox.ComparableData {
+				return map[string]netbox.ComparableData{
+					netbox.DcimManufacturerObjectType: &netbox.DcimManufacturerDataWrapper{Manufacturer: undefinedManufacturer},
+					netbox.DcimDeviceTypeObjectType:   &netbox.DcimDeviceTypeDataWrapper{DeviceType: undefinedDeviceType},
+					netbox.DcimDeviceRoleObjectType:   &netbox.DcimDeviceRoleDataWrapper{DeviceRole: undefinedRole},
+					netbox.DcimDeviceObjectType: &netbox.DcimDeviceDataWrapper{
+						Device: &netbox.DcimDevice{
+							ID:         1,
+							Name:       "router01",
+							Site:       existingSite(),
+							DeviceType: undefinedDeviceType,
+							Role:       undefinedRole,
+							Status:     (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+						},
+					},
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockClient)
+
+			existing := map[string]netbox.ComparableData{}
+			if tt.existing != nil {
+				existing = tt.existing()
+			}
+			existing[netbox.DcimSiteObjectType] = &netbox.DcimSiteDataWrapper{Site: existingSite()}
+
+			mockClient.EXPECT().RetrieveObjectState(context.Background(), mock.Anything).RunAndReturn(func(_ context.Context, params netboxdiodeplugin.RetrieveObjectStateQueryParams) (*netboxdiodeplugin.ObjectState, error) {
+				if params.ObjectType == netbox.ExtrasTagObjectType {
+					for _, tag := range existingTags {
+						if tag.Name == params.Params["q"] {
+							return &netboxdiodeplugin.ObjectState{ObjectID: tag.ID, ObjectType: params.ObjectType, Object: &netbox.TagDataWrapper{Tag: tag}}, nil
+						}
+					}
+				}
+				if obj, ok := existing[params.ObjectType]; ok {
+					return &netboxdiodeplugin.ObjectState{ObjectID: obj.ID(), ObjectType: params.ObjectType, Object: obj}, nil
+				}
+				dw, err := netbox.NewDataWrapper(params.ObjectType)
+				if err != nil {
+					return nil, err
+				}
+				return &netboxdiodeplugin.ObjectState{ObjectType: params.ObjectType, Object: dw}, nil
+			}).Maybe()
+
+			cs, err := changeset.Prepare(tt.ingestEntity, mockClient, changeset.WithTagPolicy(tt.policy))
+			require.NoError(t, err)
+
+			if !tt.wantChange {
+				assert.Empty(t, cs.ChangeSet)
+				return
+			}
+
+			require.Len(t, cs.ChangeSet, 1)
+			assert.Equal(t, changeset.ChangeTypeUpdate, cs.ChangeSet[0].ChangeType)
+
+			site, ok := cs.ChangeSet[0].Data.(*netbox.DcimSite)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantTags, site.Tags)
+			assert.Equal(t, tt.wantClearedFields, cs.ChangeSet[0].ClearedFields)
+		})
+	}
+}
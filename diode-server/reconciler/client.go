@@ -39,6 +39,12 @@ type Client interface {
 
 	// RetrieveIngestionLogs retrieves ingestion logs
 	RetrieveIngestionLogs(ctx context.Context, req *pb.RetrieveIngestionLogsRequest, opt ...grpc.CallOption) (*pb.RetrieveIngestionLogsResponse, error)
+
+	// RevertChangeSet reverts applied change sets
+	RevertChangeSet(ctx context.Context, req *pb.RevertChangeSetRequest, opt ...grpc.CallOption) (*pb.RevertChangeSetResponse, error)
+
+	// WatchIngestionLogs streams the ingestion logs written
+	WatchIngestionLogs(ctx context.Context, req *pb.WatchIngestionLogsRequest, opt ...grpc.CallOption) (pb.ReconcilerService_WatchIngestionLogsClient, error)
 }
 
 // GRPCClient is a gRPC implementation of the distributor service
@@ -70,6 +76,16 @@ func (g *GRPCClient) RetrieveIngestionLogs(ctx context.Context, req *pb.Retrieve
 	return g.client.RetrieveIngestionLogs(ctx, req, opt...)
 }
 
+// RevertChangeSet reverts applied change sets
+func (g *GRPCClient) RevertChangeSet(ctx context.Context, req *pb.RevertChangeSetRequest, opt ...grpc.CallOption) (*pb.RevertChangeSetResponse, error) {
+	return g.client.RevertChangeSet(ctx, req, opt...)
+}
+
+// WatchIngestionLogs streams the ingestion logs written
+func (g *GRPCClient) WatchIngestionLogs(ctx context.Context, req *pb.WatchIngestionLogsRequest, opt ...grpc.CallOption) (pb.ReconcilerService_WatchIngestionLogsClient, error) {
+	return g.client.WatchIngestionLogs(ctx, req, opt...)
+}
+
 // NewClient creates a new reconciler client based on gRPC
 func NewClient() (Client, error) {
 	dialOpts := []grpc.DialOption{
@@ -1,5 +1,7 @@
 package reconciler
 
+import "time"
+
 // Config is the configuration for the reconciler service
 type Config struct {
 	GRPCPort         int    `envconfig:"GRPC_PORT" default:"8081"`
@@ -10,6 +12,61 @@ type Config struct {
 	RedisStreamDB    int    `envconfig:"REDIS_STREAM_DB" default:"1"`
 	MigrationEnabled bool   `envconfig:"MIGRATION_ENABLED" default:"true"`
 
+	// Interface matching
+	InterfaceMACAddressMatchingEnabled bool `envconfig:"INTERFACE_MAC_ADDRESS_MATCHING_ENABLED" default:"false"`
+
+	// Out-of-order observations
+	StaleObservationSkippingEnabled bool          `envconfig:"STALE_OBSERVATION_SKIPPING_ENABLED" default:"true"`
+	StaleObservationTTL             time.Duration `envconfig:"STALE_OBSERVATION_TTL" default:"168h"`
+
+	// Optimistic concurrency
+	ChangeSetConflictMaxRetries int `envconfig:"CHANGE_SET_CONFLICT_MAX_RETRIES" default:"3"`
+
+	// Object state cache
+	ObjectStateCacheEnabled      bool          `envconfig:"OBJECT_STATE_CACHE_ENABLED" default:"false"`
+	ObjectStateCacheSize         int           `envconfig:"OBJECT_STATE_CACHE_SIZE" default:"10000"`
+	ObjectStateCacheTTL          time.Duration `envconfig:"OBJECT_STATE_CACHE_TTL" default:"1m"`
+	ObjectStateCacheRedisEnabled bool          `envconfig:"OBJECT_STATE_CACHE_REDIS_ENABLED" default:"false"`
+
+	// Field ownership
+	FieldOwnershipConfigFile string `envconfig:"FIELD_OWNERSHIP_CONFIG_FILE" default:""`
+
+	// Tag policies
+	TagPoliciesConfigFile string `envconfig:"TAG_POLICIES_CONFIG_FILE" default:""`
+
+	// Device and virtual machine matching
+	MatchingConfigFile string `envconfig:"MATCHING_CONFIG_FILE" default:""`
+
+	// Interface naming
+	InterfaceNamingConfigFile string `envconfig:"INTERFACE_NAMING_CONFIG_FILE" default:""`
+
+	// Interface type inference
+	InterfaceTypeInferenceEnabled bool   `envconfig:"INTERFACE_TYPE_INFERENCE_ENABLED" default:"false"`
+	InterfaceTypeRulesFile        string `envconfig:"INTERFACE_TYPE_RULES_FILE" default:""`
+
+	// Device type library
+	DeviceTypeLibraryPath string `envconfig:"DEVICE_TYPE_LIBRARY_PATH" default:""`
+
+	// Parent prefixes
+	ParentPrefixCreationEnabled bool   `envconfig:"PARENT_PREFIX_CREATION_ENABLED" default:"false"`
+	ParentPrefixStatus          string `envconfig:"PARENT_PREFIX_STATUS" default:""`
+
+	// Transformations
+	TransformationRulesFile string `envconfig:"TRANSFORMATION_RULES_FILE" default:""`
+
+	// Filtering
+	FilteringPoliciesFile           string        `envconfig:"FILTERING_POLICIES_FILE" default:""`
+	FilteringPoliciesReloadInterval time.Duration `envconfig:"FILTERING_POLICIES_RELOAD_INTERVAL" default:"30s"`
+
+	// Stale object detection
+	StaleObjectDetectionEnabled  bool          `envconfig:"STALE_OBJECT_DETECTION_ENABLED" default:"false"`
+	StaleObjectMaxMissedCycles   int           `envconfig:"STALE_OBJECT_MAX_MISSED_CYCLES" default:"3"`
+	StaleObjectMaxAge            time.Duration `envconfig:"STALE_OBJECT_MAX_AGE" default:"0"`
+	StaleObjectAction            string        `envconfig:"STALE_OBJECT_ACTION" default:"tag"`
+	StaleObjectTag               string        `envconfig:"STALE_OBJECT_TAG" default:"stale"`
+	StaleObjectDiscoveryCycleGap time.Duration `envconfig:"STALE_OBJECT_DISCOVERY_CYCLE_GAP" default:"1m"`
+	StaleObjectSweepInterval     time.Duration `envconfig:"STALE_OBJECT_SWEEP_INTERVAL" default:"5m"`
+
 	// API keys
 	DiodeToNetBoxAPIKey        string `envconfig:"DIODE_TO_NETBOX_API_KEY" required:"true"`
 	NetBoxToDiodeAPIKey        string `envconfig:"NETBOX_TO_DIODE_API_KEY" required:"true"`
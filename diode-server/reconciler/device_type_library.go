@@ -0,0 +1,114 @@
+package reconciler
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// deviceTypeLibraryFile is the part of a devicetype-library device type definition device types are matched by
+type deviceTypeLibraryFile struct {
+	Manufacturer string `yaml:"manufacturer"`
+	Model        string `yaml:"model"`
+	Slug         string `yaml:"slug"`
+	PartNumber   string `yaml:"part_number"`
+}
+
+// deviceTypeLibraryVendorFile is a devicetype-library manufacturer definition
+type deviceTypeLibraryVendorFile struct {
+	Name string `yaml:"name"`
+	Slug string `yaml:"slug"`
+}
+
+// LoadDeviceTypeLibrary loads the device type definitions of a local copy of the NetBox community devicetype-library,
+// from its device-types directory if any, and of any directory of YAML files in the same format otherwise. The slugs
+// of manufacturers are read from the vendors directory of the library, if any
+func LoadDeviceTypeLibrary(path string) (*netbox.DeviceTypeLibrary, error) {
+	root := path
+	if info, err := os.Stat(filepath.Join(path, "device-types")); err == nil && info.IsDir() {
+		root = filepath.Join(path, "device-types")
+	}
+
+	manufacturerSlugs, err := loadDeviceTypeLibraryVendors(filepath.Join(path, "vendors"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load device type library: %v", err)
+	}
+
+	entries := make([]netbox.DeviceTypeLibraryEntry, 0)
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || (filepath.Ext(p) != ".yaml" && filepath.Ext(p) != ".yml") {
+			return nil
+		}
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		var f deviceTypeLibraryFile
+		if err := yaml.Unmarshal(b, &f); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %v", p, err)
+		}
+
+		entries = append(entries, netbox.DeviceTypeLibraryEntry{
+			Manufacturer:     f.Manufacturer,
+			ManufacturerSlug: manufacturerSlugs[f.Manufacturer],
+			Model:            f.Model,
+			Slug:             f.Slug,
+			PartNumber:       f.PartNumber,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load device type library: %v", err)
+	}
+
+	return netbox.NewDeviceTypeLibrary(entries), nil
+}
+
+// loadDeviceTypeLibraryVendors returns the slugs of the manufacturers defined in the vendors directory of a
+// devicetype-library, by manufacturer name, none if there is no such directory
+func loadDeviceTypeLibraryVendors(path string) (map[string]string, error) {
+	slugs := make(map[string]string)
+
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		return slugs, nil
+	}
+
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || (filepath.Ext(p) != ".yaml" && filepath.Ext(p) != ".yml") {
+			return nil
+		}
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		var f deviceTypeLibraryVendorFile
+		if err := yaml.Unmarshal(b, &f); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %v", p, err)
+		}
+
+		if f.Name != "" && f.Slug != "" {
+			slugs[f.Name] = f.Slug
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slugs, nil
+}
@@ -0,0 +1,135 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestLoadDeviceTypeLibrary(t *testing.T) {
+	tests := []struct {
+		name      string
+		root      string
+		files     map[string]string
+		wantLen   int
+		wantMatch map[string]string
+		wantSlugs map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "devicetype-library repository",
+			files: map[string]string{
+				"device-types/Cisco/WS-C3850-48P.yaml": `
+manufacturer: Cisco
+model: Catalyst 3850-48P
+slug: cisco-ws-c3850-48p
+part_number: WS-C3850-48P
+interfaces:
+  - name: GigabitEthernet1/0/1
+    type: 1000base-t
+`,
+				"device-types/Juniper/MX204.yml": `
+manufacturer: Juniper
+model: MX204
+slug: juniper-mx204
+`,
+				"module-types/Cisco/C3850-NM-4-10G.yaml": `
+manufacturer: Cisco
+model: C3850-NM-4-10G
+`,
+				"device-types/HPE/JL075A.yaml": `
+manufacturer: HPE
+model: Aruba 3810M 16SFP+ 2-slot
+part_number: JL075A
+`,
+				"vendors/hpe.yaml": `
+name: HPE
+slug: hpe
+`,
+				"vendors/cisco.yaml": `
+name: Cisco
+slug: cisco-systems
+`,
+				"README.md": "# devicetype-library",
+			},
+			wantLen: 3,
+			wantMatch: map[string]string{
+				"WS-C3850-48P": "Catalyst 3850-48P",
+				"MX204":        "MX204",
+				"JL075A":       "Aruba 3810M 16SFP+ 2-slot",
+			},
+			wantSlugs: map[string]string{
+				"WS-C3850-48P": "cisco-systems",
+				"MX204":        "juniper",
+				"JL075A":       "hpe",
+			},
+		},
+		{
+			name: "directory of device types",
+			files: map[string]string{
+				"Cisco/WS-C3850-48P.yaml": `
+manufacturer: Cisco
+model: Catalyst 3850-48P
+part_number: WS-C3850-48P
+`,
+			},
+			wantLen: 1,
+			wantMatch: map[string]string{
+				"WS-C3850-48P": "Catalyst 3850-48P",
+			},
+		},
+		{
+			name: "invalid vendor",
+			files: map[string]string{
+				"vendors/cisco.yaml": "name: [Cisco",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid device type",
+			files: map[string]string{
+				"device-types/Cisco/WS-C3850-48P.yaml": "manufacturer: [Cisco",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing directory",
+			root:    "missing",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+
+			lib, err := reconciler.LoadDeviceTypeLibrary(filepath.Join(dir, tt.root))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantLen, lib.Len())
+			for model, wantModel := range tt.wantMatch {
+				entry := lib.Match("", model)
+				require.NotNil(t, entry, model)
+				assert.Equal(t, wantModel, entry.Model)
+			}
+			for model, wantSlug := range tt.wantSlugs {
+				entry := lib.Match("", model)
+				require.NotNil(t, entry, model)
+				assert.Equal(t, wantSlug, entry.ManufacturerSlug)
+			}
+		})
+	}
+}
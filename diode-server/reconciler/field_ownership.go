@@ -0,0 +1,82 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// FieldOwnershipConfig is the field ownership configuration
+//
+// Default holds the field ownership policies per object type applied to all data sources, DataSources holds
+// policies per producer app name and object type overriding the default ones field by field
+type FieldOwnershipConfig struct {
+	Default     map[string]netbox.FieldOwnershipPolicy            `yaml:"default"`
+	DataSources map[string]map[string]netbox.FieldOwnershipPolicy `yaml:"data_sources"`
+}
+
+// LoadFieldOwnershipConfig loads and validates a field ownership configuration file
+func LoadFieldOwnershipConfig(path string) (*FieldOwnershipConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read field ownership config: %v", err)
+	}
+
+	var cfg FieldOwnershipConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal field ownership config: %v", err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid field ownership config: %v", err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *FieldOwnershipConfig) validate() error {
+	errs := make([]error, 0)
+
+	for objectType, policy := range c.Default {
+		if err := netbox.ValidateFieldOwnershipPolicy(objectType, policy); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for producerAppName, policies := range c.DataSources {
+		for objectType, policy := range policies {
+			if err := netbox.ValidateFieldOwnershipPolicy(objectType, policy); err != nil {
+				errs = append(errs, fmt.Errorf("data source %s: %w", producerAppName, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Policies returns the field ownership policies per object type of a data source
+func (c *FieldOwnershipConfig) Policies(producerAppName string) map[string]netbox.FieldOwnershipPolicy {
+	policies := make(map[string]netbox.FieldOwnershipPolicy)
+
+	for objectType, policy := range c.Default {
+		policies[objectType] = make(netbox.FieldOwnershipPolicy, len(policy))
+		for field, ownership := range policy {
+			policies[objectType][field] = ownership
+		}
+	}
+
+	for objectType, policy := range c.DataSources[producerAppName] {
+		if _, ok := policies[objectType]; !ok {
+			policies[objectType] = make(netbox.FieldOwnershipPolicy, len(policy))
+		}
+		for field, ownership := range policy {
+			policies[objectType][field] = ownership
+		}
+	}
+
+	return policies
+}
@@ -0,0 +1,109 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestLoadFieldOwnershipConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       string
+		wantPolicies map[string]map[string]netbox.FieldOwnershipPolicy
+		wantErr      bool
+	}{
+		{
+			name: "default and data source policies merged field by field",
+			config: `
+default:
+  dcim.device:
+    description: never_touch
+    serial: fill_if_empty
+data_sources:
+  orb-agent:
+    dcim.device:
+      serial: authoritative
+    dcim.site:
+      comments: never_touch
+`,
+			wantPolicies: map[string]map[string]netbox.FieldOwnershipPolicy{
+				"orb-agent": {
+					"dcim.device": {
+						"description": netbox.FieldOwnershipNeverTouch,
+						"serial":      netbox.FieldOwnershipAuthoritative,
+					},
+					"dcim.site": {
+						"comments": netbox.FieldOwnershipNeverTouch,
+					},
+				},
+				"other-producer": {
+					"dcim.device": {
+						"description": netbox.FieldOwnershipNeverTouch,
+						"serial":      netbox.FieldOwnershipFillIfEmpty,
+					},
+				},
+			},
+		},
+		{
+			name: "unknown object type",
+			config: `
+default:
+  dcim.unknown:
+    description: never_touch
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			config: `
+default:
+  dcim.device:
+    unknown: never_touch
+`,
+			wantErr: true,
+		},
+		{
+			name: "nested object field",
+			config: `
+data_sources:
+  orb-agent:
+    dcim.device:
+      site: never_touch
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid ownership",
+			config: `
+default:
+  dcim.device:
+    description: sometimes
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "field_ownership.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			cfg, err := reconciler.LoadFieldOwnershipConfig(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for producerAppName, wantPolicies := range tt.wantPolicies {
+				assert.Equal(t, wantPolicies, cfg.Policies(producerAppName))
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+)
+
+// FilteringAction is the action of a filtering policy on the ingested entities it matches
+type FilteringAction string
+
+const (
+	// FilteringActionAllow keeps the entities
+	FilteringActionAllow FilteringAction = "allow"
+
+	// FilteringActionDeny drops the entities
+	FilteringActionDeny FilteringAction = "deny"
+)
+
+// FilteringPoliciesConfig is the filtering configuration, the first of its policies matching an ingested entity
+// deciding whether it is kept or dropped, DefaultAction deciding for entities no policy matches (allow by default)
+type FilteringPoliciesConfig struct {
+	DefaultAction FilteringAction         `yaml:"default_action"`
+	Policies      []FilteringPolicyConfig `yaml:"policies"`
+}
+
+// FilteringPolicyConfig is a filtering policy. ProducerAppName, Stream and DataType restrict the entities the policy
+// applies to, Match maps dotted paths of fields of the object of the entity (named as in the diode protobuf messages,
+// e.g. site.name for a device) to regular expressions their value must match, When is a CEL condition on the entity.
+// Action is deny by default.
+type FilteringPolicyConfig struct {
+	Name            string            `yaml:"name"`
+	Action          FilteringAction   `yaml:"action"`
+	ProducerAppName string            `yaml:"producer_app_name"`
+	Stream          string            `yaml:"stream"`
+	DataType        string            `yaml:"data_type"`
+	Match           map[string]string `yaml:"match"`
+	When            string            `yaml:"when"`
+	Reason          string            `yaml:"reason"`
+}
+
+// FilteringPolicies are the filtering policies dropping unwanted ingested entities before reconciliation
+type FilteringPolicies struct {
+	defaultAction FilteringAction
+	policies      []*filteringPolicy
+}
+
+type filteringPolicy struct {
+	FilteringPolicyConfig
+
+	match []fieldMatch
+	when  cel.Program
+}
+
+// fieldMatch is a field of an entity and the regular expression its value must match
+type fieldMatch struct {
+	field   string
+	path    []string
+	pattern *regexp.Regexp
+}
+
+// LoadFilteringPolicies loads a filtering policies file
+func LoadFilteringPolicies(path string) (*FilteringPolicies, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read filtering policies: %v", err)
+	}
+
+	return parseFilteringPolicies(b)
+}
+
+func parseFilteringPolicies(b []byte) (*FilteringPolicies, error) {
+	var cfg FilteringPoliciesConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal filtering policies: %v", err)
+	}
+
+	return NewFilteringPolicies(cfg)
+}
+
+// NewFilteringPolicies validates and compiles filtering policies
+func NewFilteringPolicies(cfg FilteringPoliciesConfig) (*FilteringPolicies, error) {
+	env, err := newEntityExpressionEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create filtering policies environment: %v", err)
+	}
+
+	objectTypes := entityObjectTypes()
+
+	f := &FilteringPolicies{
+		defaultAction: cfg.DefaultAction,
+		policies:      make([]*filteringPolicy, 0, len(cfg.Policies)),
+	}
+	if f.defaultAction == "" {
+		f.defaultAction = FilteringActionAllow
+	}
+
+	errs := make([]error, 0)
+	if f.defaultAction != FilteringActionAllow && f.defaultAction != FilteringActionDeny {
+		errs = append(errs, fmt.Errorf("unknown default action %q", cfg.DefaultAction))
+	}
+
+	names := make(map[string]struct{})
+	for i, pc := range cfg.Policies {
+		policy, err := newFilteringPolicy(pc, env, objectTypes)
+		if err == nil {
+			if _, ok := names[pc.Name]; ok {
+				err = fmt.Errorf("duplicate name %q", pc.Name)
+			}
+			names[pc.Name] = struct{}{}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("policy %d: %w", i, err))
+			continue
+		}
+		f.policies = append(f.policies, policy)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid filtering policies: %v", err)
+	}
+
+	return f, nil
+}
+
+func newFilteringPolicy(cfg FilteringPolicyConfig, env *cel.Env, objectTypes map[string]protoreflect.MessageDescriptor) (*filteringPolicy, error) {
+	p := &filteringPolicy{FilteringPolicyConfig: cfg}
+
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if p.Action == "" {
+		p.Action = FilteringActionDeny
+	}
+	if p.Action != FilteringActionAllow && p.Action != FilteringActionDeny {
+		return nil, fmt.Errorf("unknown action %q", cfg.Action)
+	}
+
+	for field, pattern := range cfg.Match {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of field %s: %v", field, err)
+		}
+		p.match = append(p.match, fieldMatch{field: field, path: strings.Split(field, "."), pattern: re})
+	}
+
+	if cfg.When != "" {
+		prg, err := compileEntityExpression(env, cfg.When, true)
+		if err != nil {
+			return nil, fmt.Errorf("when: %w", err)
+		}
+		p.when = prg
+	}
+
+	if cfg.DataType != "" {
+		desc, ok := objectTypes[cfg.DataType]
+		if !ok {
+			return nil, fmt.Errorf("unknown data type %q", cfg.DataType)
+		}
+		if err := p.validate(desc); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// validate checks the matched fields exist in the object and hold values
+func (p *filteringPolicy) validate(desc protoreflect.MessageDescriptor) error {
+	for _, m := range p.match {
+		fields, err := resolveFieldPath(desc, m.path)
+		if err != nil {
+			return err
+		}
+		if fields[len(fields)-1].Kind() == protoreflect.MessageKind {
+			return fmt.Errorf("can't match field %s, a message", m.field)
+		}
+	}
+	return nil
+}
+
+// Filter returns whether the entity is dropped by the policies and why
+func (f *FilteringPolicies) Filter(producerAppName string, stream string, dataType string, entity *diodepb.Entity) (bool, string, error) {
+	if f == nil {
+		return false, "", nil
+	}
+
+	obj := entityObject(entity)
+	if obj == nil {
+		return false, "", nil
+	}
+
+	for _, p := range f.policies {
+		matched, err := p.matches(producerAppName, stream, dataType, obj)
+		if err != nil {
+			return false, "", fmt.Errorf("filtering policy %s: %v", p.Name, err)
+		}
+		if !matched {
+			continue
+		}
+
+		if p.Action == FilteringActionAllow {
+			return false, "", nil
+		}
+		if p.Reason != "" {
+			return true, p.Reason, nil
+		}
+		return true, fmt.Sprintf("denied by filtering policy %s", p.Name), nil
+	}
+
+	if f.defaultAction == FilteringActionDeny {
+		return true, "not allowed by any filtering policy", nil
+	}
+	return false, "", nil
+}
+
+func (p *filteringPolicy) matches(producerAppName string, stream string, dataType string, obj protoreflect.Message) (bool, error) {
+	if (p.ProducerAppName != "" && p.ProducerAppName != producerAppName) ||
+		(p.Stream != "" && p.Stream != stream) ||
+		(p.DataType != "" && p.DataType != dataType) {
+		return false, nil
+	}
+
+	// policies without data type only apply to entities having their fields
+	if p.validate(obj.Descriptor()) != nil {
+		return false, nil
+	}
+
+	for _, m := range p.match {
+		if !m.matches(obj, m.path) {
+			return false, nil
+		}
+	}
+
+	if p.when != nil {
+		out, _, err := p.when.Eval(map[string]any{
+			"entity":            obj.Interface(),
+			"producer_app_name": producerAppName,
+			"stream":            stream,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to evaluate condition: %v", err)
+		}
+		if out != types.True {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// matches returns whether the value of the field at path of msg matches, any element of repeated fields matching
+func (m fieldMatch) matches(msg protoreflect.Message, path []string) bool {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+
+	if fd.IsList() {
+		list := msg.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			if len(path) > 1 && m.matches(list.Get(i).Message(), path[1:]) {
+				return true
+			}
+			if len(path) == 1 && m.pattern.MatchString(fmt.Sprint(list.Get(i).Interface())) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(path) > 1 {
+		return m.matches(msg.Get(fd).Message(), path[1:])
+	}
+
+	return m.pattern.MatchString(fmt.Sprint(msg.Get(fd).Interface()))
+}
+
+// filterEntity returns whether an ingested entity is dropped by the filtering policies and why
+func (p *IngestionProcessor) filterEntity(ingestReq *diodepb.IngestRequest, dataType string, entity *diodepb.Entity) (bool, string, error) {
+	return p.filteringPolicies.Load().Filter(ingestReq.GetProducerAppName(), ingestReq.GetStream(), dataType, entity)
+}
+
+// runFilteringPoliciesReloader reloads the filtering policies when their file changes, the previous policies being
+// kept when the file is invalid
+func (p *IngestionProcessor) runFilteringPoliciesReloader(ctx context.Context, content []byte) {
+	ticker := time.NewTicker(p.config.FilteringPoliciesReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			content = p.reloadFilteringPolicies(content)
+		}
+	}
+}
+
+// reloadFilteringPolicies reloads the filtering policies if their file content differs from the given one and
+// returns the content of the policies in use
+func (p *IngestionProcessor) reloadFilteringPolicies(content []byte) []byte {
+	b, err := os.ReadFile(p.config.FilteringPoliciesFile)
+	if err != nil {
+		p.logger.Warn("failed to read filtering policies", "path", p.config.FilteringPoliciesFile, "error", err)
+		return content
+	}
+	if bytes.Equal(b, content) {
+		return content
+	}
+
+	policies, err := parseFilteringPolicies(b)
+	if err != nil {
+		p.logger.Warn("failed to reload filtering policies, keeping the previous ones", "path", p.config.FilteringPoliciesFile, "error", err)
+		return content
+	}
+
+	p.filteringPolicies.Store(policies)
+	p.logger.Info("reloaded filtering policies", "path", p.config.FilteringPoliciesFile, "policies", len(policies.policies))
+
+	return b
+}
@@ -0,0 +1,88 @@
+package reconciler
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+)
+
+func TestReloadFilteringPolicies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "filtering_policies.yaml")
+
+	dockerInterfaces := []byte(`
+policies:
+  - name: docker-interfaces
+    match:
+      name: ^docker
+`)
+	require.NoError(t, os.WriteFile(path, dockerInterfaces, 0o600))
+
+	policies, err := parseFilteringPolicies(dockerInterfaces)
+	require.NoError(t, err)
+
+	p := &IngestionProcessor{
+		config: Config{FilteringPoliciesFile: path},
+		logger: slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+	p.filteringPolicies.Store(policies)
+
+	ingestReq := &diodepb.IngestRequest{ProducerAppName: "orb-agent"}
+	docker := &diodepb.Entity{Entity: &diodepb.Entity_Interface{Interface: &diodepb.Interface{Name: "docker0"}}}
+	veth := &diodepb.Entity{Entity: &diodepb.Entity_Interface{Interface: &diodepb.Interface{Name: "veth1a2b"}}}
+
+	filtered, _, err := p.filterEntity(ingestReq, "dcim.interface", veth)
+	require.NoError(t, err)
+	assert.False(t, filtered)
+
+	// unchanged file
+	content := p.reloadFilteringPolicies(dockerInterfaces)
+	assert.Equal(t, dockerInterfaces, content)
+	assert.Same(t, policies, p.filteringPolicies.Load())
+
+	// changed file
+	virtualInterfaces := []byte(`
+policies:
+  - name: virtual-interfaces
+    match:
+      name: ^(docker|veth)
+`)
+	require.NoError(t, os.WriteFile(path, virtualInterfaces, 0o600))
+
+	content = p.reloadFilteringPolicies(content)
+	assert.Equal(t, virtualInterfaces, content)
+
+	filtered, reason, err := p.filterEntity(ingestReq, "dcim.interface", veth)
+	require.NoError(t, err)
+	assert.True(t, filtered)
+	assert.Equal(t, "denied by filtering policy virtual-interfaces", reason)
+
+	// invalid file, previous policies kept
+	require.NoError(t, os.WriteFile(path, []byte(`
+policies:
+  - match:
+      name: ^docker
+`), 0o600))
+
+	content = p.reloadFilteringPolicies(content)
+	assert.Equal(t, virtualInterfaces, content)
+
+	filtered, _, err = p.filterEntity(ingestReq, "dcim.interface", docker)
+	require.NoError(t, err)
+	assert.True(t, filtered)
+
+	// missing file, previous policies kept
+	require.NoError(t, os.Remove(path))
+
+	content = p.reloadFilteringPolicies(content)
+	assert.Equal(t, virtualInterfaces, content)
+
+	filtered, _, err = p.filterEntity(ingestReq, "dcim.interface", docker)
+	require.NoError(t, err)
+	assert.True(t, filtered)
+}
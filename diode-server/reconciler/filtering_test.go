@@ -0,0 +1,284 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestFilteringPoliciesFilter(t *testing.T) {
+	policies := `
+policies:
+  - name: docker-interfaces
+    data_type: dcim.interface
+    match:
+      name: ^(docker\d+|br-[0-9a-f]+|veth.+)$
+    reason: docker bridge interface
+  - name: link-local-addresses
+    data_type: ipam.ipaddress
+    match:
+      address: ^(169\.254\.|fe80:)
+  - name: loopback-addresses
+    data_type: ipam.ipaddress
+    when: entity.address.startsWith("127.") || entity.address.startsWith("::1/")
+  - name: lab-devices-of-lab-stream
+    action: allow
+    stream: lab
+    match:
+      tags.name: ^lab$
+  - name: lab-devices
+    match:
+      tags.name: ^lab$
+`
+
+	interfaceEntity := func(name string) *diodepb.Entity {
+		return &diodepb.Entity{Entity: &diodepb.Entity_Interface{Interface: &diodepb.Interface{Name: name}}}
+	}
+	ipAddressEntity := func(address string) *diodepb.Entity {
+		return &diodepb.Entity{Entity: &diodepb.Entity_IpAddress{IpAddress: &diodepb.IPAddress{Address: address}}}
+	}
+	deviceEntity := func(tags ...string) *diodepb.Entity {
+		device := &diodepb.Device{Name: "router01"}
+		for _, tag := range tags {
+			device.Tags = append(device.Tags, &diodepb.Tag{Name: tag})
+		}
+		return &diodepb.Entity{Entity: &diodepb.Entity_Device{Device: device}}
+	}
+
+	tests := []struct {
+		name         string
+		policies     string
+		stream       string
+		dataType     string
+		entity       *diodepb.Entity
+		wantFiltered bool
+		wantReason   string
+	}{
+		{
+			name:         "docker bridge interface",
+			policies:     policies,
+			dataType:     "dcim.interface",
+			entity:       interfaceEntity("br-4f2a9c1e"),
+			wantFiltered: true,
+			wantReason:   "docker bridge interface",
+		},
+		{
+			name:     "physical interface",
+			policies: policies,
+			dataType: "dcim.interface",
+			entity:   interfaceEntity("GigabitEthernet0/1"),
+		},
+		{
+			name:         "link-local address",
+			policies:     policies,
+			dataType:     "ipam.ipaddress",
+			entity:       ipAddressEntity("fe80::1/64"),
+			wantFiltered: true,
+			wantReason:   "denied by filtering policy link-local-addresses",
+		},
+		{
+			name:         "loopback address",
+			policies:     policies,
+			dataType:     "ipam.ipaddress",
+			entity:       ipAddressEntity("127.0.0.1/8"),
+			wantFiltered: true,
+			wantReason:   "denied by filtering policy loopback-addresses",
+		},
+		{
+			name:     "other address",
+			policies: policies,
+			dataType: "ipam.ipaddress",
+			entity:   ipAddressEntity("192.168.0.1/24"),
+		},
+		{
+			name:         "lab device",
+			policies:     policies,
+			dataType:     "dcim.device",
+			entity:       deviceEntity("managed", "lab"),
+			wantFiltered: true,
+			wantReason:   "denied by filtering policy lab-devices",
+		},
+		{
+			name:     "lab device of lab stream",
+			policies: policies,
+			stream:   "lab",
+			dataType: "dcim.device",
+			entity:   deviceEntity("lab"),
+		},
+		{
+			name:     "device",
+			policies: policies,
+			dataType: "dcim.device",
+			entity:   deviceEntity("managed"),
+		},
+		{
+			name: "default deny",
+			policies: `
+default_action: deny
+policies:
+  - name: devices
+    action: allow
+    data_type: dcim.device
+`,
+			dataType:     "dcim.interface",
+			entity:       interfaceEntity("Gi0/1"),
+			wantFiltered: true,
+			wantReason:   "not allowed by any filtering policy",
+		},
+		{
+			name: "default deny - allowed",
+			policies: `
+default_action: deny
+policies:
+  - name: devices
+    action: allow
+    data_type: dcim.device
+`,
+			dataType: "dcim.device",
+			entity:   deviceEntity(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "filtering_policies.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.policies), 0o600))
+
+			filteringPolicies, err := reconciler.LoadFilteringPolicies(path)
+			require.NoError(t, err)
+
+			filtered, reason, err := filteringPolicies.Filter("orb-agent", tt.stream, tt.dataType, tt.entity)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantFiltered, filtered)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestLoadFilteringPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies string
+		wantErr  bool
+	}{
+		{
+			name: "valid policies",
+			policies: `
+default_action: allow
+policies:
+  - name: docker-interfaces
+    data_type: dcim.interface
+    match:
+      name: ^docker
+      device.name: ^k8s-
+  - name: lab
+    action: allow
+    when: stream == "lab"
+`,
+		},
+		{
+			name: "unknown default action",
+			policies: `
+default_action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			policies: `
+policies:
+  - data_type: dcim.interface
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			policies: `
+policies:
+  - name: interfaces
+    data_type: dcim.interface
+  - name: interfaces
+    data_type: virtualization.vminterface
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown action",
+			policies: `
+policies:
+  - name: interfaces
+    action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid pattern",
+			policies: `
+policies:
+  - name: interfaces
+    match:
+      name: (docker
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid condition",
+			policies: `
+policies:
+  - name: interfaces
+    when: entity.name.
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown data type",
+			policies: `
+policies:
+  - name: racks
+    data_type: dcim.rack
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			policies: `
+policies:
+  - name: interfaces
+    data_type: dcim.interface
+    match:
+      site.name: ^lab
+`,
+			wantErr: true,
+		},
+		{
+			name: "message field",
+			policies: `
+policies:
+  - name: interfaces
+    data_type: dcim.interface
+    match:
+      device: ^lab
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "filtering_policies.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.policies), 0o600))
+
+			_, err := reconciler.LoadFilteringPolicies(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
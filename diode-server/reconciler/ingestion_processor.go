@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"regexp"
 	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/andybalholm/brotli"
 	"github.com/kelseyhightower/envconfig"
@@ -34,6 +37,12 @@ const (
 	// RedisIngestEntityIndexName is the name of the redis index for ingest entities
 	RedisIngestEntityIndexName = "ingest-entity"
 
+	// RedisIngestionLogsChannel is the name of the redis channel ingestion logs are published to when written
+	RedisIngestionLogsChannel = "diode.ingestion-logs"
+
+	// RedisObjectStateCacheStatsKey is the key for the object state cache hits and misses of all the replicas
+	RedisObjectStateCacheStatsKey = "diode.object-state-cache-stats"
+
 	// RedisConsumerGroupExistsErrMsg is the error message returned by the redis client when the consumer group already exists
 	RedisConsumerGroupExistsErrMsg = "BUSYGROUP Consumer Group name already exists"
 )
@@ -49,7 +58,17 @@ type RedisClient interface {
 	Do(ctx context.Context, args ...interface{}) *redis.Cmd
 	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	HGet(ctx context.Context, key, field string) *redis.StringCmd
+	HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd
+	HSet(ctx context.Context, key string, values ...interface{}) *redis.IntCmd
+	HSetNX(ctx context.Context, key, field string, value interface{}) *redis.BoolCmd
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
+	HDel(ctx context.Context, key string, fields ...string) *redis.IntCmd
+	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
 	Pipeline() redis.Pipeliner
+	Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd
+	Subscribe(ctx context.Context, channels ...string) *redis.PubSub
 }
 
 // IngestionProcessor processes ingested data
@@ -60,6 +79,18 @@ type IngestionProcessor struct {
 	redisClient       RedisClient
 	redisStreamClient RedisClient
 	nbClient          netboxdiodeplugin.NetBoxAPI
+	objectStateCache  *netboxdiodeplugin.CachedClient
+	localStateCache   netboxdiodeplugin.ObjectStateCache
+	fieldOwnership    *FieldOwnershipConfig
+	tagPolicies       *TagPoliciesConfig
+	matching          *MatchingConfig
+	interfaceNaming   *netbox.InterfaceNaming
+	interfaceTypes    netbox.InterfaceTypeRules
+	deviceTypeLibrary *netbox.DeviceTypeLibrary
+	transformations   *Transformations
+
+	filteringPolicies        atomic.Pointer[FilteringPolicies]
+	filteringPoliciesContent []byte
 }
 
 // NewIngestionProcessor creates a new ingestion processor
@@ -67,6 +98,99 @@ func NewIngestionProcessor(ctx context.Context, logger *slog.Logger) (*Ingestion
 	var cfg Config
 	envconfig.MustProcess("", &cfg)
 
+	if cfg.StaleObjectDetectionEnabled {
+		if _, err := changeset.ParseStaleAction(cfg.StaleObjectAction); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.ParentPrefixCreationEnabled && cfg.ParentPrefixStatus != "" {
+		if err := (&netbox.IpamPrefix{Status: &cfg.ParentPrefixStatus}).Validate(); err != nil {
+			return nil, fmt.Errorf("invalid parent prefix status %q: %v", cfg.ParentPrefixStatus, err)
+		}
+	}
+
+	var fieldOwnership *FieldOwnershipConfig
+	if cfg.FieldOwnershipConfigFile != "" {
+		var err error
+		fieldOwnership, err = LoadFieldOwnershipConfig(cfg.FieldOwnershipConfigFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tagPolicies *TagPoliciesConfig
+	if cfg.TagPoliciesConfigFile != "" {
+		var err error
+		tagPolicies, err = LoadTagPoliciesConfig(cfg.TagPoliciesConfigFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var matching *MatchingConfig
+	if cfg.MatchingConfigFile != "" {
+		var err error
+		matching, err = LoadMatchingConfig(cfg.MatchingConfigFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var interfaceNaming *netbox.InterfaceNaming
+	if cfg.InterfaceNamingConfigFile != "" {
+		interfaceNamingConfig, err := LoadInterfaceNamingConfig(cfg.InterfaceNamingConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		interfaceNaming = interfaceNamingConfig.InterfaceNaming()
+	}
+
+	var interfaceTypes netbox.InterfaceTypeRules
+	if cfg.InterfaceTypeInferenceEnabled {
+		interfaceTypes = netbox.DefaultInterfaceTypeRules()
+		if cfg.InterfaceTypeRulesFile != "" {
+			var err error
+			interfaceTypes, err = LoadInterfaceTypeRules(cfg.InterfaceTypeRulesFile)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var deviceTypeLibrary *netbox.DeviceTypeLibrary
+	if cfg.DeviceTypeLibraryPath != "" {
+		var err error
+		deviceTypeLibrary, err = LoadDeviceTypeLibrary(cfg.DeviceTypeLibraryPath)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("loaded device type library", "path", cfg.DeviceTypeLibraryPath, "device_types", deviceTypeLibrary.Len())
+	}
+
+	var transformations *Transformations
+	if cfg.TransformationRulesFile != "" {
+		var err error
+		transformations, err = LoadTransformations(cfg.TransformationRulesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var filteringPolicies *FilteringPolicies
+	var filteringPoliciesContent []byte
+	if cfg.FilteringPoliciesFile != "" {
+		var err error
+		filteringPoliciesContent, err = os.ReadFile(cfg.FilteringPoliciesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read filtering policies: %v", err)
+		}
+		filteringPolicies, err = parseFilteringPolicies(filteringPoliciesContent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%s", cfg.RedisHost, cfg.RedisPort),
 		Password: cfg.RedisPassword,
@@ -92,11 +216,25 @@ func NewIngestionProcessor(ctx context.Context, logger *slog.Logger) (*Ingestion
 		return nil, fmt.Errorf("failed to get hostname: %v", err)
 	}
 
-	nbClient, err := netboxdiodeplugin.NewClient(logger, cfg.DiodeToNetBoxAPIKey)
+	var nbClient netboxdiodeplugin.NetBoxAPI
+	nbClient, err = netboxdiodeplugin.NewClient(logger, cfg.DiodeToNetBoxAPIKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create netbox diode plugin client: %v", err)
 	}
 
+	var objectStateCache *netboxdiodeplugin.CachedClient
+	var localStateCache netboxdiodeplugin.ObjectStateCache
+	if cfg.ObjectStateCacheEnabled {
+		// invalidations of the in-process cache are published to the other replicas to invalidate theirs
+		localStateCache = netboxdiodeplugin.NewLRUObjectStateCache(cfg.ObjectStateCacheSize)
+		caches := []netboxdiodeplugin.ObjectStateCache{netboxdiodeplugin.NewBroadcastObjectStateCache(localStateCache, redisClient)}
+		if cfg.ObjectStateCacheRedisEnabled {
+			caches = append(caches, netboxdiodeplugin.NewRedisObjectStateCache(redisClient, cfg.ObjectStateCacheTTL))
+		}
+		objectStateCache = netboxdiodeplugin.NewCachedClient(logger, nbClient, cfg.ObjectStateCacheTTL, caches...)
+		nbClient = objectStateCache
+	}
+
 	component := &IngestionProcessor{
 		config:            cfg,
 		logger:            logger,
@@ -104,7 +242,19 @@ func NewIngestionProcessor(ctx context.Context, logger *slog.Logger) (*Ingestion
 		redisClient:       redisClient,
 		redisStreamClient: redisStreamClient,
 		nbClient:          nbClient,
+		objectStateCache:  objectStateCache,
+		localStateCache:   localStateCache,
+		fieldOwnership:    fieldOwnership,
+		tagPolicies:       tagPolicies,
+		matching:          matching,
+		interfaceNaming:   interfaceNaming,
+		interfaceTypes:    interfaceTypes,
+		deviceTypeLibrary: deviceTypeLibrary,
+		transformations:   transformations,
+
+		filteringPoliciesContent: filteringPoliciesContent,
 	}
+	component.filteringPolicies.Store(filteringPolicies)
 
 	return component, nil
 }
@@ -124,9 +274,34 @@ func (p *IngestionProcessor) Start(ctx context.Context) error {
 		}
 	}
 
+	if p.config.StaleObjectDetectionEnabled {
+		go p.runStaleObjectSweeper(ctx)
+	}
+
+	if p.config.FilteringPoliciesFile != "" && p.config.FilteringPoliciesReloadInterval > 0 {
+		go p.runFilteringPoliciesReloader(ctx, p.filteringPoliciesContent)
+	}
+
+	if p.localStateCache != nil {
+		go p.watchObjectStateCacheInvalidations(ctx)
+	}
+
 	return p.consumeIngestionStream(ctx, redisStreamID, redisConsumerGroup, fmt.Sprintf("%s-%s", redisConsumerGroup, p.hostname))
 }
 
+// watchObjectStateCacheInvalidations invalidates the in-process object state cache on the invalidations published by
+// all the replicas, this one included
+func (p *IngestionProcessor) watchObjectStateCacheInvalidations(ctx context.Context) {
+	pubsub := p.redisClient.Subscribe(ctx, netboxdiodeplugin.RedisObjectStateCacheInvalidationsChannel)
+	defer func() {
+		_ = pubsub.Close()
+	}()
+
+	if err := netboxdiodeplugin.WatchObjectStateCacheInvalidations(ctx, p.logger, pubsub.Channel(), p.localStateCache); err != nil {
+		p.logger.Error("stopped watching object state cache invalidations", "error", err)
+	}
+}
+
 // Stop stops the component
 func (p *IngestionProcessor) Stop() error {
 	p.logger.Info("stopping component", "name", p.Name())
@@ -186,6 +361,16 @@ func (p *IngestionProcessor) handleStreamMessage(ctx context.Context, msg redis.
 
 	p.logger.Debug("handling ingest request", "request", ingestReq)
 
+	var discoveryCycle int64
+	if p.config.StaleObjectDetectionEnabled {
+		discoveryCycle, err = p.advanceDiscoveryCycle(ctx, ingestReq.GetProducerAppName(), ingestReq.GetStream(), int64(ingestionTs))
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	transactionEntities := make([]queuedEntity, 0)
+
 	for i, v := range ingestReq.GetEntities() {
 		if v.GetEntity() == nil {
 			errs = append(errs, fmt.Errorf("entity at index %d is nil", i))
@@ -200,7 +385,7 @@ func (p *IngestionProcessor) handleStreamMessage(ctx context.Context, msg redis.
 
 		ingestionLogID := ksuid.New().String()
 
-		key := fmt.Sprintf("ingest-entity:%s-%d-%s", objectType, ingestionTs, ingestionLogID)
+		key := ingestionLogKey(objectType, int64(ingestionTs), ingestionLogID)
 		p.logger.Debug("ingest entity key", "key", key)
 
 		ingestionLog := &reconcilerpb.IngestionLog{
@@ -216,58 +401,137 @@ func (p *IngestionProcessor) handleStreamMessage(ctx context.Context, msg redis.
 			State:              reconcilerpb.State_QUEUED,
 		}
 
-		if _, err = p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
-			errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
-			continue
-		}
+		// the ingestion log keeps the entity as ingested, along with the transformation rules applied to it
+		transformed, transformationRules, err := p.transformations.Apply(ingestReq.GetProducerAppName(), ingestReq.GetStream(), objectType, v)
 
 		ingestEntity := changeset.IngestEntity{
-			RequestID: ingestReq.GetId(),
-			DataType:  objectType,
-			Entity:    v,
-			State:     int(reconcilerpb.State_QUEUED),
+			RequestID:       ingestReq.GetId(),
+			ProducerAppName: ingestReq.GetProducerAppName(),
+			DataType:        objectType,
+			Entity:          transformed,
+			State:           int(reconcilerpb.State_QUEUED),
 		}
 
-		changeSet, err := p.reconcileEntity(ctx, ingestEntity)
+		// objects are part of the snapshot even if they fail to be transformed, are filtered or fail to reconcile, so
+		// they are not deleted
+		if ingestReq.GetSnapshot() != nil {
+			snapshotEntity := ingestEntity
+			if err != nil {
+				snapshotEntity.Entity = v
+			}
+			if err := p.markSnapshotObject(ctx, ingestReq.GetSnapshot(), snapshotEntity); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		var filtered bool
+		var filterReason string
+		if err == nil {
+			filtered, filterReason, err = p.filterEntity(ingestReq, objectType, transformed)
+		}
 		if err != nil {
 			errs = append(errs, err)
 
 			ingestionLog.State = reconcilerpb.State_FAILED
 			ingestionLog.Error = extractIngestionError(err)
 
-			if changeSet != nil {
-				ingestionLog.ChangeSet = &reconcilerpb.ChangeSet{Id: changeSet.ChangeSetID}
-				csCompressed, err := compressChangeSet(changeSet)
-				if err != nil {
-					errs = append(errs, err)
-				} else {
-					ingestionLog.ChangeSet.Data = csCompressed
-				}
+			if _, err = p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
+				errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
 			}
+			continue
+		}
+		ingestionLog.TransformationRules = transformationRules
+
+		if filtered {
+			p.logger.Debug("filtering entity", "key", key, "reason", filterReason)
+
+			ingestionLog.State = reconcilerpb.State_FILTERED
+			ingestionLog.FilterReason = filterReason
 
 			if _, err = p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
-				errs = append(errs, err)
+				errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
 			}
 			continue
 		}
 
-		if changeSet != nil {
-			ingestionLog.State = reconcilerpb.State_RECONCILED
-			ingestionLog.ChangeSet = &reconcilerpb.ChangeSet{Id: changeSet.ChangeSetID}
-			csCompressed, err := compressChangeSet(changeSet)
+		if _, err = p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
+			continue
+		}
+
+		var obs observation
+		if p.config.StaleObservationSkippingEnabled && transformed.GetTimestamp() != nil {
+			obs, err = p.newObservation(ingestEntity, transformed.GetTimestamp().AsTime().UnixNano())
 			if err != nil {
 				errs = append(errs, err)
-			} else {
-				ingestionLog.ChangeSet.Data = csCompressed
 			}
-		} else {
-			ingestionLog.State = reconcilerpb.State_NO_CHANGES
+
+			staleFields, err := p.claimObservation(ctx, &obs)
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			if isStaleObservation(obs, objectType, staleFields) {
+				p.logger.Debug("skipping stale observation", "key", key, "observed_ts", obs.observedTs)
+
+				if err := p.releaseObservation(ctx, obs); err != nil {
+					errs = append(errs, err)
+				}
+
+				ingestionLog.State = reconcilerpb.State_SKIPPED_STALE
+
+				if discoveryCycle > 0 {
+					if err := p.markObjectSeen(ctx, ingestReq.GetProducerAppName(), ingestReq.GetStream(), discoveryCycle, int64(ingestionTs), ingestEntity); err != nil {
+						errs = append(errs, err)
+					}
+				}
+
+				if _, err = p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
+					errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
+				}
+				continue
+			}
+
+			if len(staleFields) > 0 {
+				p.logger.Debug("leaving stale fields untouched", "key", key, "observed_ts", obs.observedTs, "fields", staleFields)
+				ingestEntity.StaleFields = staleFields
+			}
 		}
 
-		if _, err = p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
-			errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
+		entity := queuedEntity{
+			key:          key,
+			ingestionLog: ingestionLog,
+			ingestEntity: ingestEntity,
+			observation:  obs,
+		}
+
+		if ingestReq.GetTransactional() {
+			transactionEntities = append(transactionEntities, entity)
 			continue
 		}
+
+		changeSet, err := p.reconcileEntity(ctx, ingestEntity)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		errs = append(errs, p.completeEntity(ctx, ingestReq, entity, changeSet, err, discoveryCycle, int64(ingestionTs))...)
+	}
+
+	if len(transactionEntities) > 0 {
+		errs = append(errs, p.reconcileTransaction(ctx, ingestReq, transactionEntities, discoveryCycle, int64(ingestionTs))...)
+	}
+
+	if ingestReq.GetSnapshot() != nil {
+		if err := p.receiveSnapshotBatch(ctx, ingestReq, int64(ingestionTs)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if p.objectStateCache != nil {
+		if err := p.recordObjectStateCacheStats(ctx); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	p.redisStreamClient.XAck(ctx, redisStreamID, redisConsumerGroup, msg.ID)
@@ -292,6 +556,80 @@ func (p *IngestionProcessor) handleStreamMessage(ctx context.Context, msg redis.
 	return nil
 }
 
+// queuedEntity is an ingest entity queued for reconciliation along with its ingestion log
+type queuedEntity struct {
+	key          string
+	ingestionLog *reconcilerpb.IngestionLog
+	ingestEntity changeset.IngestEntity
+	observation  observation
+}
+
+// completeEntity records the outcome of the reconciliation of an entity in its ingestion log
+func (p *IngestionProcessor) completeEntity(ctx context.Context, ingestReq *diodepb.IngestRequest, entity queuedEntity, changeSet *changeset.ChangeSet, reconcileErr error, discoveryCycle int64, ingestionTs int64) []error {
+	errs := make([]error, 0)
+
+	ingestionLog := entity.ingestionLog
+
+	switch {
+	case reconcileErr != nil:
+		ingestionLog.State = reconcilerpb.State_FAILED
+		ingestionLog.Error = extractIngestionError(reconcileErr)
+	case changeSet != nil:
+		ingestionLog.State = reconcilerpb.State_RECONCILED
+	default:
+		ingestionLog.State = reconcilerpb.State_NO_CHANGES
+	}
+
+	if changeSet != nil {
+		ingestionLog.ChangeSet = &reconcilerpb.ChangeSet{Id: changeSet.ChangeSetID}
+		csCompressed, err := compressChangeSet(changeSet)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			ingestionLog.ChangeSet.Data = csCompressed
+		}
+	}
+
+	// the fields claimed by the observation stay claimed once applied
+	if reconcileErr != nil {
+		if err := p.releaseObservation(ctx, entity.observation); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if reconcileErr == nil {
+		if discoveryCycle > 0 {
+			if err := p.markObjectSeen(ctx, ingestReq.GetProducerAppName(), ingestReq.GetStream(), discoveryCycle, ingestionTs, entity.ingestEntity); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if _, err := p.writeIngestionLog(ctx, entity.key, ingestionLog); err != nil {
+		errs = append(errs, fmt.Errorf("failed to write JSON: %v", err))
+	}
+
+	return errs
+}
+
+// recordObjectStateCacheStats adds the object state cache hits and misses since they were last recorded to the ones
+// of all the replicas
+func (p *IngestionProcessor) recordObjectStateCacheStats(ctx context.Context) error {
+	stats := p.objectStateCache.TakeStats()
+	if stats.Hits == 0 && stats.Misses == 0 {
+		return nil
+	}
+
+	pipe := p.redisClient.Pipeline()
+	pipe.HIncrBy(ctx, RedisObjectStateCacheStatsKey, "hits", stats.Hits)
+	pipe.HIncrBy(ctx, RedisObjectStateCacheStatsKey, "misses", stats.Misses)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record object state cache stats: %v", err)
+	}
+
+	return nil
+}
+
 func extractIngestionError(err error) *reconcilerpb.IngestionError {
 	var ingestionErr *reconcilerpb.IngestionError
 	var applyChangeSetErr *netboxdiodeplugin.ApplyChangeSetError
@@ -309,8 +647,39 @@ func extractIngestionError(err error) *reconcilerpb.IngestionError {
 	return ingestionErr
 }
 
-func (p *IngestionProcessor) reconcileEntity(ctx context.Context, ingestEntity changeset.IngestEntity) (*changeset.ChangeSet, error) {
-	cs, err := changeset.Prepare(ingestEntity, p.nbClient)
+// changeSetOptions returns the options preparing the change set of an ingest entity
+func (p *IngestionProcessor) changeSetOptions(ingestEntity changeset.IngestEntity) []changeset.Option {
+	opts := []changeset.Option{changeset.WithInterfaceMACAddressMatching(p.config.InterfaceMACAddressMatchingEnabled)}
+	var fieldOwnership map[string]netbox.FieldOwnershipPolicy
+	if p.fieldOwnership != nil {
+		fieldOwnership = p.fieldOwnership.Policies(ingestEntity.ProducerAppName)
+	}
+	if fieldOwnership = withStaleFields(fieldOwnership, ingestEntity); fieldOwnership != nil {
+		opts = append(opts, changeset.WithFieldOwnership(fieldOwnership))
+	}
+	if p.tagPolicies != nil {
+		opts = append(opts, changeset.WithTagPolicy(p.tagPolicies.Policy(ingestEntity.ProducerAppName)))
+	}
+	if p.matching != nil {
+		opts = append(opts, changeset.WithMatchingStrategies(p.matching.Strategies(ingestEntity.ProducerAppName)))
+	}
+	if p.interfaceNaming != nil {
+		opts = append(opts, changeset.WithInterfaceNaming(p.interfaceNaming))
+	}
+	if p.interfaceTypes != nil {
+		opts = append(opts, changeset.WithInterfaceTypeRules(p.interfaceTypes))
+	}
+	if p.deviceTypeLibrary != nil {
+		opts = append(opts, changeset.WithDeviceTypeLibrary(p.deviceTypeLibrary))
+	}
+	if p.config.ParentPrefixCreationEnabled {
+		opts = append(opts, changeset.WithParentPrefixes(true, p.config.ParentPrefixStatus))
+	}
+	return opts
+}
+
+func (p *IngestionProcessor) prepareChangeSet(ingestEntity changeset.IngestEntity, nbClient netboxdiodeplugin.NetBoxAPI) (*changeset.ChangeSet, error) {
+	cs, err := changeset.Prepare(ingestEntity, nbClient, p.changeSetOptions(ingestEntity)...)
 	if err != nil {
 		tags := map[string]string{
 			"request_id": ingestEntity.RequestID,
@@ -323,11 +692,46 @@ func (p *IngestionProcessor) reconcileEntity(ctx context.Context, ingestEntity c
 		return nil, fmt.Errorf("failed to prepare change set: %v", err)
 	}
 
-	if len(cs.ChangeSet) == 0 {
-		p.logger.Debug("no changes to apply", "request_id", ingestEntity.RequestID)
-		return nil, nil
+	return cs, nil
+}
+
+func (p *IngestionProcessor) reconcileEntity(ctx context.Context, ingestEntity changeset.IngestEntity) (*changeset.ChangeSet, error) {
+	// the entity is re-planned when an object was modified in NetBox between preparing and applying its change set
+	for attempt := 0; ; attempt++ {
+		cs, err := p.prepareChangeSet(ingestEntity, p.nbClient)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cs.ChangeSet) == 0 {
+			p.logger.Debug("no changes to apply", "request_id", ingestEntity.RequestID)
+			return nil, nil
+		}
+
+		err = p.applyChangeSet(ctx, cs)
+		if err == nil {
+			return cs, nil
+		}
+
+		if !isObjectVersionConflict(err) || attempt >= p.config.ChangeSetConflictMaxRetries {
+			return cs, err
+		}
+
+		p.logger.Debug("object modified since change set was prepared, re-planning", "request_id", ingestEntity.RequestID, "change_set_id", cs.ChangeSetID, "attempt", attempt+1)
 	}
+}
+
+func isObjectVersionConflict(err error) bool {
+	var applyChangeSetErr *netboxdiodeplugin.ApplyChangeSetError
+	return errors.As(err, &applyChangeSetErr) && applyChangeSetErr.IsObjectVersionConflict()
+}
 
+func (p *IngestionProcessor) applyChangeSet(ctx context.Context, cs *changeset.ChangeSet) error {
+	return applyChangeSet(ctx, p.logger, p.nbClient, cs)
+}
+
+// applyChangeSet applies a change set to NetBox
+func applyChangeSet(ctx context.Context, logger *slog.Logger, nbClient netboxdiodeplugin.NetBoxAPI, cs *changeset.ChangeSet) error {
 	changes := make([]netboxdiodeplugin.Change, 0)
 	for _, change := range cs.ChangeSet {
 		changes = append(changes, netboxdiodeplugin.Change{
@@ -337,6 +741,7 @@ func (p *IngestionProcessor) reconcileEntity(ctx context.Context, ingestEntity c
 			ObjectID:      change.ObjectID,
 			ObjectVersion: change.ObjectVersion,
 			Data:          change.Data,
+			ClearedFields: change.ClearedFields,
 		})
 	}
 
@@ -345,16 +750,21 @@ func (p *IngestionProcessor) reconcileEntity(ctx context.Context, ingestEntity c
 		ChangeSet:   changes,
 	}
 
-	resp, err := p.nbClient.ApplyChangeSet(ctx, req)
+	resp, err := nbClient.ApplyChangeSet(ctx, req)
 	if err != nil {
-		return cs, err
+		return err
 	}
 
-	p.logger.Debug("apply change set response", "response", resp)
-	return cs, nil
+	logger.Debug("apply change set response", "response", resp)
+	return nil
 }
 
 func (p *IngestionProcessor) writeIngestionLog(ctx context.Context, key string, ingestionLog *reconcilerpb.IngestionLog) ([]byte, error) {
+	return writeIngestionLog(ctx, p.logger, p.redisClient, key, ingestionLog)
+}
+
+// writeIngestionLog stores an ingestion log under key and publishes it to watchers
+func writeIngestionLog(ctx context.Context, logger *slog.Logger, client RedisClient, key string, ingestionLog *reconcilerpb.IngestionLog) ([]byte, error) {
 	ingestionLogJSON, err := protojson.Marshal(ingestionLog)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal JSON: %v", err)
@@ -362,13 +772,23 @@ func (p *IngestionProcessor) writeIngestionLog(ctx context.Context, key string,
 
 	ingestionLogJSON = normalizeIngestionLog(ingestionLogJSON)
 
-	if _, err := p.redisClient.Do(ctx, "JSON.SET", key, "$", ingestionLogJSON).Result(); err != nil {
+	if _, err := client.Do(ctx, "JSON.SET", key, "$", ingestionLogJSON).Result(); err != nil {
 		return nil, fmt.Errorf("failed to set JSON redis key: %v", err)
 	}
 
+	// ingestion logs are published to watchers on a best-effort basis
+	if err := client.Publish(ctx, RedisIngestionLogsChannel, ingestionLogJSON).Err(); err != nil {
+		logger.Warn("failed to publish ingestion log", "key", key, "error", err)
+	}
+
 	return ingestionLogJSON, nil
 }
 
+// ingestionLogKey returns the key of the ingestion log of an entity
+func ingestionLogKey(objectType string, ingestionTs int64, id string) string {
+	return fmt.Sprintf("ingest-entity:%s-%d-%s", objectType, ingestionTs, id)
+}
+
 func normalizeIngestionLog(l []byte) []byte {
 	//replace ingestionTs string value as integer, see: https://github.com/golang/protobuf/issues/1414
 	re := regexp.MustCompile(`"ingestionTs":"(\d+)"`)
@@ -393,6 +813,20 @@ func compressChangeSet(cs *changeset.ChangeSet) ([]byte, error) {
 	return brotliBuf.Bytes(), nil
 }
 
+func decompressChangeSet(b []byte) (*changeset.ChangeSet, error) {
+	csJSON, err := io.ReadAll(brotli.NewReader(bytes.NewReader(b)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress changeset: %v", err)
+	}
+
+	var cs changeset.ChangeSet
+	if err := json.Unmarshal(csJSON, &cs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal changeset JSON: %v", err)
+	}
+
+	return &cs, nil
+}
+
 func extractObjectType(in *diodepb.Entity) (string, error) {
 	switch in.GetEntity().(type) {
 	case *diodepb.Entity_Device:
@@ -425,6 +859,16 @@ func extractObjectType(in *diodepb.Entity) (string, error) {
 		return netbox.VirtualizationVMInterfaceObjectType, nil
 	case *diodepb.Entity_VirtualDisk:
 		return netbox.VirtualizationVirtualDiskObjectType, nil
+	case *diodepb.Entity_WirelessLan:
+		return netbox.WirelessLANObjectType, nil
+	case *diodepb.Entity_WirelessLanGroup:
+		return netbox.WirelessLANGroupObjectType, nil
+	case *diodepb.Entity_WirelessLink:
+		return netbox.WirelessLinkObjectType, nil
+	case *diodepb.Entity_FhrpGroup:
+		return netbox.IpamFHRPGroupObjectType, nil
+	case *diodepb.Entity_FhrpGroupAssignment:
+		return netbox.IpamFHRPGroupAssignmentObjectType, nil
 	default:
 		return "", fmt.Errorf("unknown data type")
 	}
@@ -5,14 +5,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
+	"net/http"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/andybalholm/brotli"
 	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -28,10 +31,26 @@ import (
 
 func strPtr(s string) *string { return &s }
 
+// retrieveObjectStatesOneByOne serves the batch object state lookups of a mocked client with its single lookups
+func retrieveObjectStatesOneByOne(mockNbClient *mnp.NetBoxAPI) {
+	mockNbClient.EXPECT().RetrieveObjectStates(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, params []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+		objStates := make([]*netboxdiodeplugin.ObjectState, 0, len(params))
+		for _, p := range params {
+			objState, err := mockNbClient.RetrieveObjectState(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			objStates = append(objStates, objState)
+		}
+		return objStates, nil
+	}).Maybe()
+}
+
 func TestWriteIngestionLog(t *testing.T) {
 	tests := []struct {
 		name         string
 		ingestionLog *reconcilerpb.IngestionLog
+		publishError bool
 		hasError     bool
 		hasMock      bool
 	}{
@@ -51,6 +70,23 @@ func TestWriteIngestionLog(t *testing.T) {
 			hasError: false,
 			hasMock:  true,
 		},
+		{
+			name: "publish error - ingestion log written",
+			ingestionLog: &reconcilerpb.IngestionLog{
+				RequestId: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.site",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Site{
+						Site: &diodepb.Site{
+							Name: "Site A",
+						},
+					},
+				},
+			},
+			publishError: true,
+			hasError:     false,
+			hasMock:      true,
+		},
 		{
 			name: "redis error",
 			ingestionLog: &reconcilerpb.IngestionLog{
@@ -90,6 +126,13 @@ func TestWriteIngestionLog(t *testing.T) {
 			}
 			mockRedisClient.On("Do", ctx, "JSON.SET", "test-key", "$", mock.Anything).
 				Return(cmd)
+			if !tt.hasError {
+				publishCmd := redis.NewIntCmd(ctx)
+				if tt.publishError {
+					publishCmd.SetErr(errors.New("error"))
+				}
+				mockRedisClient.On("Publish", ctx, RedisIngestionLogsChannel, mock.Anything).Return(publishCmd)
+			}
 
 			// Call the method
 			_, err := p.writeIngestionLog(ctx, key, tt.ingestionLog)
@@ -158,6 +201,7 @@ func TestReconcileEntity(t *testing.T) {
 
 			// Mock nbClient
 			mockNbClient := new(mnp.NetBoxAPI)
+			retrieveObjectStatesOneByOne(mockNbClient)
 			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
 			// Create IngestionProcessor
 			p := &IngestionProcessor{
@@ -212,6 +256,100 @@ func TestReconcileEntity(t *testing.T) {
 	}
 }
 
+func TestReconcileEntityObjectVersionConflict(t *testing.T) {
+	conflictErr := netboxdiodeplugin.NewApplyChangeSetError("failed to apply change set", http.StatusConflict, netboxdiodeplugin.ChangeSetResponse{})
+
+	tests := []struct {
+		name          string
+		maxRetries    int
+		applyErrs     []error
+		expectedError bool
+	}{
+		{
+			name:          "object modified before apply - re-planned with the new object version",
+			maxRetries:    3,
+			applyErrs:     []error{conflictErr, nil},
+			expectedError: false,
+		},
+		{
+			name:          "object modified before every apply - retries exhausted",
+			maxRetries:    1,
+			applyErrs:     []error{conflictErr, conflictErr},
+			expectedError: true,
+		},
+		{
+			name:          "other apply error - not retried",
+			maxRetries:    3,
+			applyErrs:     []error{errors.New("apply error")},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockNbClient := mnp.NewNetBoxAPI(t)
+			retrieveObjectStatesOneByOne(mockNbClient)
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+			p := &IngestionProcessor{
+				config:   Config{ChangeSetConflictMaxRetries: tt.maxRetries},
+				nbClient: mockNbClient,
+				logger:   logger,
+			}
+
+			for i, applyErr := range tt.applyErrs {
+				objectVersion := 10 + i
+
+				mockNbClient.EXPECT().RetrieveObjectState(ctx, mock.Anything).Return(&netboxdiodeplugin.ObjectState{
+					ObjectID:       1,
+					ObjectType:     "dcim.site",
+					ObjectChangeID: objectVersion,
+					Object: &netbox.DcimSiteDataWrapper{
+						Site: &netbox.DcimSite{
+							ID:          1,
+							Name:        "Site A",
+							Slug:        "site-a",
+							Status:      (*netbox.DcimSiteStatus)(strPtr(string(netbox.DcimSiteStatusActive))),
+							Description: strPtr(fmt.Sprintf("edited %d times", i)),
+						},
+					},
+				}, nil).Once()
+
+				var resp *netboxdiodeplugin.ChangeSetResponse
+				if applyErr == nil {
+					resp = &netboxdiodeplugin.ChangeSetResponse{}
+				}
+				mockNbClient.EXPECT().ApplyChangeSet(ctx, mock.MatchedBy(func(req netboxdiodeplugin.ChangeSetRequest) bool {
+					return len(req.ChangeSet) == 1 && req.ChangeSet[0].ObjectVersion != nil && *req.ChangeSet[0].ObjectVersion == objectVersion
+				})).Return(resp, applyErr).Once()
+			}
+
+			ingestEntity := changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.site",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Site{
+						Site: &diodepb.Site{
+							Name:        "Site A",
+							Description: strPtr("ingested description"),
+						},
+					},
+				},
+			}
+
+			cs, err := p.reconcileEntity(ctx, ingestEntity)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, cs.ChangeSet, 1)
+			assert.Equal(t, 10+len(tt.applyErrs)-1, *cs.ChangeSet[0].ObjectVersion, "last prepared change set is sent with the last read object version")
+		})
+	}
+}
+
 func TestHandleStreamMessage(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -333,6 +471,7 @@ func TestHandleStreamMessage(t *testing.T) {
 			mockRedisClient := new(mr.RedisClient)
 			mockRedisStreamClient := new(mr.RedisClient)
 			mockNbClient := new(mnp.NetBoxAPI)
+			retrieveObjectStatesOneByOne(mockNbClient)
 			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
 
 			p := &IngestionProcessor{
@@ -379,6 +518,7 @@ func TestHandleStreamMessage(t *testing.T) {
 			mockNbClient.On("ApplyChangeSet", ctx, mock.Anything).Return(tt.changeSetResponse, tt.changeSetError)
 			if tt.entities[0].Entity != nil {
 				mockRedisClient.On("Do", ctx, "JSON.SET", mock.Anything, "$", mock.Anything).Return(redis.NewCmd(ctx))
+				mockRedisClient.On("Publish", ctx, RedisIngestionLogsChannel, mock.Anything).Return(redis.NewIntCmd(ctx))
 			}
 			mockRedisStreamClient.On("XAck", ctx, mock.Anything, mock.Anything, mock.Anything).Return(redis.NewIntCmd(ctx))
 			mockRedisStreamClient.On("XDel", ctx, mock.Anything, mock.Anything).Return(redis.NewIntCmd(ctx))
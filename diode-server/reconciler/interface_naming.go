@@ -0,0 +1,73 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// InterfaceNamingConfig is the interface naming configuration
+//
+// Default holds the naming convention applied to interfaces of devices and virtual machines of any platform and
+// manufacturer, Platforms and Manufacturers hold naming conventions per platform and manufacturer name
+type InterfaceNamingConfig struct {
+	Default       netbox.InterfaceNamingConvention            `yaml:"default"`
+	Platforms     map[string]netbox.InterfaceNamingConvention `yaml:"platforms"`
+	Manufacturers map[string]netbox.InterfaceNamingConvention `yaml:"manufacturers"`
+}
+
+// LoadInterfaceNamingConfig loads and validates an interface naming configuration file
+func LoadInterfaceNamingConfig(path string) (*InterfaceNamingConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read interface naming config: %v", err)
+	}
+
+	var cfg InterfaceNamingConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal interface naming config: %v", err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid interface naming config: %v", err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *InterfaceNamingConfig) validate() error {
+	errs := make([]error, 0)
+
+	if c.Default != "" {
+		if err := netbox.ValidateInterfaceNamingConvention(c.Default); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for platform, convention := range c.Platforms {
+		if err := netbox.ValidateInterfaceNamingConvention(convention); err != nil {
+			errs = append(errs, fmt.Errorf("platform %s: %w", platform, err))
+		}
+	}
+
+	for manufacturer, convention := range c.Manufacturers {
+		if err := netbox.ValidateInterfaceNamingConvention(convention); err != nil {
+			errs = append(errs, fmt.Errorf("manufacturer %s: %w", manufacturer, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// InterfaceNaming returns the naming conventions ingested interface names are canonicalised to
+func (c *InterfaceNamingConfig) InterfaceNaming() *netbox.InterfaceNaming {
+	return &netbox.InterfaceNaming{
+		Default:       c.Default,
+		Platforms:     c.Platforms,
+		Manufacturers: c.Manufacturers,
+	}
+}
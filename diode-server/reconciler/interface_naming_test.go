@@ -0,0 +1,82 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestLoadInterfaceNamingConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     string
+		wantNaming *netbox.InterfaceNaming
+		wantErr    bool
+	}{
+		{
+			name: "default, platform and manufacturer conventions",
+			config: `
+default: cisco_ios
+platforms:
+  nxos: cisco_nxos
+manufacturers:
+  Juniper: junos
+  Arista: arista_eos
+`,
+			wantNaming: &netbox.InterfaceNaming{
+				Default: netbox.InterfaceNamingCiscoIOS,
+				Platforms: map[string]netbox.InterfaceNamingConvention{
+					"nxos": netbox.InterfaceNamingCiscoNXOS,
+				},
+				Manufacturers: map[string]netbox.InterfaceNamingConvention{
+					"Juniper": netbox.InterfaceNamingJunos,
+					"Arista":  netbox.InterfaceNamingAristaEOS,
+				},
+			},
+		},
+		{
+			name: "unknown default convention",
+			config: `
+default: cisco
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown platform convention",
+			config: `
+platforms:
+  ios: ios
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown manufacturer convention",
+			config: `
+manufacturers:
+  Cisco: ""
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "interface_naming.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			cfg, err := reconciler.LoadInterfaceNamingConfig(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantNaming, cfg.InterfaceNaming())
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// InterfaceTypeRulesConfig is the interface type inference configuration, its rules being tried in order before the
+// built-in ones
+type InterfaceTypeRulesConfig struct {
+	Rules []InterfaceTypeRuleConfig `yaml:"rules"`
+}
+
+// InterfaceTypeRuleConfig is an interface type rule, Name being a case-insensitive regular expression and Speed in
+// Kbps, criteria left unset match any interface
+type InterfaceTypeRuleConfig struct {
+	Name  string `yaml:"name"`
+	Speed *int   `yaml:"speed"`
+	MTU   *int   `yaml:"mtu"`
+	Type  string `yaml:"type"`
+}
+
+// LoadInterfaceTypeRules loads an interface type rules file and returns its rules followed by the built-in ones
+func LoadInterfaceTypeRules(path string) (netbox.InterfaceTypeRules, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read interface type rules: %v", err)
+	}
+
+	var cfg InterfaceTypeRulesConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal interface type rules: %v", err)
+	}
+
+	rules := make(netbox.InterfaceTypeRules, 0, len(cfg.Rules))
+	errs := make([]error, 0)
+	for i, r := range cfg.Rules {
+		rule, err := netbox.NewInterfaceTypeRule(r.Name, r.Speed, r.MTU, r.Type)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %w", i, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid interface type rules: %v", err)
+	}
+
+	return append(rules, netbox.DefaultInterfaceTypeRules()...), nil
+}
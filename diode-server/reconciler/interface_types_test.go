@@ -0,0 +1,96 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestLoadInterfaceTypeRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        string
+		interfaceName string
+		speed         *int
+		mtu           *int
+		wantType      *string
+		wantErr       bool
+	}{
+		{
+			name: "configured rule before built-in ones",
+			config: `
+rules:
+  - name: "^gi"
+    type: 1000base-x-sfp
+`,
+			interfaceName: "GigabitEthernet0/1",
+			wantType:      strPtr("1000base-x-sfp"),
+		},
+		{
+			name: "configured rule matching speed and mtu",
+			config: `
+rules:
+  - speed: 1000000
+    mtu: 9000
+    type: 1000base-x-sfp
+`,
+			interfaceName: "eth0",
+			speed:         intPtr(1000000),
+			mtu:           intPtr(9000),
+			wantType:      strPtr("1000base-x-sfp"),
+		},
+		{
+			name: "built-in rules after configured ones",
+			config: `
+rules:
+  - name: "^xe-"
+    type: 10gbase-t
+`,
+			interfaceName: "GigabitEthernet0/1",
+			wantType:      strPtr("1000base-t"),
+		},
+		{
+			name: "invalid interface type",
+			config: `
+rules:
+  - name: "^gi"
+    type: gigabit
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid name pattern",
+			config: `
+rules:
+  - name: "^gi("
+    type: 1000base-t
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "interface_types.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			rules, err := reconciler.LoadInterfaceTypeRules(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, len(netbox.DefaultInterfaceTypeRules())+1, len(rules))
+			assert.Equal(t, tt.wantType, rules.Infer(tt.interfaceName, tt.speed, tt.mtu))
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
+func intPtr(d int) *int       { return &d }
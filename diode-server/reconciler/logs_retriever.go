@@ -4,25 +4,31 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
-	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
 )
 
-type extraAttributesWrapper struct {
-	ExtraAttributes string `json:"$"`
-	IngestionTs     string `json:"ingestion_ts"`
-}
+const (
+	// defaultLogsPageSize is the number of ingestion logs per page by default
+	defaultLogsPageSize = 100
+
+	// maxLogsPageSize is the maximum number of ingestion logs per page
+	maxLogsPageSize = 1000
+)
+
 type redisLogResult struct {
-	ExtraAttributes extraAttributesWrapper `json:"extra_attributes"`
-	ID              string                 `json:"id"`
+	ExtraAttributes map[string]any `json:"extra_attributes"`
+	ID              string         `json:"id"`
 }
 
 type redisLogsResponse struct {
@@ -49,34 +55,55 @@ func convertMapInterface(data interface{}) interface{} {
 	}
 }
 
-func encodeIntToBase64(num int32) string {
-	// Create a buffer to hold the binary representation
-	buf := new(bytes.Buffer)
+// logsSortKeys are the indexed fields ingestion logs are sorted and paged by for each field they can be sorted by, the
+// ingestion timestamp and ID ordering the logs with the same value
+var logsSortKeys = map[string][]string{
+	"ingestion_ts":      {"ingestion_ts", "ingestion_log_id"},
+	"id":                {"ingestion_log_id"},
+	"data_type":         {"data_type", "ingestion_ts", "ingestion_log_id"},
+	"state":             {"state", "ingestion_ts", "ingestion_log_id"},
+	"producer_app_name": {"producer_app_name", "ingestion_ts", "ingestion_log_id"},
+}
 
-	// Write the int value as a binary value into the buffer
-	if err := binary.Write(buf, binary.BigEndian, num); err != nil {
-		fmt.Println("error writing binary:", err)
-	}
+// logsCursor is the position after a page of ingestion logs: the field and order they are sorted by, and the values of
+// the sort keys of the last log returned
+type logsCursor struct {
+	SortBy string   `json:"s"`
+	Order  string   `json:"o"`
+	Values []string `json:"v"`
+}
 
-	// Encode the binary data to base64
-	return base64.StdEncoding.EncodeToString(buf.Bytes())
+func encodeLogsCursor(cursor logsCursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.StdEncoding.EncodeToString(b)
 }
 
-func decodeBase64ToInt(encoded string) (int32, error) {
-	// Decode the base64 string back to bytes
-	decoded, err := base64.StdEncoding.DecodeString(encoded)
+func decodeLogsCursor(token string, sortBy string, order reconcilerpb.SortOrder) (*logsCursor, error) {
+	b, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	// Convert the byte slice back to int64
-	buf := bytes.NewReader(decoded)
-	var num int32
-	if err := binary.Read(buf, binary.BigEndian, &num); err != nil {
-		return 0, err
+	var cursor logsCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, err
 	}
 
-	return num, nil
+	keys := logsSortKeys[sortBy]
+	if cursor.SortBy != sortBy || cursor.Order != order.String() || len(cursor.Values) != len(keys) {
+		return nil, errors.New("cursor not matching the sort order of the request")
+	}
+
+	for i, key := range keys {
+		if _, ok := numericSortKeys[key]; !ok {
+			continue
+		}
+		if _, err := strconv.ParseFloat(cursor.Values[i], 64); err != nil {
+			return nil, fmt.Errorf("invalid cursor position: %w", err)
+		}
+	}
+
+	return &cursor, nil
 }
 
 func retrieveIngestionMetrics(ctx context.Context, client RedisClient) (*reconcilerpb.RetrieveIngestionLogsResponse, error) {
@@ -85,7 +112,7 @@ func retrieveIngestionMetrics(ctx context.Context, client RedisClient) (*reconci
 	results := []*redis.Cmd{
 		pipe.Do(ctx, "FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0),
 	}
-	for s := reconcilerpb.State_QUEUED; s <= reconcilerpb.State_NO_CHANGES; s++ {
+	for s := reconcilerpb.State_QUEUED; s <= reconcilerpb.State_REVERTED; s++ {
 		stateName, ok := reconcilerpb.State_name[int32(s)]
 		if !ok {
 			return nil, fmt.Errorf("failed to retrieve ingestion logs: failed to get state name of %d", s)
@@ -93,6 +120,7 @@ func retrieveIngestionMetrics(ctx context.Context, client RedisClient) (*reconci
 		stateName = escapeSpecialChars(stateName)
 		results = append(results, pipe.Do(ctx, "FT.SEARCH", "ingest-entity", fmt.Sprintf("@state:{%s}", stateName), "LIMIT", 0, 0))
 	}
+	cacheStats := pipe.HGetAll(ctx, RedisObjectStateCacheStatsKey)
 
 	if _, err := pipe.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
@@ -120,10 +148,29 @@ func retrieveIngestionMetrics(ctx context.Context, client RedisClient) (*reconci
 			metrics.Failed = total
 		} else if q == int(reconcilerpb.State_NO_CHANGES) {
 			metrics.NoChanges = total
+		} else if q == int(reconcilerpb.State_SKIPPED_STALE) {
+			metrics.SkippedStale = total
+		} else if q == int(reconcilerpb.State_FILTERED) {
+			metrics.Filtered = total
+		} else if q == int(reconcilerpb.State_REVERTED) {
+			metrics.Reverted = total
 		} else {
 			metrics.Total = total
 		}
 	}
+
+	stats, err := cacheStats.Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
+	}
+	for field, dst := range map[string]*int64{"hits": &metrics.ObjectStateCacheHits, "misses": &metrics.ObjectStateCacheMisses} {
+		if v, ok := stats[field]; ok {
+			if *dst, err = strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, fmt.Errorf("failed to retrieve ingestion logs: failed to parse object state cache %s: %w", field, err)
+			}
+		}
+	}
+
 	return &reconcilerpb.RetrieveIngestionLogsResponse{Logs: nil, Metrics: &metrics, NextPageToken: ""}, nil
 }
 
@@ -133,43 +180,335 @@ func retrieveIngestionLogs(ctx context.Context, logger *slog.Logger, client Redi
 		return retrieveIngestionMetrics(ctx, client)
 	}
 
-	pageSize := in.GetPageSize()
-	if in.PageSize == nil || pageSize >= 1000 {
-		pageSize = 100 // Default to 100
+	pageSize := int32(defaultLogsPageSize)
+	if in.PageSize != nil {
+		pageSize = in.GetPageSize()
+		if pageSize < 1 || pageSize > maxLogsPageSize {
+			return nil, fmt.Errorf("failed to retrieve ingestion logs: page size %d is not between 1 and %d", pageSize, maxLogsPageSize)
+		}
+	}
+
+	sortBy := in.GetSortBy()
+	if sortBy == "" {
+		sortBy = "ingestion_ts"
+	}
+	keys, ok := logsSortKeys[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve ingestion logs: invalid sort field %s", sortBy)
+	}
+
+	query, err := buildQueryFilter(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
+	}
+
+	var after []string
+	if in.GetPageToken() != "" {
+		cursor, err := decodeLogsCursor(in.GetPageToken(), sortBy, in.GetSortOrder())
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve ingestion logs: invalid page token: %w", err)
+		}
+		after = cursor.Values
+	}
+
+	// one more log than the page size is retrieved to tell whether there is a next page
+	logs, values, err := searchLogsPage(ctx, logger, client, query, keys, in.GetSortOrder(), after, pageSize+1)
+	if err != nil {
+		return nil, err
 	}
 
-	query := buildQueryFilter(in)
+	var nextPageToken string
+	if int32(len(logs)) > pageSize {
+		logs = logs[:pageSize]
+		nextPageToken = encodeLogsCursor(logsCursor{SortBy: sortBy, Order: in.GetSortOrder().String(), Values: values[pageSize-1]})
+	}
 
-	// Construct the base FT.SEARCH query
+	totalResults, err := countLogs(ctx, logger, client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetDecodeChangeSets() {
+		for _, ingestionLog := range logs {
+			if err := decodeChangeSet(ingestionLog.GetChangeSet()); err != nil {
+				return nil, fmt.Errorf("error decoding change set of ingestion log %s: %w", ingestionLog.GetId(), err)
+			}
+		}
+	}
+
+	// Fill metrics
+	var metrics reconcilerpb.IngestionMetrics
+	if in.State != nil {
+		if in.GetState() == reconcilerpb.State_UNSPECIFIED {
+			metrics.Total = totalResults
+		} else if in.GetState() == reconcilerpb.State_QUEUED {
+			metrics.Queued = totalResults
+		} else if in.GetState() == reconcilerpb.State_RECONCILED {
+			metrics.Reconciled = totalResults
+		} else if in.GetState() == reconcilerpb.State_FAILED {
+			metrics.Failed = totalResults
+		} else if in.GetState() == reconcilerpb.State_NO_CHANGES {
+			metrics.NoChanges = totalResults
+		} else if in.GetState() == reconcilerpb.State_SKIPPED_STALE {
+			metrics.SkippedStale = totalResults
+		} else if in.GetState() == reconcilerpb.State_FILTERED {
+			metrics.Filtered = totalResults
+		} else if in.GetState() == reconcilerpb.State_REVERTED {
+			metrics.Reverted = totalResults
+		}
+	} else {
+		metrics.Total = totalResults
+	}
+
+	return &reconcilerpb.RetrieveIngestionLogsResponse{Logs: logs, Metrics: &metrics, NextPageToken: nextPageToken}, nil
+}
+
+// numericSortKeys are the sort keys of ingestion logs indexed as numbers, others being compared as strings
+var numericSortKeys = map[string]struct{}{
+	"ingestion_ts": {},
+}
+
+// optionalSortKeys are the sort keys missing from the ingestion logs without a value, such as the producer app name of
+// the logs of reverts and stale objects, sorted as the lowest values and paged as empty strings
+var optionalSortKeys = map[string]struct{}{
+	"producer_app_name": {},
+}
+
+// searchLogsPage retrieves a page of the ingestion logs matching the query sorted by keys, starting after the sort key
+// values of a log if set, along with the sort key values of each log retrieved. Only the sort keys are loaded to
+// filter and sort the matching logs, the ingestion logs of the page being then retrieved by key.
+func searchLogsPage(ctx context.Context, logger *slog.Logger, client RedisClient, query string, keys []string, order reconcilerpb.SortOrder, after []string, limit int32) ([]*reconcilerpb.IngestionLog, [][]string, error) {
 	queryArgs := []interface{}{
-		"FT.SEARCH",
-		"ingest-entity", // Index name
+		"FT.AGGREGATE",
+		RedisIngestEntityIndexName,
 		query,
+		"LOAD",
+		len(keys) + 1,
+		"@__key",
+	}
+	for _, key := range keys {
+		queryArgs = append(queryArgs, "@"+key)
 	}
 
-	// Apply sorting by id in descending order
-	queryArgs = append(queryArgs, "SORTBY", "id", "DESC")
+	if len(after) > 0 {
+		queryArgs = append(queryArgs, "FILTER", keysetFilter(keys, after, order))
+	}
 
-	var err error
+	queryArgs = append(queryArgs, "SORTBY", 2*len(keys))
+	for _, key := range keys {
+		queryArgs = append(queryArgs, "@"+key, order.String())
+	}
 
-	// Apply limit for pagination
-	var offset int32
-	if in.PageToken != "" {
-		offset, err = decodeBase64ToInt(in.PageToken)
-		if err != nil {
-			logger.Warn("error decoding page token", "error", err)
+	queryArgs = append(queryArgs, "LIMIT", 0, limit)
+
+	logger.Debug("retrieving ingestion logs", "query", queryArgs)
+
+	result, err := client.Do(ctx, queryArgs...).Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
+	}
+
+	logKeys, values, err := parseLogsPageRows(result, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(logKeys) == 0 {
+		return []*reconcilerpb.IngestionLog{}, values, nil
+	}
+
+	return getIngestionLogs(ctx, client, logKeys, values)
+}
+
+// getIngestionLogs retrieves the ingestion logs stored at keys in order along with their sort key values, skipping
+// the logs deleted since they were searched
+func getIngestionLogs(ctx context.Context, client RedisClient, logKeys []string, values [][]string) ([]*reconcilerpb.IngestionLog, [][]string, error) {
+	mgetArgs := make([]interface{}, 0, len(logKeys)+2)
+	mgetArgs = append(mgetArgs, "JSON.MGET")
+	for _, key := range logKeys {
+		mgetArgs = append(mgetArgs, key)
+	}
+	mgetArgs = append(mgetArgs, "$")
+
+	result, err := client.Do(ctx, mgetArgs...).Slice()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
+	}
+	if len(result) != len(logKeys) {
+		return nil, nil, fmt.Errorf("failed to retrieve ingestion logs: %d logs returned for %d keys", len(result), len(logKeys))
+	}
+
+	logs := make([]*reconcilerpb.IngestionLog, 0, len(result))
+	logValues := make([][]string, 0, len(result))
+	for i, data := range result {
+		s, ok := data.(string)
+		if !ok {
+			continue
 		}
+
+		var docs []json.RawMessage
+		if err := json.Unmarshal([]byte(s), &docs); err != nil {
+			return nil, nil, fmt.Errorf("error parsing ingestion log %s: %w", logKeys[i], err)
+		}
+		if len(docs) == 0 {
+			continue
+		}
+
+		ingestionLog := &reconcilerpb.IngestionLog{}
+		if err := protojson.Unmarshal(docs[0], ingestionLog); err != nil {
+			return nil, nil, fmt.Errorf("error parsing ingestion log %s: %w", logKeys[i], err)
+		}
+
+		logs = append(logs, ingestionLog)
+		logValues = append(logValues, values[i])
 	}
-	queryArgs = append(queryArgs, "LIMIT", offset, pageSize)
 
-	logger.Debug("retrieving ingestion logs", "query", queryArgs)
+	return logs, logValues, nil
+}
+
+// countLogs returns the number of ingestion logs matching the query
+func countLogs(ctx context.Context, logger *slog.Logger, client RedisClient, query string) (int32, error) {
+	queryArgs := []interface{}{
+		"FT.SEARCH",
+		RedisIngestEntityIndexName,
+		query,
+		"LIMIT",
+		0,
+		0,
+	}
+
+	logger.Debug("counting ingestion logs", "query", queryArgs)
 
-	// Execute the query using Redis
 	result, err := client.Do(ctx, queryArgs...).Result()
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
+		return 0, fmt.Errorf("failed to retrieve ingestion logs: %w", err)
 	}
 
+	_, total, err := parseIngestionLogs(result)
+	return total, err
+}
+
+// keysetFilter returns the FT.AGGREGATE filter expression matching the logs after the sort key values of a log in the
+// sort order: with a greater (ascending) or lower (descending) first key, or the same first key and a greater or lower
+// second key, and so on. Optional sort keys missing from a log are compared as the lowest values.
+func keysetFilter(keys []string, values []string, order reconcilerpb.SortOrder) string {
+	alternatives := make([]string, 0, len(keys))
+	for i, key := range keys {
+		after, ok := afterTerm(key, values[i], order)
+		if !ok {
+			continue
+		}
+
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, equalTerm(keys[j], values[j]))
+		}
+		terms = append(terms, after)
+		alternatives = append(alternatives, fmt.Sprintf("(%s)", strings.Join(terms, " && ")))
+	}
+	return strings.Join(alternatives, " || ")
+}
+
+// equalTerm returns the filter expression matching the logs with a sort key value, missing if an optional key is empty
+func equalTerm(key string, value string) string {
+	if _, ok := optionalSortKeys[key]; ok && value == "" {
+		return fmt.Sprintf("!exists(@%s)", key)
+	}
+	return fmt.Sprintf("@%s == %s", key, filterLiteral(key, value))
+}
+
+// afterTerm returns the filter expression matching the logs with a sort key value after value in the sort order, none
+// being before a missing optional key in descending order
+func afterTerm(key string, value string, order reconcilerpb.SortOrder) (string, bool) {
+	_, optional := optionalSortKeys[key]
+
+	if order == reconcilerpb.SortOrder_ASC {
+		if optional && value == "" {
+			return fmt.Sprintf("exists(@%s)", key), true
+		}
+		return fmt.Sprintf("@%s > %s", key, filterLiteral(key, value)), true
+	}
+
+	if !optional {
+		return fmt.Sprintf("@%s < %s", key, filterLiteral(key, value)), true
+	}
+	if value == "" {
+		return "", false
+	}
+	return fmt.Sprintf("(!exists(@%s) || @%s < %s)", key, key, filterLiteral(key, value)), true
+}
+
+// filterLiteral returns the literal of a sort key value in a filter expression, quoted unless numeric
+func filterLiteral(key string, value string) string {
+	if _, ok := numericSortKeys[key]; ok {
+		return value
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(value) + "'"
+}
+
+// parseIngestionLogs parses the ingestion logs of a FT.SEARCH result along with the total number of results
+func parseIngestionLogs(result interface{}) ([]*reconcilerpb.IngestionLog, int32, error) {
+	response, err := parseLogsResponse(result)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs := make([]*reconcilerpb.IngestionLog, 0, len(response.Results))
+
+	for _, logsResult := range response.Results {
+		data, ok := logsResult.ExtraAttributes["$"].(string)
+		if !ok {
+			return nil, 0, fmt.Errorf("error parsing ExtraAttributes JSON: ingestion log not found")
+		}
+
+		ingestionLog := &reconcilerpb.IngestionLog{}
+		if err := protojson.Unmarshal([]byte(data), ingestionLog); err != nil {
+			return nil, 0, fmt.Errorf("error parsing ExtraAttributes JSON: %v", err)
+		}
+
+		logs = append(logs, ingestionLog)
+	}
+
+	return logs, response.TotalResults, nil
+}
+
+// parseLogsPageRows parses the keys of the ingestion logs of a FT.AGGREGATE result along with the values of the sort
+// keys loaded for each log, empty for the optional sort keys a log is missing
+func parseLogsPageRows(result interface{}, keys []string) ([]string, [][]string, error) {
+	response, err := parseLogsResponse(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logKeys := make([]string, 0, len(response.Results))
+	values := make([][]string, 0, len(response.Results))
+
+	for _, logsResult := range response.Results {
+		logKey, ok := logsResult.ExtraAttributes["__key"].(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("error parsing ExtraAttributes JSON: ingestion log key not found")
+		}
+
+		logValues := make([]string, 0, len(keys))
+		for _, key := range keys {
+			v, ok := logsResult.ExtraAttributes[key]
+			if !ok {
+				if _, optional := optionalSortKeys[key]; !optional {
+					return nil, nil, fmt.Errorf("error parsing ExtraAttributes JSON: %s of ingestion log %s not found", key, logKey)
+				}
+				v = ""
+			}
+			logValues = append(logValues, fmt.Sprint(v))
+		}
+
+		logKeys = append(logKeys, logKey)
+		values = append(values, logValues)
+	}
+
+	return logKeys, values, nil
+}
+
+// parseLogsResponse parses a FT.SEARCH or FT.AGGREGATE result, keeping numbers as is
+func parseLogsResponse(result interface{}) (*redisLogsResponse, error) {
 	res := convertMapInterface(result)
 
 	jsonBytes, err := json.Marshal(res)
@@ -179,52 +518,95 @@ func retrieveIngestionLogs(ctx context.Context, logger *slog.Logger, client Redi
 
 	var response redisLogsResponse
 
-	// Unmarshal the result into the struct
-	if err = json.Unmarshal(jsonBytes, &response); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	if err = decoder.Decode(&response); err != nil {
 		return nil, fmt.Errorf("error parsing JSON: %w", err)
 	}
 
-	logs := make([]*reconcilerpb.IngestionLog, 0)
+	return &response, nil
+}
 
-	for _, logsResult := range response.Results {
-		ingestionLog := &reconcilerpb.IngestionLog{}
-		if err := protojson.Unmarshal([]byte(logsResult.ExtraAttributes.ExtraAttributes), ingestionLog); err != nil {
-			return nil, fmt.Errorf("error parsing ExtraAttributes JSON: %v", err)
+// decodeChangeSet sets the changes of a change set decoded from its brotli-compressed JSON data
+func decodeChangeSet(pbChangeSet *reconcilerpb.ChangeSet) error {
+	if len(pbChangeSet.GetData()) == 0 {
+		return nil
+	}
+
+	cs, err := decompressChangeSet(pbChangeSet.GetData())
+	if err != nil {
+		return err
+	}
+
+	changes := make([]*reconcilerpb.Change, 0, len(cs.ChangeSet))
+	for _, change := range cs.ChangeSet {
+		pbChange := &reconcilerpb.Change{
+			ChangeId:   change.ChangeID,
+			ChangeType: change.ChangeType,
+			ObjectType: change.ObjectType,
+		}
+		if change.ObjectID != nil {
+			pbChange.ObjectId = int32(*change.ObjectID)
 		}
 
-		logs = append(logs, ingestionLog)
+		if data, ok := change.Data.(map[string]any); ok {
+			if pbChange.Data, err = structpb.NewStruct(data); err != nil {
+				return fmt.Errorf("failed to decode data of change %s: %w", change.ChangeID, err)
+			}
+		}
+
+		for _, fieldChange := range change.Diff {
+			before, err := jsonValue(fieldChange.Before)
+			if err != nil {
+				return fmt.Errorf("failed to decode field %s of change %s: %w", fieldChange.Field, change.ChangeID, err)
+			}
+			after, err := jsonValue(fieldChange.After)
+			if err != nil {
+				return fmt.Errorf("failed to decode field %s of change %s: %w", fieldChange.Field, change.ChangeID, err)
+			}
+			pbChange.Diff = append(pbChange.Diff, &reconcilerpb.FieldChange{Field: fieldChange.Field, Before: before, After: after})
+		}
+
+		changes = append(changes, pbChange)
 	}
+	pbChangeSet.Changes = changes
 
-	var nextPageToken string
+	return nil
+}
 
-	if len(logs) == int(pageSize) {
-		offset += int32(len(logs))
-		nextPageToken = encodeIntToBase64(offset)
+// jsonValue converts a JSON value to a protobuf value, null if not set
+func jsonValue(b json.RawMessage) (*structpb.Value, error) {
+	if len(b) == 0 {
+		return structpb.NewNullValue(), nil
 	}
 
-	// Fill metrics
-	var metrics reconcilerpb.IngestionMetrics
-	if in.State != nil {
-		if in.GetState() == reconcilerpb.State_UNSPECIFIED {
-			metrics.Total = response.TotalResults
-		} else if in.GetState() == reconcilerpb.State_QUEUED {
-			metrics.Queued = response.TotalResults
-		} else if in.GetState() == reconcilerpb.State_RECONCILED {
-			metrics.Reconciled = response.TotalResults
-		} else if in.GetState() == reconcilerpb.State_FAILED {
-			metrics.Failed = response.TotalResults
-		} else if in.GetState() == reconcilerpb.State_NO_CHANGES {
-			metrics.NoChanges = response.TotalResults
-		}
-	} else {
-		metrics.Total = response.TotalResults
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
 	}
+	return structpb.NewValue(v)
+}
 
-	return &reconcilerpb.RetrieveIngestionLogsResponse{Logs: logs, Metrics: &metrics, NextPageToken: nextPageToken}, nil
+// tagFields are the indexed tag fields of ingestion logs, filtered by exact values
+var tagFields = map[string]struct{}{
+	"data_type":            {},
+	"state":                {},
+	"request_id":           {},
+	"producer_app_name":    {},
+	"producer_app_version": {},
+	"sdk_name":             {},
+	"sdk_version":          {},
+	"change_set_id":        {},
+}
+
+// textFields are the indexed full-text fields of ingestion logs, filtered by terms
+var textFields = map[string]struct{}{
+	"object_name":   {},
+	"error_message": {},
 }
 
-func buildQueryFilter(req *reconcilerpb.RetrieveIngestionLogsRequest) string {
-	queryFilter := "*"
+func buildQueryFilter(req *reconcilerpb.RetrieveIngestionLogsRequest) (string, error) {
+	var filters []string
 
 	// apply optional filters for ingestion timestamps (start and end)
 	if req.GetIngestionTsStart() > 0 || req.GetIngestionTsEnd() > 0 {
@@ -234,31 +616,85 @@ func buildQueryFilter(req *reconcilerpb.RetrieveIngestionLogsRequest) string {
 			ingestionTsFilter = fmt.Sprintf("@ingestion_ts:[%d %d]", req.GetIngestionTsStart(), req.GetIngestionTsEnd())
 		}
 
-		queryFilter = ingestionTsFilter
+		filters = append(filters, ingestionTsFilter)
 	}
 
 	// apply optional filters for ingestion state
 	if req.State != nil {
 		state := escapeSpecialChars(req.GetState().String())
-		stateFilter := fmt.Sprintf("@state:{%s}", state)
-		if queryFilter == "*" {
-			queryFilter = stateFilter
-		} else {
-			queryFilter = fmt.Sprintf("%s %s", queryFilter, stateFilter)
-		}
+		filters = append(filters, fmt.Sprintf("@state:{%s}", state))
 	}
 
 	if req.GetDataType() != "" {
 		dataType := escapeSpecialChars(req.GetDataType())
-		dataTypeFilter := fmt.Sprintf("@data_type:{%s}", dataType)
-		if queryFilter == "*" {
-			queryFilter = dataTypeFilter
-		} else {
-			queryFilter = fmt.Sprintf("%s %s", queryFilter, dataTypeFilter)
+		filters = append(filters, fmt.Sprintf("@data_type:{%s}", dataType))
+	}
+
+	if req.GetRequestId() != "" {
+		requestID := escapeSpecialChars(req.GetRequestId())
+		filters = append(filters, fmt.Sprintf("@request_id:{%s}", requestID))
+	}
+
+	for _, f := range req.GetFilters() {
+		filter, err := buildFieldFilter(f)
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, filter)
+	}
+
+	// apply optional free-text search over object names and error messages
+	if search := strings.TrimSpace(req.GetSearch()); search != "" {
+		filters = append(filters, fmt.Sprintf("@object_name|error_message:(%s)", escapeSpecialChars(search)))
+	}
+
+	if len(filters) == 0 {
+		return "*", nil
+	}
+
+	return strings.Join(filters, " "), nil
+}
+
+// buildFieldFilter returns the query matching the ingestion logs with any of the values of an indexed field, or none
+// of them when negated
+func buildFieldFilter(f *reconcilerpb.IngestionLogFilter) (string, error) {
+	if len(f.GetValues()) == 0 {
+		return "", fmt.Errorf("no values to filter field %s by", f.GetField())
+	}
+
+	values := make([]string, 0, len(f.GetValues()))
+	for _, v := range f.GetValues() {
+		if f.GetField() == "state" {
+			if _, ok := reconcilerpb.State_value[v]; !ok {
+				return "", fmt.Errorf("invalid state %s", v)
+			}
 		}
+		values = append(values, escapeSpecialChars(v))
+	}
+
+	var filter string
+	if _, ok := tagFields[f.GetField()]; ok {
+		// spaces within tags are escaped
+		for i, v := range values {
+			values[i] = strings.ReplaceAll(v, " ", "\\ ")
+		}
+		filter = fmt.Sprintf("@%s:{%s}", f.GetField(), strings.Join(values, " | "))
+	} else if _, ok := textFields[f.GetField()]; ok {
+		if len(values) > 1 {
+			for i, v := range values {
+				values[i] = fmt.Sprintf("(%s)", v)
+			}
+		}
+		filter = fmt.Sprintf("@%s:(%s)", f.GetField(), strings.Join(values, " | "))
+	} else {
+		return "", fmt.Errorf("invalid filter field %s", f.GetField())
+	}
+
+	if f.GetNegate() {
+		filter = "-" + filter
 	}
 
-	return queryFilter
+	return filter, nil
 }
 
 func escapeSpecialChars(s string) string {
@@ -1,11 +1,27 @@
 package reconciler
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
 	"testing"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+	mr "github.com/netboxlabs/diode/diode-server/reconciler/mocks"
 )
 
+func int32Ptr(i int32) *int32 { return &i }
+
 func TestEscapeSpecialChars(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -47,3 +63,517 @@ func TestEscapeSpecialChars(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildQueryFilter(t *testing.T) {
+	state := reconcilerpb.State_FAILED
+
+	tests := []struct {
+		name    string
+		in      *reconcilerpb.RetrieveIngestionLogsRequest
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no filters",
+			in:   &reconcilerpb.RetrieveIngestionLogsRequest{},
+			want: "*",
+		},
+		{
+			name: "request ID",
+			in:   &reconcilerpb.RetrieveIngestionLogsRequest{RequestId: "req-id"},
+			want: `@request_id:{req\-id}`,
+		},
+		{
+			name: "state, data type and request ID",
+			in:   &reconcilerpb.RetrieveIngestionLogsRequest{State: &state, DataType: "dcim.site", RequestId: "req-id"},
+			want: `@state:{FAILED} @data_type:{dcim\.site} @request_id:{req\-id}`,
+		},
+		{
+			name: "tag field with multiple values",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "producer_app_name", Values: []string{"diode-agent", "my app"}},
+			}},
+			want: `@producer_app_name:{diode\-agent | my\ app}`,
+		},
+		{
+			name: "negated tag field",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "state", Values: []string{"RECONCILED", "NO_CHANGES"}, Negate: true},
+				{Field: "sdk_version", Values: []string{"0.1.0"}},
+			}},
+			want: `-@state:{RECONCILED | NO_CHANGES} @sdk_version:{0\.1\.0}`,
+		},
+		{
+			name: "text field with multiple values",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "object_name", Values: []string{"router01", "Gig 2"}},
+			}},
+			want: `@object_name:((router01) | (Gig 2))`,
+		},
+		{
+			name: "negated text field",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "error_message", Values: []string{"timeout"}, Negate: true},
+			}},
+			want: `-@error_message:(timeout)`,
+		},
+		{
+			name: "free-text search",
+			in:   &reconcilerpb.RetrieveIngestionLogsRequest{DataType: "dcim.device", Search: " router-01 "},
+			want: `@data_type:{dcim\.device} @object_name|error_message:(router\-01)`,
+		},
+		{
+			name: "unknown field",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "entity", Values: []string{"router01"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "filter without values",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "sdk_name"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid state",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "state", Values: []string{"DONE"}},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildQueryFilter(tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRetrieveIngestionLogsPagination(t *testing.T) {
+	docs := make(map[string]string)
+	logRow := func(id string, ingestionTs int64, attrs ...string) interface{} {
+		key := ingestionLogKey("dcim.site", ingestionTs, id)
+		docs[key] = fmt.Sprintf(`[{"id":"%s","dataType":"dcim.site","state":"RECONCILED","ingestionTs":"%d"}]`, id, ingestionTs)
+		extraAttributes := map[interface{}]interface{}{
+			"__key":            key,
+			"ingestion_ts":     fmt.Sprint(ingestionTs),
+			"ingestion_log_id": id,
+		}
+		for i := 0; i+1 < len(attrs); i += 2 {
+			extraAttributes[attrs[i]] = attrs[i+1]
+		}
+		return map[interface{}]interface{}{"extra_attributes": extraAttributes, "values": []interface{}{}}
+	}
+
+	loadTsID := []interface{}{"LOAD", 3, "@__key", "@ingestion_ts", "@ingestion_log_id"}
+	sortTsID := func(order string) []interface{} {
+		return []interface{}{"SORTBY", 4, "@ingestion_ts", order, "@ingestion_log_id", order}
+	}
+
+	type search struct {
+		args    []interface{}
+		results []interface{}
+		total   int
+	}
+
+	args := func(parts ...[]interface{}) []interface{} {
+		var all []interface{}
+		for _, p := range parts {
+			all = append(all, p...)
+		}
+		return all
+	}
+
+	tests := []struct {
+		name          string
+		in            *reconcilerpb.RetrieveIngestionLogsRequest
+		searches      []search
+		wantIDs       []string
+		wantPageToken string
+		wantTotal     int32
+		wantErr       bool
+	}{
+		{
+			name: "first page ending within logs of the same ingestion timestamp",
+			in:   &reconcilerpb.RetrieveIngestionLogsRequest{PageSize: int32Ptr(2)},
+			searches: []search{
+				{
+					args:    args([]interface{}{"FT.AGGREGATE", "ingest-entity", "*"}, loadTsID, sortTsID("DESC"), []interface{}{"LIMIT", 0, int32(3)}),
+					results: []interface{}{logRow("a", 300), logRow("b2", 200), logRow("b1", 200)},
+				},
+				{
+					args:  []interface{}{"FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0},
+					total: 4,
+				},
+			},
+			wantIDs:       []string{"a", "b2"},
+			wantPageToken: encodeLogsCursor(logsCursor{SortBy: "ingestion_ts", Order: "DESC", Values: []string{"200", "b2"}}),
+			wantTotal:     4,
+		},
+		{
+			name: "next page after the ingestion timestamp and ID of the last log",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{
+				PageSize:  int32Ptr(2),
+				PageToken: encodeLogsCursor(logsCursor{SortBy: "ingestion_ts", Order: "DESC", Values: []string{"200", "b2"}}),
+			},
+			searches: []search{
+				{
+					args: args(
+						[]interface{}{"FT.AGGREGATE", "ingest-entity", "*"},
+						loadTsID,
+						[]interface{}{"FILTER", "(@ingestion_ts < 200) || (@ingestion_ts == 200 && @ingestion_log_id < 'b2')"},
+						sortTsID("DESC"),
+						[]interface{}{"LIMIT", 0, int32(3)},
+					),
+					results: []interface{}{logRow("b1", 200), logRow("c", 100)},
+				},
+				{
+					args:  []interface{}{"FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0},
+					total: 4,
+				},
+			},
+			wantIDs:   []string{"b1", "c"},
+			wantTotal: 4,
+		},
+		{
+			name: "next page sorted by state in ascending order with filters",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{
+				DataType:  "dcim.site",
+				PageSize:  int32Ptr(1),
+				PageToken: encodeLogsCursor(logsCursor{SortBy: "state", Order: "ASC", Values: []string{"FAILED", "100", "a"}}),
+				SortBy:    "state",
+				SortOrder: reconcilerpb.SortOrder_ASC,
+			},
+			searches: []search{
+				{
+					args: []interface{}{
+						"FT.AGGREGATE", "ingest-entity", `@data_type:{dcim\.site}`,
+						"LOAD", 4, "@__key", "@state", "@ingestion_ts", "@ingestion_log_id",
+						"FILTER", "(@state > 'FAILED') || (@state == 'FAILED' && @ingestion_ts > 100) || (@state == 'FAILED' && @ingestion_ts == 100 && @ingestion_log_id > 'a')",
+						"SORTBY", 6, "@state", "ASC", "@ingestion_ts", "ASC", "@ingestion_log_id", "ASC",
+						"LIMIT", 0, int32(2),
+					},
+					results: []interface{}{logRow("b", 200, "state", "FAILED"), logRow("c", 100, "state", "RECONCILED")},
+				},
+				{
+					args:  []interface{}{"FT.SEARCH", "ingest-entity", `@data_type:{dcim\.site}`, "LIMIT", 0, 0},
+					total: 3,
+				},
+			},
+			wantIDs:       []string{"b"},
+			wantPageToken: encodeLogsCursor(logsCursor{SortBy: "state", Order: "ASC", Values: []string{"FAILED", "200", "b"}}),
+			wantTotal:     3,
+		},
+		{
+			name: "first page sorted by producer app name ending with a log without producer app name",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{
+				PageSize:  int32Ptr(1),
+				SortBy:    "producer_app_name",
+				SortOrder: reconcilerpb.SortOrder_ASC,
+			},
+			searches: []search{
+				{
+					args: []interface{}{
+						"FT.AGGREGATE", "ingest-entity", "*",
+						"LOAD", 4, "@__key", "@producer_app_name", "@ingestion_ts", "@ingestion_log_id",
+						"SORTBY", 6, "@producer_app_name", "ASC", "@ingestion_ts", "ASC", "@ingestion_log_id", "ASC",
+						"LIMIT", 0, int32(2),
+					},
+					results: []interface{}{logRow("b", 200), logRow("a", 300, "producer_app_name", "diode-agent")},
+				},
+				{
+					args:  []interface{}{"FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0},
+					total: 2,
+				},
+			},
+			wantIDs:       []string{"b"},
+			wantPageToken: encodeLogsCursor(logsCursor{SortBy: "producer_app_name", Order: "ASC", Values: []string{"", "200", "b"}}),
+			wantTotal:     2,
+		},
+		{
+			name: "next page after a log without producer app name",
+			in: &reconcilerpb.RetrieveIngestionLogsRequest{
+				PageSize:  int32Ptr(1),
+				PageToken: encodeLogsCursor(logsCursor{SortBy: "producer_app_name", Order: "ASC", Values: []string{"", "200", "b"}}),
+				SortBy:    "producer_app_name",
+				SortOrder: reconcilerpb.SortOrder_ASC,
+			},
+			searches: []search{
+				{
+					args: []interface{}{
+						"FT.AGGREGATE", "ingest-entity", "*",
+						"LOAD", 4, "@__key", "@producer_app_name", "@ingestion_ts", "@ingestion_log_id",
+						"FILTER", "(exists(@producer_app_name)) || (!exists(@producer_app_name) && @ingestion_ts > 200) || (!exists(@producer_app_name) && @ingestion_ts == 200 && @ingestion_log_id > 'b')",
+						"SORTBY", 6, "@producer_app_name", "ASC", "@ingestion_ts", "ASC", "@ingestion_log_id", "ASC",
+						"LIMIT", 0, int32(2),
+					},
+					results: []interface{}{logRow("a", 300, "producer_app_name", "diode-agent")},
+				},
+				{
+					args:  []interface{}{"FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0},
+					total: 2,
+				},
+			},
+			wantIDs:   []string{"a"},
+			wantTotal: 2,
+		},
+		{
+			name: "maximum page size",
+			in:   &reconcilerpb.RetrieveIngestionLogsRequest{PageSize: int32Ptr(1000)},
+			searches: []search{
+				{
+					args:    args([]interface{}{"FT.AGGREGATE", "ingest-entity", "*"}, loadTsID, sortTsID("DESC"), []interface{}{"LIMIT", 0, int32(1001)}),
+					results: []interface{}{logRow("a", 300)},
+				},
+				{
+					args:  []interface{}{"FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0},
+					total: 1,
+				},
+			},
+			wantIDs:   []string{"a"},
+			wantTotal: 1,
+		},
+		{
+			name:    "page size too large",
+			in:      &reconcilerpb.RetrieveIngestionLogsRequest{PageSize: int32Ptr(1001)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid sort field",
+			in:      &reconcilerpb.RetrieveIngestionLogsRequest{SortBy: "sdk_name"},
+			wantErr: true,
+		},
+		{
+			name:    "page token of another sort order",
+			in:      &reconcilerpb.RetrieveIngestionLogsRequest{PageToken: encodeLogsCursor(logsCursor{SortBy: "ingestion_ts", Order: "ASC", Values: []string{"200", "b2"}})},
+			wantErr: true,
+		},
+		{
+			name:    "page token with an invalid ingestion timestamp",
+			in:      &reconcilerpb.RetrieveIngestionLogsRequest{PageToken: encodeLogsCursor(logsCursor{SortBy: "ingestion_ts", Order: "DESC", Values: []string{"200 || 1", "b2"}})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+			mockRedisClient := new(mr.RedisClient)
+			for _, s := range tt.searches {
+				cmd := redis.NewCmd(ctx)
+				results := s.results
+				if results == nil {
+					results = []interface{}{}
+				}
+				cmd.SetVal(interface{}(map[interface{}]interface{}{
+					"results":       results,
+					"total_results": s.total,
+				}))
+				mockRedisClient.On("Do", append([]interface{}{ctx}, s.args...)...).Return(cmd).Once()
+
+				if s.args[0] != "FT.AGGREGATE" || len(results) == 0 {
+					continue
+				}
+				mgetArgs := []interface{}{ctx, "JSON.MGET"}
+				var logs []interface{}
+				for _, row := range results {
+					key := row.(map[interface{}]interface{})["extra_attributes"].(map[interface{}]interface{})["__key"].(string)
+					mgetArgs = append(mgetArgs, key)
+					logs = append(logs, docs[key])
+				}
+				mgetArgs = append(mgetArgs, "$")
+				mgetCmd := redis.NewCmd(ctx)
+				mgetCmd.SetVal(interface{}(logs))
+				mockRedisClient.On("Do", mgetArgs...).Return(mgetCmd).Once()
+			}
+
+			resp, err := retrieveIngestionLogs(ctx, logger, mockRedisClient, tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(resp.Logs))
+			for _, ingestionLog := range resp.Logs {
+				ids = append(ids, ingestionLog.GetId())
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantPageToken, resp.NextPageToken)
+			assert.Equal(t, tt.wantTotal, resp.Metrics.GetTotal())
+			mockRedisClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestKeysetFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   []string
+		values []string
+		order  reconcilerpb.SortOrder
+		want   string
+	}{
+		{
+			name:   "ID",
+			keys:   []string{"ingestion_log_id"},
+			values: []string{"2mAT7vZ38H4ttI0i5dBebwJbSnZ"},
+			order:  reconcilerpb.SortOrder_DESC,
+			want:   "(@ingestion_log_id < '2mAT7vZ38H4ttI0i5dBebwJbSnZ')",
+		},
+		{
+			name:   "quoted string value",
+			keys:   []string{"producer_app_name", "ingestion_ts", "ingestion_log_id"},
+			values: []string{`o'reilly\agent`, "1.7255529143922086e+18", "a"},
+			order:  reconcilerpb.SortOrder_ASC,
+			want:   `(@producer_app_name > 'o\'reilly\\agent') || (@producer_app_name == 'o\'reilly\\agent' && @ingestion_ts > 1.7255529143922086e+18) || (@producer_app_name == 'o\'reilly\\agent' && @ingestion_ts == 1.7255529143922086e+18 && @ingestion_log_id > 'a')`,
+		},
+		{
+			name:   "producer app name in descending order",
+			keys:   []string{"producer_app_name", "ingestion_ts", "ingestion_log_id"},
+			values: []string{"diode-agent", "100", "a"},
+			order:  reconcilerpb.SortOrder_DESC,
+			want:   `((!exists(@producer_app_name) || @producer_app_name < 'diode-agent')) || (@producer_app_name == 'diode-agent' && @ingestion_ts < 100) || (@producer_app_name == 'diode-agent' && @ingestion_ts == 100 && @ingestion_log_id < 'a')`,
+		},
+		{
+			name:   "missing producer app name in descending order",
+			keys:   []string{"producer_app_name", "ingestion_ts", "ingestion_log_id"},
+			values: []string{"", "100", "a"},
+			order:  reconcilerpb.SortOrder_DESC,
+			want:   `(!exists(@producer_app_name) && @ingestion_ts < 100) || (!exists(@producer_app_name) && @ingestion_ts == 100 && @ingestion_log_id < 'a')`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, keysetFilter(tt.keys, tt.values, tt.order))
+		})
+	}
+}
+
+func TestRetrieveIngestionLogsDecodeChangeSets(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+	objectID := 1
+	csCompressed, err := compressChangeSet(&changeset.ChangeSet{
+		ChangeSetID: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5",
+		ChangeSet: []changeset.Change{
+			{
+				ChangeID:   "c-1",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   &objectID,
+				Data:       &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Facility: strPtr("DC2")},
+				Diff: []netbox.FieldChange{
+					{Field: "facility", Before: json.RawMessage(`"DC1"`), After: json.RawMessage(`"DC2"`)},
+					{Field: "description", Before: json.RawMessage(`"edge site"`)},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ingestionLog, err := protojson.Marshal(&reconcilerpb.IngestionLog{
+		Id:        "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
+		DataType:  netbox.DcimSiteObjectType,
+		State:     reconcilerpb.State_RECONCILED,
+		ChangeSet: &reconcilerpb.ChangeSet{Id: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5", Data: csCompressed},
+	})
+	require.NoError(t, err)
+
+	for _, decode := range []bool{false, true} {
+		mockRedisClient := new(mr.RedisClient)
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(interface{}(map[interface{}]interface{}{
+			"results": []interface{}{
+				map[interface{}]interface{}{
+					"extra_attributes": map[interface{}]interface{}{
+						"$":                string(ingestionLog),
+						"ingestion_ts":     "1725552914392208640",
+						"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
+					},
+					"id": "ingest-entity:dcim.site-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
+				},
+			},
+			"total_results": 1,
+		}))
+		mockLogsPage(ctx, mockRedisClient, []interface{}{"FT.AGGREGATE", "ingest-entity", "*", "LOAD", 3, "@__key", "@ingestion_ts", "@ingestion_log_id", "SORTBY", 4, "@ingestion_ts", "DESC", "@ingestion_log_id", "DESC", "LIMIT", 0, int32(101)}, cmd.Val())
+		mockRedisClient.On("Do", ctx, "FT.SEARCH", "ingest-entity", "*", "LIMIT", 0, 0).Return(cmd)
+
+		resp, err := retrieveIngestionLogs(ctx, logger, mockRedisClient, &reconcilerpb.RetrieveIngestionLogsRequest{DecodeChangeSets: decode})
+		require.NoError(t, err)
+		require.Len(t, resp.Logs, 1)
+
+		changeSet := resp.Logs[0].GetChangeSet()
+		assert.Equal(t, csCompressed, changeSet.GetData())
+
+		if !decode {
+			assert.Empty(t, changeSet.GetChanges())
+			continue
+		}
+
+		require.Len(t, changeSet.GetChanges(), 1)
+		change := changeSet.GetChanges()[0]
+		assert.Equal(t, "c-1", change.GetChangeId())
+		assert.Equal(t, changeset.ChangeTypeUpdate, change.GetChangeType())
+		assert.Equal(t, netbox.DcimSiteObjectType, change.GetObjectType())
+		assert.Equal(t, int32(1), change.GetObjectId())
+		assert.Equal(t, map[string]any{"id": float64(1), "name": "Site A", "slug": "site-a", "facility": "DC2"}, change.GetData().AsMap())
+
+		require.Len(t, change.GetDiff(), 2)
+		assert.Equal(t, "facility", change.GetDiff()[0].GetField())
+		assert.Equal(t, "DC1", change.GetDiff()[0].GetBefore().GetStringValue())
+		assert.Equal(t, "DC2", change.GetDiff()[0].GetAfter().GetStringValue())
+		assert.Equal(t, "description", change.GetDiff()[1].GetField())
+		assert.Equal(t, "edge site", change.GetDiff()[1].GetBefore().GetStringValue())
+		assert.Equal(t, structpb.NewNullValue(), change.GetDiff()[1].GetAfter())
+	}
+}
+
+// mockLogsPage mocks the FT.AGGREGATE search of a page of ingestion logs with the arguments args, returning the logs of
+// the FT.SEARCH result searchResult, and the JSON.MGET retrieving their JSON documents
+func mockLogsPage(ctx context.Context, client *mr.RedisClient, args []interface{}, searchResult interface{}) {
+	rows, _ := searchResult.(map[interface{}]interface{})["results"].([]interface{})
+
+	aggregateRows := make([]interface{}, 0, len(rows))
+	mgetArgs := []interface{}{ctx, "JSON.MGET"}
+	docs := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		row := row.(map[interface{}]interface{})
+		extraAttributes := make(map[interface{}]interface{})
+		for k, v := range row["extra_attributes"].(map[interface{}]interface{}) {
+			extraAttributes[k] = v
+		}
+
+		key, ok := row["id"].(string)
+		if !ok {
+			key = fmt.Sprintf("ingest-entity:%s", extraAttributes["ingestion_log_id"])
+		}
+		docs = append(docs, fmt.Sprintf("[%s]", extraAttributes["$"]))
+		delete(extraAttributes, "$")
+		extraAttributes["__key"] = key
+
+		aggregateRows = append(aggregateRows, map[interface{}]interface{}{"extra_attributes": extraAttributes, "values": []interface{}{}})
+		mgetArgs = append(mgetArgs, key)
+	}
+	mgetArgs = append(mgetArgs, "$")
+
+	aggregateCmd := redis.NewCmd(ctx)
+	aggregateCmd.SetVal(interface{}(map[interface{}]interface{}{"results": aggregateRows, "total_results": len(aggregateRows)}))
+	client.On("Do", append([]interface{}{ctx}, args...)...).Return(aggregateCmd)
+
+	if len(rows) > 0 {
+		mgetCmd := redis.NewCmd(ctx)
+		mgetCmd.SetVal(interface{}(docs))
+		client.On("Do", mgetArgs...).Return(mgetCmd)
+	}
+}
@@ -0,0 +1,200 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+)
+
+// textSeparators are the characters separating the terms of indexed text fields, along with whitespaces
+const textSeparators = `,.<>{}[]"':;!@#$%^&*()-+=~`
+
+// stopWords are the default stop-words of RediSearch, neither indexed nor searched
+var stopWords = []string{
+	"a", "is", "the", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "it", "no", "not",
+	"of", "on", "or", "such", "that", "their", "then", "there", "these", "they", "this", "to", "was", "will", "with",
+}
+
+// watchIngestionLogs sends the ingestion logs published to the subscription matching the filters of the request, until
+// the context is done
+func watchIngestionLogs(ctx context.Context, logger *slog.Logger, messages <-chan *redis.Message, in *reconcilerpb.WatchIngestionLogsRequest, send func(*reconcilerpb.IngestionLog) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return errors.New("ingestion logs subscription closed")
+			}
+
+			ingestionLog := &reconcilerpb.IngestionLog{}
+			if err := protojson.Unmarshal([]byte(msg.Payload), ingestionLog); err != nil {
+				logger.Warn("failed to parse published ingestion log", "error", err)
+				continue
+			}
+
+			if !matchIngestionLog(in, ingestionLog) {
+				continue
+			}
+
+			if in.GetDecodeChangeSets() {
+				if err := decodeChangeSet(ingestionLog.GetChangeSet()); err != nil {
+					logger.Warn("failed to decode change set of published ingestion log", "id", ingestionLog.GetId(), "error", err)
+				}
+			}
+
+			if err := send(ingestionLog); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// queryRequest returns the request retrieving the ingestion logs matching the filters of a watch request
+func queryRequest(in *reconcilerpb.WatchIngestionLogsRequest) *reconcilerpb.RetrieveIngestionLogsRequest {
+	return &reconcilerpb.RetrieveIngestionLogsRequest{
+		State:            in.State,
+		DataType:         in.GetDataType(),
+		RequestId:        in.GetRequestId(),
+		IngestionTsStart: in.GetIngestionTsStart(),
+		IngestionTsEnd:   in.GetIngestionTsEnd(),
+		Filters:          in.GetFilters(),
+		Search:           in.GetSearch(),
+	}
+}
+
+// matchIngestionLog returns whether an ingestion log matches the filters of the request, as its query would when
+// retrieving ingestion logs. Text fields are matched by exact terms, stop-words aside: unlike RediSearch queries,
+// search terms aren't stemmed, e.g. "timeouts" doesn't match a "timeout" error message.
+func matchIngestionLog(in *reconcilerpb.WatchIngestionLogsRequest, ingestionLog *reconcilerpb.IngestionLog) bool {
+	if in.GetIngestionTsStart() > 0 && ingestionLog.GetIngestionTs() < in.GetIngestionTsStart() {
+		return false
+	}
+
+	if in.GetIngestionTsEnd() > 0 && ingestionLog.GetIngestionTs() > in.GetIngestionTsEnd() {
+		return false
+	}
+
+	if in.State != nil && ingestionLog.GetState() != in.GetState() {
+		return false
+	}
+
+	if in.GetDataType() != "" && ingestionLog.GetDataType() != in.GetDataType() {
+		return false
+	}
+
+	if in.GetRequestId() != "" && ingestionLog.GetRequestId() != in.GetRequestId() {
+		return false
+	}
+
+	for _, f := range in.GetFilters() {
+		if matchFieldFilter(f, ingestionLog) == f.GetNegate() {
+			return false
+		}
+	}
+
+	if search := searchTerms(in.GetSearch()); len(search) > 0 {
+		terms := append(textTerms(strings.Join(objectNames(ingestionLog.GetEntity().ProtoReflect()), " ")), textTerms(ingestionLog.GetError().GetMessage())...)
+		if !containsTerms(terms, search) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchFieldFilter returns whether an indexed field of an ingestion log matches any of the values of a filter
+func matchFieldFilter(f *reconcilerpb.IngestionLogFilter, ingestionLog *reconcilerpb.IngestionLog) bool {
+	var terms []string
+	switch f.GetField() {
+	case "object_name":
+		terms = textTerms(strings.Join(objectNames(ingestionLog.GetEntity().ProtoReflect()), " "))
+	case "error_message":
+		terms = textTerms(ingestionLog.GetError().GetMessage())
+	default:
+		return slices.Contains(f.GetValues(), tagValue(f.GetField(), ingestionLog))
+	}
+
+	for _, v := range f.GetValues() {
+		if containsTerms(terms, searchTerms(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// tagValue returns the value of an indexed tag field of an ingestion log
+func tagValue(field string, ingestionLog *reconcilerpb.IngestionLog) string {
+	switch field {
+	case "data_type":
+		return ingestionLog.GetDataType()
+	case "state":
+		return ingestionLog.GetState().String()
+	case "request_id":
+		return ingestionLog.GetRequestId()
+	case "producer_app_name":
+		return ingestionLog.GetProducerAppName()
+	case "producer_app_version":
+		return ingestionLog.GetProducerAppVersion()
+	case "sdk_name":
+		return ingestionLog.GetSdkName()
+	case "sdk_version":
+		return ingestionLog.GetSdkVersion()
+	case "change_set_id":
+		return ingestionLog.GetChangeSet().GetId()
+	}
+	return ""
+}
+
+// objectNames returns the names of an ingested object and its nested objects
+func objectNames(m protoreflect.Message) []string {
+	var names []string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.Kind() == protoreflect.StringKind && fd.Name() == "name" && !fd.IsList():
+			names = append(names, v.String())
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				names = append(names, objectNames(v.List().Get(i).Message())...)
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			names = append(names, objectNames(v.Message())...)
+		}
+		return true
+	})
+	return names
+}
+
+// textTerms returns the lowercase terms of a text, split as indexed text fields are
+func textTerms(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(textSeparators, r)
+	})
+}
+
+// searchTerms returns the terms of a text searched, without the stop-words RediSearch ignores
+func searchTerms(s string) []string {
+	return slices.DeleteFunc(textTerms(s), func(term string) bool {
+		return slices.Contains(stopWords, term)
+	})
+}
+
+// containsTerms returns whether all the terms searched are found among the terms of a text
+func containsTerms(terms []string, search []string) bool {
+	for _, term := range search {
+		if !slices.Contains(terms, term) {
+			return false
+		}
+	}
+	return true
+}
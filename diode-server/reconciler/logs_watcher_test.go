@@ -0,0 +1,221 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+)
+
+func TestMatchIngestionLog(t *testing.T) {
+	ingestionLog := &reconcilerpb.IngestionLog{
+		Id:                 "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
+		DataType:           "dcim.interface",
+		State:              reconcilerpb.State_FAILED,
+		RequestId:          "req-id",
+		IngestionTs:        200,
+		ProducerAppName:    "diode-agent",
+		ProducerAppVersion: "0.0.1",
+		SdkName:            "diode-sdk-go",
+		SdkVersion:         "0.1.0",
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Interface{
+				Interface: &diodepb.Interface{
+					Name:   "Gig 2",
+					Device: &diodepb.Device{Name: "router-01", Site: &diodepb.Site{Name: "HQ"}},
+					Tags:   []*diodepb.Tag{{Name: "uplink"}},
+				},
+			},
+		},
+		Error: &reconcilerpb.IngestionError{Message: "failed to apply change set: connection timeout"},
+	}
+	state := reconcilerpb.State_FAILED
+	reconciled := reconcilerpb.State_RECONCILED
+
+	tests := []struct {
+		name string
+		in   *reconcilerpb.WatchIngestionLogsRequest
+		want bool
+	}{
+		{
+			name: "no filters",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{},
+			want: true,
+		},
+		{
+			name: "state, data type, request ID and ingestion timestamp range",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{State: &state, DataType: "dcim.interface", RequestId: "req-id", IngestionTsStart: 100, IngestionTsEnd: 200},
+			want: true,
+		},
+		{
+			name: "other state",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{State: &reconciled},
+			want: false,
+		},
+		{
+			name: "before ingestion timestamp range",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{IngestionTsStart: 300},
+			want: false,
+		},
+		{
+			name: "tag field matching any value",
+			in: &reconcilerpb.WatchIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "sdk_name", Values: []string{"diode-sdk-python", "diode-sdk-go"}},
+			}},
+			want: true,
+		},
+		{
+			name: "negated tag field",
+			in: &reconcilerpb.WatchIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "producer_app_name", Values: []string{"diode-agent"}, Negate: true},
+			}},
+			want: false,
+		},
+		{
+			name: "nested object name",
+			in: &reconcilerpb.WatchIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "object_name", Values: []string{"Router-01"}},
+			}},
+			want: true,
+		},
+		{
+			name: "negated error message",
+			in: &reconcilerpb.WatchIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "error_message", Values: []string{"timeout"}, Negate: true},
+			}},
+			want: false,
+		},
+		{
+			name: "search over object names and error message",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{Search: "uplink timeout"},
+			want: true,
+		},
+		{
+			name: "search with stop-words",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{Search: "the timeout of an uplink"},
+			want: true,
+		},
+		{
+			name: "text filter with stop-words",
+			in: &reconcilerpb.WatchIngestionLogsRequest{Filters: []*reconcilerpb.IngestionLogFilter{
+				{Field: "error_message", Values: []string{"timeout for a connection"}},
+			}},
+			want: true,
+		},
+		{
+			name: "search term not stemmed",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{Search: "timeouts"},
+			want: false,
+		},
+		{
+			name: "search term not found",
+			in:   &reconcilerpb.WatchIngestionLogsRequest{Search: "uplink refused"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchIngestionLog(tt.in, ingestionLog))
+		})
+	}
+}
+
+func TestWatchIngestionLogs(t *testing.T) {
+	message := func(t *testing.T, ingestionLog *reconcilerpb.IngestionLog) *redis.Message {
+		b, err := protojson.Marshal(ingestionLog)
+		require.NoError(t, err)
+		return &redis.Message{Channel: RedisIngestionLogsChannel, Payload: string(normalizeIngestionLog(b))}
+	}
+
+	tests := []struct {
+		name     string
+		messages func(t *testing.T) []*redis.Message
+		sendErr  error
+		wantIDs  []string
+		wantErr  bool
+	}{
+		{
+			name: "matching ingestion logs sent",
+			messages: func(t *testing.T) []*redis.Message {
+				return []*redis.Message{
+					message(t, &reconcilerpb.IngestionLog{Id: "1", DataType: "dcim.site", IngestionTs: 100}),
+					message(t, &reconcilerpb.IngestionLog{Id: "2", DataType: "dcim.device", IngestionTs: 100}),
+					{Channel: RedisIngestionLogsChannel, Payload: "invalid"},
+					message(t, &reconcilerpb.IngestionLog{Id: "3", DataType: "dcim.site", IngestionTs: 200}),
+				}
+			},
+			wantIDs: []string{"1", "3"},
+			wantErr: true,
+		},
+		{
+			name: "send error",
+			messages: func(t *testing.T) []*redis.Message {
+				return []*redis.Message{message(t, &reconcilerpb.IngestionLog{Id: "1", DataType: "dcim.site"})}
+			},
+			sendErr: errors.New("stream closed"),
+			wantIDs: []string{"1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+			messages := make(chan *redis.Message, 10)
+			for _, msg := range tt.messages(t) {
+				messages <- msg
+			}
+			// the subscription being closed ends watching
+			close(messages)
+
+			var ids []string
+			err := watchIngestionLogs(context.Background(), logger, messages, &reconcilerpb.WatchIngestionLogsRequest{DataType: "dcim.site"}, func(ingestionLog *reconcilerpb.IngestionLog) error {
+				ids = append(ids, ingestionLog.GetId())
+				return tt.sendErr
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestQueryRequest(t *testing.T) {
+	state := reconcilerpb.State_FAILED
+	filters := []*reconcilerpb.IngestionLogFilter{{Field: "error_message", Values: []string{"timeout"}}}
+
+	got, err := buildQueryFilter(queryRequest(&reconcilerpb.WatchIngestionLogsRequest{
+		State:            &state,
+		DataType:         "dcim.interface",
+		RequestId:        "req-id",
+		IngestionTsStart: 100,
+		IngestionTsEnd:   200,
+		Filters:          filters,
+		Search:           "uplink",
+	}))
+	require.NoError(t, err)
+
+	want, err := buildQueryFilter(&reconcilerpb.RetrieveIngestionLogsRequest{
+		State:            &state,
+		DataType:         "dcim.interface",
+		RequestId:        "req-id",
+		IngestionTsStart: 100,
+		IngestionTsEnd:   200,
+		Filters:          filters,
+		Search:           "uplink",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
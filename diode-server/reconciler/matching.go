@@ -0,0 +1,74 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// MatchingConfig is the matching configuration
+//
+// Default holds the matching strategies per object type applied to all data sources, DataSources holds strategies
+// per producer app name and object type replacing the default ones
+type MatchingConfig struct {
+	Default     map[string]netbox.MatchingStrategy            `yaml:"default"`
+	DataSources map[string]map[string]netbox.MatchingStrategy `yaml:"data_sources"`
+}
+
+// LoadMatchingConfig loads and validates a matching configuration file
+func LoadMatchingConfig(path string) (*MatchingConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read matching config: %v", err)
+	}
+
+	var cfg MatchingConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal matching config: %v", err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid matching config: %v", err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *MatchingConfig) validate() error {
+	errs := make([]error, 0)
+
+	for objectType, strategy := range c.Default {
+		if err := netbox.ValidateMatchingStrategy(objectType, strategy); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for producerAppName, strategies := range c.DataSources {
+		for objectType, strategy := range strategies {
+			if err := netbox.ValidateMatchingStrategy(objectType, strategy); err != nil {
+				errs = append(errs, fmt.Errorf("data source %s: %w", producerAppName, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Strategies returns the matching strategies per object type of a data source
+func (c *MatchingConfig) Strategies(producerAppName string) map[string]netbox.MatchingStrategy {
+	strategies := make(map[string]netbox.MatchingStrategy)
+
+	for objectType, strategy := range c.Default {
+		strategies[objectType] = strategy
+	}
+
+	for objectType, strategy := range c.DataSources[producerAppName] {
+		strategies[objectType] = strategy
+	}
+
+	return strategies
+}
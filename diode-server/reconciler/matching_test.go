@@ -0,0 +1,94 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestLoadMatchingConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         string
+		wantStrategies map[string]map[string]netbox.MatchingStrategy
+		wantErr        bool
+	}{
+		{
+			name: "data source strategies replace default ones",
+			config: `
+default:
+  dcim.device: [serial, name]
+  virtualization.virtualmachine: [primary_ip, name]
+data_sources:
+  orb-agent:
+    dcim.device: [asset_tag, device_fqdn, name]
+`,
+			wantStrategies: map[string]map[string]netbox.MatchingStrategy{
+				"orb-agent": {
+					"dcim.device":                   {netbox.MatchingKeyAssetTag, netbox.MatchingKeyDeviceFQDN, netbox.MatchingKeyName},
+					"virtualization.virtualmachine": {netbox.MatchingKeyPrimaryIP, netbox.MatchingKeyName},
+				},
+				"other-producer": {
+					"dcim.device":                   {netbox.MatchingKeySerial, netbox.MatchingKeyName},
+					"virtualization.virtualmachine": {netbox.MatchingKeyPrimaryIP, netbox.MatchingKeyName},
+				},
+			},
+		},
+		{
+			name: "unsupported object type",
+			config: `
+default:
+  dcim.site: [name]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unsupported matching key",
+			config: `
+data_sources:
+  orb-agent:
+    virtualization.virtualmachine: [serial]
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate matching key",
+			config: `
+default:
+  dcim.device: [serial, serial]
+`,
+			wantErr: true,
+		},
+		{
+			name: "empty strategy",
+			config: `
+default:
+  dcim.device: []
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "matching.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			cfg, err := reconciler.LoadMatchingConfig(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for producerAppName, wantStrategies := range tt.wantStrategies {
+				assert.Equal(t, wantStrategies, cfg.Strategies(producerAppName))
+			}
+		})
+	}
+}
@@ -52,6 +52,18 @@ func migrate(ctx context.Context, logger *slog.Logger, redisClient RedisClient)
 			name: "0001_initial",
 			run:  initialMigration(),
 		},
+		{
+			name: "0002_change_set_id",
+			run:  changeSetIDMigration(),
+		},
+		{
+			name: "0003_ingestion_log_filters",
+			run:  ingestionLogFiltersMigration(),
+		},
+		{
+			name: "0004_ingestion_log_id",
+			run:  ingestionLogIDMigration(),
+		},
 	}
 
 	for _, m := range migrations {
@@ -162,3 +174,135 @@ func initialMigration() func(context.Context, *slog.Logger, RedisClient) error {
 		return nil
 	}
 }
+
+func changeSetIDMigration() func(context.Context, *slog.Logger, RedisClient) error {
+	return func(ctx context.Context, logger *slog.Logger, redisClient RedisClient) error {
+		// Index the change set ID of ingest entities, existing keys being reindexed
+		logger.Debug("altering index", "name", RedisIngestEntityIndexName)
+		queryArgs := []interface{}{
+			"FT.ALTER",
+			RedisIngestEntityIndexName,
+			"SCHEMA",
+			"ADD",
+			"$.changeSet.id",
+			"AS",
+			"change_set_id",
+			"TAG",
+		}
+
+		if _, err := redisClient.Do(ctx, queryArgs...).Result(); err != nil {
+			return fmt.Errorf("failed to alter FT index %s: %v", RedisIngestEntityIndexName, err)
+		}
+
+		return nil
+	}
+}
+
+func ingestionLogFiltersMigration() func(context.Context, *slog.Logger, RedisClient) error {
+	return func(ctx context.Context, logger *slog.Logger, redisClient RedisClient) error {
+		// Drop FT index ingest-entity to make existing fields sortable, keys being kept and reindexed
+		logger.Debug("dropping index", "name", RedisIngestEntityIndexName)
+		_, err := redisClient.Do(ctx, "FT.DROPINDEX", RedisIngestEntityIndexName).Result()
+		if err != nil && !errors.Is(err, redis.Nil) && err.Error() != "Unknown Index name" {
+			return fmt.Errorf("failed to drop FT index %s: %v", RedisIngestEntityIndexName, err)
+		}
+
+		// Create FT index ingest-entity indexing the SDK, error message and object names of ingest entities
+		logger.Debug("creating index", "name", RedisIngestEntityIndexName)
+		queryArgs := []interface{}{
+			"FT.CREATE",
+			RedisIngestEntityIndexName,
+			"ON",
+			"JSON",
+			"PREFIX",
+			"1",
+			"ingest-entity:",
+			"SCHEMA",
+			"$.id",
+			"AS",
+			"id",
+			"TEXT",
+			"SORTABLE",
+			"$.dataType",
+			"AS",
+			"data_type",
+			"TAG",
+			"SORTABLE",
+			"$.state",
+			"AS",
+			"state",
+			"TAG",
+			"SORTABLE",
+			"$.requestId",
+			"AS",
+			"request_id",
+			"TAG",
+			"$.producerAppName",
+			"AS",
+			"producer_app_name",
+			"TAG",
+			"SORTABLE",
+			"$.producerAppVersion",
+			"AS",
+			"producer_app_version",
+			"TAG",
+			"$.sdkName",
+			"AS",
+			"sdk_name",
+			"TAG",
+			"$.sdkVersion",
+			"AS",
+			"sdk_version",
+			"TAG",
+			"$.ingestionTs",
+			"AS",
+			"ingestion_ts",
+			"NUMERIC",
+			"SORTABLE",
+			"$.changeSet.id",
+			"AS",
+			"change_set_id",
+			"TAG",
+			"$.error.message",
+			"AS",
+			"error_message",
+			"TEXT",
+			"$.entity..name",
+			"AS",
+			"object_name",
+			"TEXT",
+		}
+
+		if _, err = redisClient.Do(ctx, queryArgs...).Result(); err != nil {
+			return fmt.Errorf("failed to create FT index %s: %v", RedisIngestEntityIndexName, err)
+		}
+
+		return nil
+	}
+}
+
+func ingestionLogIDMigration() func(context.Context, *slog.Logger, RedisClient) error {
+	return func(ctx context.Context, logger *slog.Logger, redisClient RedisClient) error {
+		// Index the ID of ingest entities as is to sort and page ingestion logs by it, existing keys being reindexed
+		logger.Debug("altering index", "name", RedisIngestEntityIndexName)
+		queryArgs := []interface{}{
+			"FT.ALTER",
+			RedisIngestEntityIndexName,
+			"SCHEMA",
+			"ADD",
+			"$.id",
+			"AS",
+			"ingestion_log_id",
+			"TAG",
+			"CASESENSITIVE",
+			"SORTABLE",
+			"UNF",
+		}
+
+		if _, err := redisClient.Do(ctx, queryArgs...).Result(); err != nil {
+			return fmt.Errorf("failed to alter FT index %s: %v", RedisIngestEntityIndexName, err)
+		}
+
+		return nil
+	}
+}
@@ -29,7 +29,7 @@ func TestMigrate(t *testing.T) {
 		},
 		{
 			name:              "applied migrations found",
-			appliedMigrations: []MigrationLog{{Name: "0001_initial", ApplyTs: time.Now().Unix()}},
+			appliedMigrations: []MigrationLog{{Name: "0001_initial", ApplyTs: time.Now().Unix()}, {Name: "0002_change_set_id", ApplyTs: time.Now().Unix()}, {Name: "0003_ingestion_log_filters", ApplyTs: time.Now().Unix()}, {Name: "0004_ingestion_log_id", ApplyTs: time.Now().Unix()}},
 			err:               nil,
 		},
 	}
@@ -100,6 +100,71 @@ func TestMigrate(t *testing.T) {
 					"NUMERIC",
 					"SORTABLE",
 				).Return(cmd)
+				mockRedisClient.On("Do", context.Background(), "FT.ALTER", RedisIngestEntityIndexName, "SCHEMA", "ADD", "$.changeSet.id", "AS", "change_set_id", "TAG").Return(cmd)
+				mockRedisClient.On("Do", context.Background(), "FT.ALTER", RedisIngestEntityIndexName, "SCHEMA", "ADD", "$.id", "AS", "ingestion_log_id", "TAG", "CASESENSITIVE", "SORTABLE", "UNF").Return(cmd)
+				mockRedisClient.On("Do", context.Background(),
+					"FT.CREATE",
+					RedisIngestEntityIndexName,
+					"ON",
+					"JSON",
+					"PREFIX",
+					"1",
+					"ingest-entity:",
+					"SCHEMA",
+					"$.id",
+					"AS",
+					"id",
+					"TEXT",
+					"SORTABLE",
+					"$.dataType",
+					"AS",
+					"data_type",
+					"TAG",
+					"SORTABLE",
+					"$.state",
+					"AS",
+					"state",
+					"TAG",
+					"SORTABLE",
+					"$.requestId",
+					"AS",
+					"request_id",
+					"TAG",
+					"$.producerAppName",
+					"AS",
+					"producer_app_name",
+					"TAG",
+					"SORTABLE",
+					"$.producerAppVersion",
+					"AS",
+					"producer_app_version",
+					"TAG",
+					"$.sdkName",
+					"AS",
+					"sdk_name",
+					"TAG",
+					"$.sdkVersion",
+					"AS",
+					"sdk_version",
+					"TAG",
+					"$.ingestionTs",
+					"AS",
+					"ingestion_ts",
+					"NUMERIC",
+					"SORTABLE",
+					"$.changeSet.id",
+					"AS",
+					"change_set_id",
+					"TAG",
+					"$.error.message",
+					"AS",
+					"error_message",
+					"TEXT",
+					"$.entity..name",
+					"AS",
+					"object_name",
+					"TEXT",
+				).Return(cmd)
 				mockRedisClient.On("Do", context.Background(), "JSON.SET", RedisDiodeMigrationsKey, "$", mock.Anything).Return(cmd)
 			} else {
 				getAppliedMigrationsRespCmd := redis.NewCmd(ctx)
@@ -218,6 +218,154 @@ func (_c *Client_RetrieveIngestionLogs_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// RevertChangeSet provides a mock function with given fields: ctx, req, opt
+func (_m *Client) RevertChangeSet(ctx context.Context, req *reconcilerpb.RevertChangeSetRequest, opt ...grpc.CallOption) (*reconcilerpb.RevertChangeSetResponse, error) {
+	_va := make([]interface{}, len(opt))
+	for _i := range opt {
+		_va[_i] = opt[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, req)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertChangeSet")
+	}
+
+	var r0 *reconcilerpb.RevertChangeSetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *reconcilerpb.RevertChangeSetRequest, ...grpc.CallOption) (*reconcilerpb.RevertChangeSetResponse, error)); ok {
+		return rf(ctx, req, opt...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *reconcilerpb.RevertChangeSetRequest, ...grpc.CallOption) *reconcilerpb.RevertChangeSetResponse); ok {
+		r0 = rf(ctx, req, opt...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reconcilerpb.RevertChangeSetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *reconcilerpb.RevertChangeSetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, req, opt...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_RevertChangeSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertChangeSet'
+type Client_RevertChangeSet_Call struct {
+	*mock.Call
+}
+
+// RevertChangeSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *reconcilerpb.RevertChangeSetRequest
+//   - opt ...grpc.CallOption
+func (_e *Client_Expecter) RevertChangeSet(ctx interface{}, req interface{}, opt ...interface{}) *Client_RevertChangeSet_Call {
+	return &Client_RevertChangeSet_Call{Call: _e.mock.On("RevertChangeSet",
+		append([]interface{}{ctx, req}, opt...)...)}
+}
+
+func (_c *Client_RevertChangeSet_Call) Run(run func(ctx context.Context, req *reconcilerpb.RevertChangeSetRequest, opt ...grpc.CallOption)) *Client_RevertChangeSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*reconcilerpb.RevertChangeSetRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_RevertChangeSet_Call) Return(_a0 *reconcilerpb.RevertChangeSetResponse, _a1 error) *Client_RevertChangeSet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_RevertChangeSet_Call) RunAndReturn(run func(context.Context, *reconcilerpb.RevertChangeSetRequest, ...grpc.CallOption) (*reconcilerpb.RevertChangeSetResponse, error)) *Client_RevertChangeSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchIngestionLogs provides a mock function with given fields: ctx, req, opt
+func (_m *Client) WatchIngestionLogs(ctx context.Context, req *reconcilerpb.WatchIngestionLogsRequest, opt ...grpc.CallOption) (reconcilerpb.ReconcilerService_WatchIngestionLogsClient, error) {
+	_va := make([]interface{}, len(opt))
+	for _i := range opt {
+		_va[_i] = opt[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, req)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchIngestionLogs")
+	}
+
+	var r0 reconcilerpb.ReconcilerService_WatchIngestionLogsClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *reconcilerpb.WatchIngestionLogsRequest, ...grpc.CallOption) (reconcilerpb.ReconcilerService_WatchIngestionLogsClient, error)); ok {
+		return rf(ctx, req, opt...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *reconcilerpb.WatchIngestionLogsRequest, ...grpc.CallOption) reconcilerpb.ReconcilerService_WatchIngestionLogsClient); ok {
+		r0 = rf(ctx, req, opt...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(reconcilerpb.ReconcilerService_WatchIngestionLogsClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *reconcilerpb.WatchIngestionLogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, req, opt...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_WatchIngestionLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchIngestionLogs'
+type Client_WatchIngestionLogs_Call struct {
+	*mock.Call
+}
+
+// WatchIngestionLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *reconcilerpb.WatchIngestionLogsRequest
+//   - opt ...grpc.CallOption
+func (_e *Client_Expecter) WatchIngestionLogs(ctx interface{}, req interface{}, opt ...interface{}) *Client_WatchIngestionLogs_Call {
+	return &Client_WatchIngestionLogs_Call{Call: _e.mock.On("WatchIngestionLogs",
+		append([]interface{}{ctx, req}, opt...)...)}
+}
+
+func (_c *Client_WatchIngestionLogs_Call) Run(run func(ctx context.Context, req *reconcilerpb.WatchIngestionLogsRequest, opt ...grpc.CallOption)) *Client_WatchIngestionLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*reconcilerpb.WatchIngestionLogsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_WatchIngestionLogs_Call) Return(_a0 reconcilerpb.ReconcilerService_WatchIngestionLogsClient, _a1 error) *Client_WatchIngestionLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_WatchIngestionLogs_Call) RunAndReturn(run func(context.Context, *reconcilerpb.WatchIngestionLogsRequest, ...grpc.CallOption) (reconcilerpb.ReconcilerService_WatchIngestionLogsClient, error)) *Client_WatchIngestionLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClient(t interface {
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	redis "github.com/redis/go-redis/v9"
+
+	time "time"
 )
 
 // RedisClient is an autogenerated mock type for the RedisClient type
@@ -190,6 +192,391 @@ func (_c *RedisClient_Do_Call) RunAndReturn(run func(context.Context, ...interfa
 	return _c
 }
 
+// Eval provides a mock function with given fields: ctx, script, keys, args
+func (_m *RedisClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, script, keys)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Eval")
+	}
+
+	var r0 *redis.Cmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) *redis.Cmd); ok {
+		r0 = rf(ctx, script, keys, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.Cmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_Eval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Eval'
+type RedisClient_Eval_Call struct {
+	*mock.Call
+}
+
+// Eval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - script string
+//   - keys []string
+//   - args ...interface{}
+func (_e *RedisClient_Expecter) Eval(ctx interface{}, script interface{}, keys interface{}, args ...interface{}) *RedisClient_Eval_Call {
+	return &RedisClient_Eval_Call{Call: _e.mock.On("Eval",
+		append([]interface{}{ctx, script, keys}, args...)...)}
+}
+
+func (_c *RedisClient_Eval_Call) Run(run func(ctx context.Context, script string, keys []string, args ...interface{})) *RedisClient_Eval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RedisClient_Eval_Call) Return(_a0 *redis.Cmd) *RedisClient_Eval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_Eval_Call) RunAndReturn(run func(context.Context, string, []string, ...interface{}) *redis.Cmd) *RedisClient_Eval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expire provides a mock function with given fields: ctx, key, expiration
+func (_m *RedisClient) Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd {
+	ret := _m.Called(ctx, key, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expire")
+	}
+
+	var r0 *redis.BoolCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) *redis.BoolCmd); ok {
+		r0 = rf(ctx, key, expiration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.BoolCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_Expire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expire'
+type RedisClient_Expire_Call struct {
+	*mock.Call
+}
+
+// Expire is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - expiration time.Duration
+func (_e *RedisClient_Expecter) Expire(ctx interface{}, key interface{}, expiration interface{}) *RedisClient_Expire_Call {
+	return &RedisClient_Expire_Call{Call: _e.mock.On("Expire", ctx, key, expiration)}
+}
+
+func (_c *RedisClient_Expire_Call) Run(run func(ctx context.Context, key string, expiration time.Duration)) *RedisClient_Expire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *RedisClient_Expire_Call) Return(_a0 *redis.BoolCmd) *RedisClient_Expire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_Expire_Call) RunAndReturn(run func(context.Context, string, time.Duration) *redis.BoolCmd) *RedisClient_Expire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HDel provides a mock function with given fields: ctx, key, fields
+func (_m *RedisClient) HDel(ctx context.Context, key string, fields ...string) *redis.IntCmd {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HDel")
+	}
+
+	var r0 *redis.IntCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) *redis.IntCmd); ok {
+		r0 = rf(ctx, key, fields...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.IntCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_HDel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HDel'
+type RedisClient_HDel_Call struct {
+	*mock.Call
+}
+
+// HDel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - fields ...string
+func (_e *RedisClient_Expecter) HDel(ctx interface{}, key interface{}, fields ...interface{}) *RedisClient_HDel_Call {
+	return &RedisClient_HDel_Call{Call: _e.mock.On("HDel",
+		append([]interface{}{ctx, key}, fields...)...)}
+}
+
+func (_c *RedisClient_HDel_Call) Run(run func(ctx context.Context, key string, fields ...string)) *RedisClient_HDel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RedisClient_HDel_Call) Return(_a0 *redis.IntCmd) *RedisClient_HDel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_HDel_Call) RunAndReturn(run func(context.Context, string, ...string) *redis.IntCmd) *RedisClient_HDel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HGet provides a mock function with given fields: ctx, key, field
+func (_m *RedisClient) HGet(ctx context.Context, key string, field string) *redis.StringCmd {
+	ret := _m.Called(ctx, key, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HGet")
+	}
+
+	var r0 *redis.StringCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *redis.StringCmd); ok {
+		r0 = rf(ctx, key, field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.StringCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_HGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HGet'
+type RedisClient_HGet_Call struct {
+	*mock.Call
+}
+
+// HGet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - field string
+func (_e *RedisClient_Expecter) HGet(ctx interface{}, key interface{}, field interface{}) *RedisClient_HGet_Call {
+	return &RedisClient_HGet_Call{Call: _e.mock.On("HGet", ctx, key, field)}
+}
+
+func (_c *RedisClient_HGet_Call) Run(run func(ctx context.Context, key string, field string)) *RedisClient_HGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RedisClient_HGet_Call) Return(_a0 *redis.StringCmd) *RedisClient_HGet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_HGet_Call) RunAndReturn(run func(context.Context, string, string) *redis.StringCmd) *RedisClient_HGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HGetAll provides a mock function with given fields: ctx, key
+func (_m *RedisClient) HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HGetAll")
+	}
+
+	var r0 *redis.MapStringStringCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string) *redis.MapStringStringCmd); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.MapStringStringCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_HGetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HGetAll'
+type RedisClient_HGetAll_Call struct {
+	*mock.Call
+}
+
+// HGetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *RedisClient_Expecter) HGetAll(ctx interface{}, key interface{}) *RedisClient_HGetAll_Call {
+	return &RedisClient_HGetAll_Call{Call: _e.mock.On("HGetAll", ctx, key)}
+}
+
+func (_c *RedisClient_HGetAll_Call) Run(run func(ctx context.Context, key string)) *RedisClient_HGetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RedisClient_HGetAll_Call) Return(_a0 *redis.MapStringStringCmd) *RedisClient_HGetAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_HGetAll_Call) RunAndReturn(run func(context.Context, string) *redis.MapStringStringCmd) *RedisClient_HGetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HSet provides a mock function with given fields: ctx, key, values
+func (_m *RedisClient) HSet(ctx context.Context, key string, values ...interface{}) *redis.IntCmd {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, key)
+	_ca = append(_ca, values...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HSet")
+	}
+
+	var r0 *redis.IntCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) *redis.IntCmd); ok {
+		r0 = rf(ctx, key, values...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.IntCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_HSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HSet'
+type RedisClient_HSet_Call struct {
+	*mock.Call
+}
+
+// HSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - values ...interface{}
+func (_e *RedisClient_Expecter) HSet(ctx interface{}, key interface{}, values ...interface{}) *RedisClient_HSet_Call {
+	return &RedisClient_HSet_Call{Call: _e.mock.On("HSet",
+		append([]interface{}{ctx, key}, values...)...)}
+}
+
+func (_c *RedisClient_HSet_Call) Run(run func(ctx context.Context, key string, values ...interface{})) *RedisClient_HSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RedisClient_HSet_Call) Return(_a0 *redis.IntCmd) *RedisClient_HSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_HSet_Call) RunAndReturn(run func(context.Context, string, ...interface{}) *redis.IntCmd) *RedisClient_HSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HSetNX provides a mock function with given fields: ctx, key, field, value
+func (_m *RedisClient) HSetNX(ctx context.Context, key string, field string, value interface{}) *redis.BoolCmd {
+	ret := _m.Called(ctx, key, field, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HSetNX")
+	}
+
+	var r0 *redis.BoolCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, interface{}) *redis.BoolCmd); ok {
+		r0 = rf(ctx, key, field, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.BoolCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_HSetNX_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HSetNX'
+type RedisClient_HSetNX_Call struct {
+	*mock.Call
+}
+
+// HSetNX is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - field string
+//   - value interface{}
+func (_e *RedisClient_Expecter) HSetNX(ctx interface{}, key interface{}, field interface{}, value interface{}) *RedisClient_HSetNX_Call {
+	return &RedisClient_HSetNX_Call{Call: _e.mock.On("HSetNX", ctx, key, field, value)}
+}
+
+func (_c *RedisClient_HSetNX_Call) Run(run func(ctx context.Context, key string, field string, value interface{})) *RedisClient_HSetNX_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *RedisClient_HSetNX_Call) Return(_a0 *redis.BoolCmd) *RedisClient_HSetNX_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_HSetNX_Call) RunAndReturn(run func(context.Context, string, string, interface{}) *redis.BoolCmd) *RedisClient_HSetNX_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ping provides a mock function with given fields: ctx
 func (_m *RedisClient) Ping(ctx context.Context) *redis.StatusCmd {
 	ret := _m.Called(ctx)
@@ -285,6 +672,56 @@ func (_c *RedisClient_Pipeline_Call) RunAndReturn(run func() redis.Pipeliner) *R
 	return _c
 }
 
+// Publish provides a mock function with given fields: ctx, channel, message
+func (_m *RedisClient) Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd {
+	ret := _m.Called(ctx, channel, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 *redis.IntCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) *redis.IntCmd); ok {
+		r0 = rf(ctx, channel, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.IntCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type RedisClient_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - message interface{}
+func (_e *RedisClient_Expecter) Publish(ctx interface{}, channel interface{}, message interface{}) *RedisClient_Publish_Call {
+	return &RedisClient_Publish_Call{Call: _e.mock.On("Publish", ctx, channel, message)}
+}
+
+func (_c *RedisClient_Publish_Call) Run(run func(ctx context.Context, channel string, message interface{})) *RedisClient_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *RedisClient_Publish_Call) Return(_a0 *redis.IntCmd) *RedisClient_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_Publish_Call) RunAndReturn(run func(context.Context, string, interface{}) *redis.IntCmd) *RedisClient_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Scan provides a mock function with given fields: ctx, cursor, match, count
 func (_m *RedisClient) Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd {
 	ret := _m.Called(ctx, cursor, match, count)
@@ -336,6 +773,120 @@ func (_c *RedisClient_Scan_Call) RunAndReturn(run func(context.Context, uint64,
 	return _c
 }
 
+// SetNX provides a mock function with given fields: ctx, key, value, expiration
+func (_m *RedisClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd {
+	ret := _m.Called(ctx, key, value, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNX")
+	}
+
+	var r0 *redis.BoolCmd
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, time.Duration) *redis.BoolCmd); ok {
+		r0 = rf(ctx, key, value, expiration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.BoolCmd)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_SetNX_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetNX'
+type RedisClient_SetNX_Call struct {
+	*mock.Call
+}
+
+// SetNX is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value interface{}
+//   - expiration time.Duration
+func (_e *RedisClient_Expecter) SetNX(ctx interface{}, key interface{}, value interface{}, expiration interface{}) *RedisClient_SetNX_Call {
+	return &RedisClient_SetNX_Call{Call: _e.mock.On("SetNX", ctx, key, value, expiration)}
+}
+
+func (_c *RedisClient_SetNX_Call) Run(run func(ctx context.Context, key string, value interface{}, expiration time.Duration)) *RedisClient_SetNX_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *RedisClient_SetNX_Call) Return(_a0 *redis.BoolCmd) *RedisClient_SetNX_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_SetNX_Call) RunAndReturn(run func(context.Context, string, interface{}, time.Duration) *redis.BoolCmd) *RedisClient_SetNX_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, channels
+func (_m *RedisClient) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	_va := make([]interface{}, len(channels))
+	for _i := range channels {
+		_va[_i] = channels[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *redis.PubSub
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) *redis.PubSub); ok {
+		r0 = rf(ctx, channels...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.PubSub)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type RedisClient_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channels ...string
+func (_e *RedisClient_Expecter) Subscribe(ctx interface{}, channels ...interface{}) *RedisClient_Subscribe_Call {
+	return &RedisClient_Subscribe_Call{Call: _e.mock.On("Subscribe",
+		append([]interface{}{ctx}, channels...)...)}
+}
+
+func (_c *RedisClient_Subscribe_Call) Run(run func(ctx context.Context, channels ...string)) *RedisClient_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RedisClient_Subscribe_Call) Return(_a0 *redis.PubSub) *RedisClient_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_Subscribe_Call) RunAndReturn(run func(context.Context, ...string) *redis.PubSub) *RedisClient_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // XAck provides a mock function with given fields: ctx, stream, group, ids
 func (_m *RedisClient) XAck(ctx context.Context, stream string, group string, ids ...string) *redis.IntCmd {
 	_va := make([]interface{}, len(ids))
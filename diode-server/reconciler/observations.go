@@ -0,0 +1,223 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+// RedisObservationsKeyPrefix is the key prefix for the source timestamps of the last applied observations of the
+// fields of an object
+const RedisObservationsKeyPrefix = "diode.observations"
+
+// claimObservationScript claims the fields of an observation not observed later by another applied or claimed
+// observation, setting the source timestamp of their last observation, and returns the fields observed later as stale
+// along with the fields claimed and their previous last observation (an empty string if none). Timestamps are compared as decimal
+// strings, as Lua numbers can't hold nanoseconds exactly
+const claimObservationScript = `
+local function later(a, b)
+	if #a ~= #b then
+		return #a > #b
+	end
+	return a > b
+end
+
+local stale = {}
+local claimed = {}
+for i = 3, #ARGV do
+	local last = redis.call('HGET', KEYS[1], ARGV[i])
+	if last and later(last, ARGV[1]) then
+		table.insert(stale, ARGV[i])
+	else
+		redis.call('HSET', KEYS[1], ARGV[i], ARGV[1])
+		table.insert(claimed, ARGV[i])
+		table.insert(claimed, last or '')
+	end
+end
+
+if tonumber(ARGV[2]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return {stale, claimed}
+`
+
+// releaseObservationScript restores the previous last observation of the fields claimed by an observation which
+// failed to be applied, unless claimed by another observation since
+const releaseObservationScript = `
+for i = 2, #ARGV, 2 do
+	if redis.call('HGET', KEYS[1], ARGV[i]) == ARGV[1] then
+		if ARGV[i + 1] == '' then
+			redis.call('HDEL', KEYS[1], ARGV[i])
+		else
+			redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+		end
+	end
+end
+return 0
+`
+
+// observation is an observation at source of the fields of the root object of an ingest entity
+type observation struct {
+	key        string
+	fields     []string
+	observedTs int64
+
+	// claimed are the fields claimed by the observation with their previous last observation, "" if none
+	claimed map[string]string
+}
+
+func observationsKey(obj RecordedObject) string {
+	return fmt.Sprintf("%s:%s", RedisObservationsKeyPrefix, obj.field())
+}
+
+func (p *IngestionProcessor) newObservation(ingestEntity changeset.IngestEntity, observedTs int64) (observation, error) {
+	obj, err := p.newRecordedObject(ingestEntity)
+	if err != nil {
+		return observation{}, err
+	}
+
+	var fields []string
+	if entity, ok := ingestEntity.Entity.(*diodepb.Entity); ok {
+		fields = observedFields(entity)
+	}
+
+	return observation{
+		key:        observationsKey(obj),
+		fields:     fields,
+		observedTs: observedTs,
+	}, nil
+}
+
+// observedFields returns the fields set on the root object of an entity, named as in the protobuf schema
+func observedFields(entity *diodepb.Entity) []string {
+	m := entity.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("entity"))
+	if fd == nil {
+		return nil
+	}
+
+	var fields []string
+	m.Get(fd).Message().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, string(fd.Name()))
+		return true
+	})
+	slices.Sort(fields)
+	return fields
+}
+
+// claimObservation claims the fields of an observation before it is applied, so that the observations of the same
+// object reconciled concurrently by other replicas see them as observed, and returns the fields observed at source
+// before their last applied or claimed observation. The claimed fields are released if the observation isn't applied.
+func (p *IngestionProcessor) claimObservation(ctx context.Context, obs *observation) ([]string, error) {
+	if len(obs.fields) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(obs.fields)+2)
+	args = append(args, strconv.FormatInt(obs.observedTs, 10), p.config.StaleObservationTTL.Milliseconds())
+	for _, field := range obs.fields {
+		args = append(args, field)
+	}
+
+	res, err := p.redisClient.Eval(ctx, claimObservationScript, []string{obs.key}, args...).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim observation of %s: %v", obs.key, err)
+	}
+	if len(res) != 2 {
+		return nil, fmt.Errorf("failed to claim observation of %s: unexpected result %v", obs.key, res)
+	}
+
+	staleValues, _ := res[0].([]interface{})
+	claimedValues, _ := res[1].([]interface{})
+
+	stale := make([]string, 0, len(staleValues))
+	for _, v := range staleValues {
+		stale = append(stale, fmt.Sprint(v))
+	}
+
+	obs.claimed = make(map[string]string, len(claimedValues)/2)
+	for i := 0; i+1 < len(claimedValues); i += 2 {
+		obs.claimed[fmt.Sprint(claimedValues[i])] = fmt.Sprint(claimedValues[i+1])
+	}
+
+	if len(stale) == 0 {
+		return nil, nil
+	}
+	return stale, nil
+}
+
+// isStaleObservation returns true if the stale fields of an observation can't be left untouched while applying the
+// others: all of its fields are stale, or some of them aren't fields of the object holding a value
+func isStaleObservation(obs observation, objectType string, staleFields []string) bool {
+	if len(staleFields) == 0 {
+		return false
+	}
+	if len(staleFields) == len(obs.fields) {
+		return true
+	}
+	return netbox.ValidateFieldOwnershipPolicy(objectType, staleFieldsPolicy(staleFields)) != nil
+}
+
+// releaseObservation releases the fields claimed by an observation which wasn't applied, restoring their previous last
+// observation unless claimed by another observation since
+func (p *IngestionProcessor) releaseObservation(ctx context.Context, obs observation) error {
+	if len(obs.claimed) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(obs.claimed))
+	for field := range obs.claimed {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	args := make([]interface{}, 0, 2*len(fields)+1)
+	args = append(args, strconv.FormatInt(obs.observedTs, 10))
+	for _, field := range fields {
+		args = append(args, field, obs.claimed[field])
+	}
+
+	if err := p.redisClient.Eval(ctx, releaseObservationScript, []string{obs.key}, args...).Err(); err != nil {
+		return fmt.Errorf("failed to release observation of %s: %v", obs.key, err)
+	}
+
+	return nil
+}
+
+func staleFieldsPolicy(staleFields []string) netbox.FieldOwnershipPolicy {
+	policy := make(netbox.FieldOwnershipPolicy, len(staleFields))
+	for _, field := range staleFields {
+		policy[field] = netbox.FieldOwnershipNeverTouch
+	}
+	return policy
+}
+
+// withStaleFields returns field ownership policies leaving the stale fields of the root object of an ingest entity
+// untouched, on top of the given policies
+func withStaleFields(policies map[string]netbox.FieldOwnershipPolicy, ingestEntity changeset.IngestEntity) map[string]netbox.FieldOwnershipPolicy {
+	if len(ingestEntity.StaleFields) == 0 {
+		return policies
+	}
+
+	out := maps.Clone(policies)
+	if out == nil {
+		out = make(map[string]netbox.FieldOwnershipPolicy)
+	}
+
+	policy := maps.Clone(out[ingestEntity.DataType])
+	if policy == nil {
+		policy = make(netbox.FieldOwnershipPolicy)
+	}
+	maps.Copy(policy, staleFieldsPolicy(ingestEntity.StaleFields))
+	out[ingestEntity.DataType] = policy
+
+	return out
+}
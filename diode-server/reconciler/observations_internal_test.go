@@ -0,0 +1,193 @@
+package reconciler
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	mnp "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestObservations(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	p := &IngestionProcessor{
+		config:      Config{StaleObservationTTL: 24 * time.Hour},
+		redisClient: redisClient,
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	vm := func(status string, vcpus int32) changeset.IngestEntity {
+		ingestEntity := vmIngestEntity("vm01")
+		ingestEntity.Entity.(*diodepb.Entity).GetVirtualMachine().Status = status
+		ingestEntity.Entity.(*diodepb.Entity).GetVirtualMachine().Vcpus = &vcpus
+		return ingestEntity
+	}
+	observedTs := time.Date(2024, 7, 8, 8, 0, 0, 0, time.UTC).UnixNano()
+	key := "diode.observations:virtualization.virtualmachine?q=vm01&site__name=undefined"
+	last := func(field string) string {
+		return s.HGet(key, field)
+	}
+
+	obs, err := p.newObservation(vm("active", 2), observedTs)
+	require.NoError(t, err)
+	assert.Equal(t, key, obs.key)
+	assert.Equal(t, []string{"name", "status", "vcpus"}, obs.fields)
+
+	staleFields, err := p.claimObservation(ctx, &obs)
+	require.NoError(t, err)
+	assert.Empty(t, staleFields, "first observation is never stale")
+	assert.Equal(t, 24*time.Hour, s.TTL(key), "observations expire")
+
+	// a later observation of the name and status only
+	later := vmIngestEntity("vm01")
+	later.Entity.(*diodepb.Entity).GetVirtualMachine().Status = "offline"
+	obs, err = p.newObservation(later, observedTs+int64(time.Minute))
+	require.NoError(t, err)
+	_, err = p.claimObservation(ctx, &obs)
+	require.NoError(t, err)
+
+	obs, err = p.newObservation(vm("active", 4), observedTs+int64(time.Second))
+	require.NoError(t, err)
+	staleFields, err = p.claimObservation(ctx, &obs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "status"}, staleFields, "fields observed later are stale")
+	assert.False(t, isStaleObservation(obs, "virtualization.virtualmachine", staleFields), "fields observed later are left untouched")
+	assert.Equal(t, strconv.FormatInt(observedTs+int64(time.Second), 10), last("vcpus"), "fields not observed later are claimed")
+
+	// the observation failing to be applied, its claimed fields are restored
+	require.NoError(t, p.releaseObservation(ctx, obs))
+	assert.Equal(t, strconv.FormatInt(observedTs, 10), last("vcpus"))
+
+	obs, err = p.newObservation(vm("active", 4), observedTs-int64(time.Second))
+	require.NoError(t, err)
+	staleFields, err = p.claimObservation(ctx, &obs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "status", "vcpus"}, staleFields)
+	assert.True(t, isStaleObservation(obs, "virtualization.virtualmachine", staleFields), "observation of stale fields only is stale")
+	assert.Empty(t, obs.claimed)
+
+	obs, err = p.newObservation(vm("active", 2), observedTs)
+	require.NoError(t, err)
+	staleFields, err = p.claimObservation(ctx, &obs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "status"}, staleFields, "field observed as late as its last applied observation is not stale")
+
+	res, err := redisClient.HGetAll(ctx, key).Result()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"name":   strconv.FormatInt(observedTs+int64(time.Minute), 10),
+		"status": strconv.FormatInt(observedTs+int64(time.Minute), 10),
+		"vcpus":  strconv.FormatInt(observedTs, 10),
+	}, res)
+
+	// an observation claimed concurrently by another replica makes older ones stale, and is kept when the older one is
+	// released
+	first, err := p.newObservation(vm("active", 8), observedTs+2*int64(time.Minute))
+	require.NoError(t, err)
+	_, err = p.claimObservation(ctx, &first)
+	require.NoError(t, err)
+
+	second, err := p.newObservation(vm("active", 16), observedTs+3*int64(time.Minute))
+	require.NoError(t, err)
+	_, err = p.claimObservation(ctx, &second)
+	require.NoError(t, err)
+
+	staleFields, err = p.claimObservation(ctx, &obs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "status", "vcpus"}, staleFields)
+
+	require.NoError(t, p.releaseObservation(ctx, first))
+	assert.Equal(t, strconv.FormatInt(observedTs+3*int64(time.Minute), 10), last("vcpus"))
+}
+
+func TestIsStaleObservationNestedObject(t *testing.T) {
+	obs := observation{fields: []string{"name", "site", "status"}}
+
+	assert.False(t, isStaleObservation(obs, "virtualization.virtualmachine", []string{"status"}))
+	assert.True(t, isStaleObservation(obs, "virtualization.virtualmachine", []string{"site"}), "nested objects can't be left untouched")
+}
+
+func TestWithStaleFields(t *testing.T) {
+	policies := map[string]netbox.FieldOwnershipPolicy{
+		"virtualization.virtualmachine": {"comments": netbox.FieldOwnershipFillIfEmpty},
+	}
+
+	ingestEntity := vmIngestEntity("vm01")
+	assert.Equal(t, policies, withStaleFields(policies, ingestEntity))
+
+	ingestEntity.StaleFields = []string{"status"}
+	assert.Equal(t, map[string]netbox.FieldOwnershipPolicy{
+		"virtualization.virtualmachine": {"comments": netbox.FieldOwnershipFillIfEmpty, "status": netbox.FieldOwnershipNeverTouch},
+	}, withStaleFields(policies, ingestEntity))
+	assert.Equal(t, map[string]netbox.FieldOwnershipPolicy{
+		"virtualization.virtualmachine": {"comments": netbox.FieldOwnershipFillIfEmpty},
+	}, policies, "policies of the producer are left unchanged")
+
+	assert.Equal(t, map[string]netbox.FieldOwnershipPolicy{
+		"virtualization.virtualmachine": {"status": netbox.FieldOwnershipNeverTouch},
+	}, withStaleFields(nil, ingestEntity))
+}
+
+func TestHandleStreamMessageSkipsStaleObservation(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	observedAt := time.Date(2024, 7, 8, 8, 0, 0, 0, time.UTC)
+	require.NoError(t, redisClient.HSet(ctx, "diode.observations:virtualization.virtualmachine?q=vm01&site__name=undefined", "name", observedAt.UnixNano()).Err())
+
+	// no NetBox calls are expected as the observation is skipped before reconciliation
+	mockNbClient := mnp.NewNetBoxAPI(t)
+
+	p := &IngestionProcessor{
+		config:            Config{StaleObservationSkippingEnabled: true},
+		nbClient:          mockNbClient,
+		redisClient:       miniredisClient{redisClient},
+		redisStreamClient: miniredisClient{redisClient},
+		logger:            slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	reqBytes, err := proto.Marshal(&diodepb.IngestRequest{
+		Id: "req123",
+		Entities: []*diodepb.Entity{
+			{
+				Entity: &diodepb.Entity_VirtualMachine{
+					VirtualMachine: &diodepb.VirtualMachine{Name: "vm01"},
+				},
+				Timestamp: timestamppb.New(observedAt.Add(-time.Hour)),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = p.handleStreamMessage(ctx, redis.XMessage{
+		ID: "1",
+		Values: map[string]interface{}{
+			"request":      string(reqBytes),
+			"ingestion_ts": "1720425600",
+		},
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,264 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/segmentio/ksuid"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+const (
+	// RedisRevertedChangeSetsKey is the key of the hash holding the ID of the change set applied to revert each
+	// reverted change set
+	RedisRevertedChangeSetsKey = "diode.reverted-change-sets"
+
+	// revertSearchPageSize is the number of ingestion logs retrieved per search when looking up the change sets to
+	// revert
+	revertSearchPageSize = 100
+)
+
+// errChangeSetAlreadyReverted is returned when reverting a change set reverted before
+var errChangeSetAlreadyReverted = errors.New("change set already reverted")
+
+// revertChangeSets reverts the change sets of the reconciled ingestion logs selected by the request, most recent
+// first, stopping at the first change set failing to be reverted. Change sets already reverted are skipped, or refused
+// when selected by ID, and change sets applied by reverts are only reverted when selected by ID
+func revertChangeSets(ctx context.Context, logger *slog.Logger, redisClient RedisClient, nbClient netboxdiodeplugin.NetBoxAPI, cache netboxdiodeplugin.ObjectStateCache, in *reconcilerpb.RevertChangeSetRequest) (*reconcilerpb.RevertChangeSetResponse, error) {
+	query, err := buildRevertQueryFilter(in)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := searchIngestionLogs(ctx, logger, redisClient, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// ingestion logs of transactional ingest requests share their change set
+	changeSetIDs := make([]string, 0, len(logs))
+	changeSetLogs := make(map[string][]*reconcilerpb.IngestionLog, len(logs))
+	for _, ingestionLog := range logs {
+		if ingestionLog.GetChangeSet().GetRevertsChangeSetId() != "" && in.GetChangeSetId() == "" {
+			continue
+		}
+
+		changeSetID := ingestionLog.GetChangeSet().GetId()
+		if _, ok := changeSetLogs[changeSetID]; !ok {
+			changeSetIDs = append(changeSetIDs, changeSetID)
+		}
+		changeSetLogs[changeSetID] = append(changeSetLogs[changeSetID], ingestionLog)
+	}
+
+	resp := &reconcilerpb.RevertChangeSetResponse{RevertedChangeSets: make([]*reconcilerpb.RevertedChangeSet, 0, len(changeSetIDs))}
+
+	for _, changeSetID := range changeSetIDs {
+		revertedChangeSet, err := revertChangeSet(ctx, logger, redisClient, nbClient, cache, changeSetLogs[changeSetID])
+		if errors.Is(err, errChangeSetAlreadyReverted) && in.GetChangeSetId() == "" {
+			logger.Debug("skipping change set already reverted", "change_set_id", changeSetID)
+			continue
+		}
+
+		// a change set reverted but failing to be recorded as such is returned along with the error
+		if revertedChangeSet != nil {
+			logger.Debug("change set reverted", "change_set_id", changeSetID, "revert_change_set_id", revertedChangeSet.GetRevertChangeSetId(), "changes", revertedChangeSet.GetChanges())
+			resp.RevertedChangeSets = append(resp.RevertedChangeSets, revertedChangeSet)
+		}
+
+		if err != nil {
+			resp.Error = extractIngestionError(fmt.Errorf("failed to revert change set %s: %w", changeSetID, err))
+			return resp, nil
+		}
+	}
+
+	return resp, nil
+}
+
+// revertChangeSet prepares and applies the change set reverting the change set stored in ingestion logs, once, then
+// records it in an ingestion log of its own and marks the ingestion logs as reverted
+func revertChangeSet(ctx context.Context, logger *slog.Logger, redisClient RedisClient, nbClient netboxdiodeplugin.NetBoxAPI, cache netboxdiodeplugin.ObjectStateCache, logs []*reconcilerpb.IngestionLog) (*reconcilerpb.RevertedChangeSet, error) {
+	stored := logs[0].GetChangeSet()
+
+	for _, ingestionLog := range logs {
+		if ingestionLog.GetState() == reconcilerpb.State_REVERTED {
+			return nil, errChangeSetAlreadyReverted
+		}
+	}
+
+	cs, err := decompressChangeSet(stored.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	revertCS, err := changeset.PrepareRevert(cs, nbClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// the change set is claimed before being reverted so that concurrent reverts don't revert it twice
+	claimed, err := redisClient.HSetNX(ctx, RedisRevertedChangeSetsKey, stored.GetId(), revertCS.ChangeSetID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim change set: %v", err)
+	}
+	if !claimed {
+		return nil, errChangeSetAlreadyReverted
+	}
+
+	if len(revertCS.ChangeSet) > 0 {
+		err := applyChangeSet(ctx, logger, nbClient, revertCS)
+
+		// invalidate even if the change set failed, as with the object states cached by the ingestion processor
+		invalidateObjectStates(ctx, logger, redisClient, cache, revertCS)
+
+		if err != nil {
+			if err := redisClient.HDel(ctx, RedisRevertedChangeSetsKey, stored.GetId()).Err(); err != nil {
+				logger.Warn("failed to release change set", "change_set_id", stored.GetId(), "error", err)
+			}
+			return nil, err
+		}
+	}
+
+	revertedChangeSet := &reconcilerpb.RevertedChangeSet{
+		ChangeSetId:       stored.GetId(),
+		RevertChangeSetId: revertCS.ChangeSetID,
+		Changes:           int32(len(revertCS.ChangeSet)),
+	}
+
+	if err := recordRevert(ctx, logger, redisClient, logs, revertCS); err != nil {
+		return revertedChangeSet, fmt.Errorf("failed to record revert: %w", err)
+	}
+
+	return revertedChangeSet, nil
+}
+
+// recordRevert writes the ingestion log of the change set applied to revert the change set of ingestion logs, and
+// marks them as reverted by it
+func recordRevert(ctx context.Context, logger *slog.Logger, redisClient RedisClient, logs []*reconcilerpb.IngestionLog, revertCS *changeset.ChangeSet) error {
+	source := logs[0]
+
+	ingestionLogID := ksuid.New().String()
+	ingestionTs := time.Now().UnixNano()
+
+	ingestionLog := &reconcilerpb.IngestionLog{
+		Id:                 ingestionLogID,
+		ProducerAppName:    source.GetProducerAppName(),
+		ProducerAppVersion: source.GetProducerAppVersion(),
+		SdkName:            source.GetSdkName(),
+		SdkVersion:         source.GetSdkVersion(),
+		DataType:           source.GetDataType(),
+		IngestionTs:        ingestionTs,
+		State:              reconcilerpb.State_RECONCILED,
+		ChangeSet:          &reconcilerpb.ChangeSet{Id: revertCS.ChangeSetID, RevertsChangeSetId: source.GetChangeSet().GetId()},
+	}
+	if len(revertCS.ChangeSet) == 0 {
+		ingestionLog.State = reconcilerpb.State_NO_CHANGES
+	}
+
+	errs := make([]error, 0)
+
+	csCompressed, err := compressChangeSet(revertCS)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		ingestionLog.ChangeSet.Data = csCompressed
+	}
+
+	if _, err := writeIngestionLog(ctx, logger, redisClient, ingestionLogKey(ingestionLog.GetDataType(), ingestionTs, ingestionLogID), ingestionLog); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, reverted := range logs {
+		reverted.State = reconcilerpb.State_REVERTED
+		reverted.ChangeSet.RevertedByChangeSetId = revertCS.ChangeSetID
+
+		if _, err := writeIngestionLog(ctx, logger, redisClient, ingestionLogKey(reverted.GetDataType(), reverted.GetIngestionTs(), reverted.GetId()), reverted); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// invalidateObjectStates removes the cached object states of the object types changed by a change set and of the ones
+// which may nest them, and publishes their invalidation to the in-process caches of the ingestion processors
+func invalidateObjectStates(ctx context.Context, logger *slog.Logger, publisher netboxdiodeplugin.Publisher, cache netboxdiodeplugin.ObjectStateCache, cs *changeset.ChangeSet) {
+	objectTypes := make([]string, 0, len(cs.ChangeSet))
+	for _, change := range cs.ChangeSet {
+		for _, objectType := range append([]string{change.ObjectType}, netbox.DependentObjectTypes(change.ObjectType)...) {
+			if !slices.Contains(objectTypes, objectType) {
+				objectTypes = append(objectTypes, objectType)
+			}
+		}
+	}
+
+	if cache != nil {
+		if err := cache.Invalidate(ctx, objectTypes...); err != nil {
+			logger.Warn("failed to invalidate cached object states", "object_types", objectTypes, "error", err)
+		}
+	}
+
+	if err := netboxdiodeplugin.PublishObjectStateCacheInvalidation(ctx, publisher, objectTypes...); err != nil {
+		logger.Warn("failed to publish invalidation of cached object states", "object_types", objectTypes, "error", err)
+	}
+}
+
+// searchIngestionLogs retrieves all the ingestion logs matching the query, most recent first
+func searchIngestionLogs(ctx context.Context, logger *slog.Logger, client RedisClient, query string) ([]*reconcilerpb.IngestionLog, error) {
+	keys := logsSortKeys["ingestion_ts"]
+	logs := make([]*reconcilerpb.IngestionLog, 0)
+
+	var after []string
+	for {
+		page, values, err := searchLogsPage(ctx, logger, client, query, keys, reconcilerpb.SortOrder_DESC, after, revertSearchPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, page...)
+
+		if len(page) < revertSearchPageSize {
+			return logs, nil
+		}
+		after = values[len(values)-1]
+	}
+}
+
+// buildRevertQueryFilter returns the query selecting the reconciled ingestion logs of the change sets to revert, by
+// change set ID, request ID or ingestion timestamp range
+func buildRevertQueryFilter(in *reconcilerpb.RevertChangeSetRequest) (string, error) {
+	var filters []string
+
+	if in.GetChangeSetId() != "" {
+		filters = append(filters, fmt.Sprintf("@change_set_id:{%s}", escapeSpecialChars(in.GetChangeSetId())))
+	}
+
+	if in.GetRequestId() != "" {
+		filters = append(filters, fmt.Sprintf("@request_id:{%s}", escapeSpecialChars(in.GetRequestId())))
+	}
+
+	if in.GetIngestionTsStart() > 0 || in.GetIngestionTsEnd() > 0 {
+		if in.GetIngestionTsEnd() > 0 && in.GetIngestionTsEnd() < in.GetIngestionTsStart() {
+			return "", errors.New("ingestion timestamp range end is before its start")
+		}
+
+		ingestionTsFilter := fmt.Sprintf("@ingestion_ts:[%d inf]", in.GetIngestionTsStart())
+		if in.GetIngestionTsEnd() > 0 {
+			ingestionTsFilter = fmt.Sprintf("@ingestion_ts:[%d %d]", in.GetIngestionTsStart(), in.GetIngestionTsEnd())
+		}
+		filters = append(filters, ingestionTsFilter)
+	}
+
+	if len(filters) != 1 {
+		return "", errors.New("exactly one of change set ID, request ID or ingestion timestamp range is required")
+	}
+
+	return fmt.Sprintf("%s @state:{%s | %s}", filters[0], reconcilerpb.State_RECONCILED.String(), reconcilerpb.State_REVERTED.String()), nil
+}
@@ -0,0 +1,350 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	mnp "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+	mr "github.com/netboxlabs/diode/diode-server/reconciler/mocks"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestBuildRevertQueryFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *reconcilerpb.RevertChangeSetRequest
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "change set ID",
+			in:   &reconcilerpb.RevertChangeSetRequest{ChangeSetId: "5663a77e-9bad-4981-afe9-77d8a9f2b8b5"},
+			want: `@change_set_id:{5663a77e\-9bad\-4981\-afe9\-77d8a9f2b8b5} @state:{RECONCILED | REVERTED}`,
+		},
+		{
+			name: "request ID",
+			in:   &reconcilerpb.RevertChangeSetRequest{RequestId: "req-id"},
+			want: `@request_id:{req\-id} @state:{RECONCILED | REVERTED}`,
+		},
+		{
+			name: "ingestion timestamp range",
+			in:   &reconcilerpb.RevertChangeSetRequest{IngestionTsStart: 100, IngestionTsEnd: 200},
+			want: `@ingestion_ts:[100 200] @state:{RECONCILED | REVERTED}`,
+		},
+		{
+			name: "open ingestion timestamp range",
+			in:   &reconcilerpb.RevertChangeSetRequest{IngestionTsStart: 100},
+			want: `@ingestion_ts:[100 inf] @state:{RECONCILED | REVERTED}`,
+		},
+		{
+			name:    "inverted ingestion timestamp range",
+			in:      &reconcilerpb.RevertChangeSetRequest{IngestionTsStart: 200, IngestionTsEnd: 100},
+			wantErr: true,
+		},
+		{
+			name:    "no selector",
+			in:      &reconcilerpb.RevertChangeSetRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "several selectors",
+			in:      &reconcilerpb.RevertChangeSetRequest{ChangeSetId: "cs-1", RequestId: "req-id"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildRevertQueryFilter(tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRevertChangeSet(t *testing.T) {
+	ingestionLog := func(id string, state reconcilerpb.State, cs *changeset.ChangeSet) *reconcilerpb.IngestionLog {
+		csCompressed, err := compressChangeSet(cs)
+		require.NoError(t, err)
+
+		return &reconcilerpb.IngestionLog{
+			Id:          id,
+			DataType:    netbox.DcimSiteObjectType,
+			State:       state,
+			RequestId:   "req-id",
+			IngestionTs: 1725552914392208722,
+			ChangeSet:   &reconcilerpb.ChangeSet{Id: cs.ChangeSetID, Data: csCompressed},
+		}
+	}
+	ingestionLogResult := func(t *testing.T, ingestionLog *reconcilerpb.IngestionLog) map[interface{}]interface{} {
+		b, err := protojson.Marshal(ingestionLog)
+		require.NoError(t, err)
+
+		return map[interface{}]interface{}{
+			"extra_attributes": map[interface{}]interface{}{
+				"$":                string(b),
+				"ingestion_ts":     "1725552914392208640",
+				"ingestion_log_id": ingestionLog.GetId(),
+			},
+			"values": []interface{}{},
+		}
+	}
+
+	siteUpdate := &changeset.ChangeSet{
+		ChangeSetID: "cs-2",
+		ChangeSet: []changeset.Change{
+			{
+				ChangeID:   "c-2",
+				ChangeType: changeset.ChangeTypeUpdate,
+				ObjectType: netbox.DcimSiteObjectType,
+				ObjectID:   intPtr(1),
+				Data:       &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Description: strPtr("edge site")},
+				Before:     []byte(`{"id":1,"name":"Site A","slug":"site-a"}`),
+				Diff:       []netbox.FieldChange{{Field: "description", After: []byte(`"edge site"`)}},
+			},
+		},
+	}
+	siteCreate := &changeset.ChangeSet{
+		ChangeSetID: "cs-1",
+		ChangeSet: []changeset.Change{
+			{
+				ChangeID:    "c-1",
+				ChangeType:  changeset.ChangeTypeCreate,
+				ObjectType:  netbox.DcimSiteObjectType,
+				Data:        &netbox.DcimSite{Name: "Site A", Slug: "site-a"},
+				QueryParams: map[string]string{"q": "Site A"},
+				Diff: []netbox.FieldChange{
+					{Field: "name", After: []byte(`"Site A"`)},
+					{Field: "slug", After: []byte(`"site-a"`)},
+				},
+			},
+		},
+	}
+	revertOfSiteCreate := &changeset.ChangeSet{ChangeSetID: "cs-3"}
+
+	byRequestID := &reconcilerpb.RevertChangeSetRequest{RequestId: "req-id"}
+
+	tests := []struct {
+		name        string
+		in          *reconcilerpb.RevertChangeSetRequest
+		logs        []*reconcilerpb.IngestionLog
+		unclaimed   []string
+		applyErr    error
+		want        *reconcilerpb.RevertChangeSetResponse
+		wantApplied int
+	}{
+		{
+			name: "change sets reverted most recent first, once each",
+			in:   byRequestID,
+			logs: []*reconcilerpb.IngestionLog{
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnZ", reconcilerpb.State_RECONCILED, siteUpdate),
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnY", reconcilerpb.State_RECONCILED, siteUpdate),
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnX", reconcilerpb.State_RECONCILED, siteCreate),
+			},
+			want: &reconcilerpb.RevertChangeSetResponse{
+				RevertedChangeSets: []*reconcilerpb.RevertedChangeSet{
+					{ChangeSetId: "cs-2", Changes: 1},
+					{ChangeSetId: "cs-1", Changes: 1},
+				},
+			},
+			wantApplied: 2,
+		},
+		{
+			name: "apply error - older change sets left as is",
+			in:   byRequestID,
+			logs: []*reconcilerpb.IngestionLog{
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnZ", reconcilerpb.State_RECONCILED, siteUpdate),
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnX", reconcilerpb.State_RECONCILED, siteCreate),
+			},
+			applyErr: errors.New("apply error"),
+			want: &reconcilerpb.RevertChangeSetResponse{
+				RevertedChangeSets: []*reconcilerpb.RevertedChangeSet{},
+				Error:              &reconcilerpb.IngestionError{Message: "failed to revert change set cs-2: apply error"},
+			},
+			wantApplied: 1,
+		},
+		{
+			name: "change sets already reverted and applied by reverts skipped",
+			in:   byRequestID,
+			logs: []*reconcilerpb.IngestionLog{
+				func() *reconcilerpb.IngestionLog {
+					l := ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnW", reconcilerpb.State_RECONCILED, revertOfSiteCreate)
+					l.ChangeSet.RevertsChangeSetId = "cs-0"
+					return l
+				}(),
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnZ", reconcilerpb.State_REVERTED, siteUpdate),
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnY", reconcilerpb.State_RECONCILED, siteUpdate),
+				ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnX", reconcilerpb.State_RECONCILED, siteCreate),
+			},
+			want: &reconcilerpb.RevertChangeSetResponse{
+				RevertedChangeSets: []*reconcilerpb.RevertedChangeSet{
+					{ChangeSetId: "cs-1", Changes: 1},
+				},
+			},
+			wantApplied: 1,
+		},
+		{
+			name:      "change set claimed by a concurrent revert skipped",
+			in:        byRequestID,
+			logs:      []*reconcilerpb.IngestionLog{ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnZ", reconcilerpb.State_RECONCILED, siteUpdate)},
+			unclaimed: []string{"cs-2"},
+			want:      &reconcilerpb.RevertChangeSetResponse{RevertedChangeSets: []*reconcilerpb.RevertedChangeSet{}},
+		},
+		{
+			name: "change set already reverted refused by ID",
+			in:   &reconcilerpb.RevertChangeSetRequest{ChangeSetId: "cs-2"},
+			logs: []*reconcilerpb.IngestionLog{ingestionLog("2mAT7vZ38H4ttI0i5dBebwJbSnZ", reconcilerpb.State_REVERTED, siteUpdate)},
+			want: &reconcilerpb.RevertChangeSetResponse{
+				RevertedChangeSets: []*reconcilerpb.RevertedChangeSet{},
+				Error:              &reconcilerpb.IngestionError{Message: "failed to revert change set cs-2: change set already reverted"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+			query, err := buildRevertQueryFilter(tt.in)
+			require.NoError(t, err)
+
+			results := make([]interface{}, 0, len(tt.logs))
+			for _, l := range tt.logs {
+				results = append(results, ingestionLogResult(t, l))
+			}
+
+			mockRedisClient := new(mr.RedisClient)
+			cmd := redis.NewCmd(ctx)
+			cmd.SetVal(interface{}(map[interface{}]interface{}{
+				"attributes":    []interface{}{},
+				"format":        "STRING",
+				"results":       results,
+				"total_results": len(results),
+				"warning":       []interface{}{},
+			}))
+			mockLogsPage(ctx, mockRedisClient, []interface{}{"FT.AGGREGATE", "ingest-entity", query, "LOAD", 3, "@__key", "@ingestion_ts", "@ingestion_log_id", "SORTBY", 4, "@ingestion_ts", "DESC", "@ingestion_log_id", "DESC", "LIMIT", 0, int32(revertSearchPageSize)}, cmd.Val())
+			mockRedisClient.On("HSetNX", ctx, RedisRevertedChangeSetsKey, mock.Anything, mock.Anything).Return(func(_ context.Context, _ string, field string, _ interface{}) *redis.BoolCmd {
+				return redis.NewBoolResult(!slices.Contains(tt.unclaimed, field), nil)
+			}).Maybe()
+			mockRedisClient.On("HDel", ctx, RedisRevertedChangeSetsKey, "cs-2").Return(redis.NewIntResult(1, nil)).Maybe()
+
+			written := make(map[string]*reconcilerpb.IngestionLog)
+			mockRedisClient.On("Do", ctx, "JSON.SET", mock.Anything, "$", mock.Anything).Run(func(args mock.Arguments) {
+				l := &reconcilerpb.IngestionLog{}
+				require.NoError(t, protojson.Unmarshal(args.Get(4).([]byte), l))
+				written[args.Get(2).(string)] = l
+			}).Return(redis.NewCmd(ctx)).Maybe()
+			mockRedisClient.On("Publish", ctx, RedisIngestionLogsChannel, mock.Anything).Return(redis.NewIntResult(0, nil)).Maybe()
+
+			var invalidations [][]string
+			mockRedisClient.On("Publish", ctx, netboxdiodeplugin.RedisObjectStateCacheInvalidationsChannel, mock.Anything).Run(func(args mock.Arguments) {
+				var objectTypes []string
+				require.NoError(t, json.Unmarshal(args.Get(2).([]byte), &objectTypes))
+				invalidations = append(invalidations, objectTypes)
+			}).Return(redis.NewIntResult(0, nil)).Maybe()
+
+			site := &netbox.DcimSite{ID: 1, Name: "Site A", Slug: "site-a", Description: strPtr("edge site")}
+			mockNbClient := mnp.NewNetBoxAPI(t)
+			mockNbClient.EXPECT().RetrieveObjectState(mock.Anything, mock.Anything).Return(&netboxdiodeplugin.ObjectState{
+				ObjectID:       1,
+				ObjectType:     netbox.DcimSiteObjectType,
+				ObjectChangeID: 5,
+				Object:         &netbox.DcimSiteDataWrapper{Site: site},
+			}, nil).Maybe()
+
+			var applied []netboxdiodeplugin.ChangeSetRequest
+			mockNbClient.EXPECT().ApplyChangeSet(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, req netboxdiodeplugin.ChangeSetRequest) (*netboxdiodeplugin.ChangeSetResponse, error) {
+				applied = append(applied, req)
+				return &netboxdiodeplugin.ChangeSetResponse{ChangeSetID: req.ChangeSetID}, tt.applyErr
+			}).Maybe()
+
+			cache := netboxdiodeplugin.NewLRUObjectStateCache(10)
+			require.NoError(t, cache.Set(ctx, netbox.DcimSiteObjectType, "dcim.site?q=Site+A", []byte(`{}`)))
+			require.NoError(t, cache.Set(ctx, netbox.DcimDeviceObjectType, "dcim.device?q=router01", []byte(`{}`)))
+
+			resp, err := revertChangeSets(ctx, logger, mockRedisClient, mockNbClient, cache, tt.in)
+			require.NoError(t, err)
+
+			require.Len(t, applied, tt.wantApplied)
+			require.Len(t, resp.RevertedChangeSets, len(tt.want.RevertedChangeSets))
+			for i, reverted := range resp.RevertedChangeSets {
+				assert.Equal(t, tt.want.RevertedChangeSets[i].ChangeSetId, reverted.ChangeSetId)
+				assert.Equal(t, tt.want.RevertedChangeSets[i].Changes, reverted.Changes)
+				assert.Equal(t, applied[len(applied)-len(resp.RevertedChangeSets)+i].ChangeSetID, reverted.RevertChangeSetId)
+			}
+			assert.Equal(t, tt.want.Error.GetMessage(), resp.GetError().GetMessage())
+
+			_, cached, err := cache.Get(ctx, netbox.DcimSiteObjectType, "dcim.site?q=Site+A")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantApplied == 0, cached, "object states of reverted object types are invalidated")
+			_, cached, err = cache.Get(ctx, netbox.DcimDeviceObjectType, "dcim.device?q=router01")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantApplied == 0, cached, "object states nesting reverted object types are invalidated")
+
+			// the in-process caches of the ingestion processors are invalidated as well
+			require.Len(t, invalidations, tt.wantApplied)
+			for _, objectTypes := range invalidations {
+				assert.Contains(t, objectTypes, netbox.DcimSiteObjectType)
+				assert.Contains(t, objectTypes, netbox.DcimDeviceObjectType)
+			}
+
+			// each revert is recorded in an ingestion log of its own, the ingestion logs of the reverted change set being
+			// marked as reverted by it
+			for _, reverted := range resp.RevertedChangeSets {
+				var revertLogs, revertedLogs int
+				for key, l := range written {
+					switch {
+					case l.GetChangeSet().GetId() == reverted.GetRevertChangeSetId():
+						revertLogs++
+						assert.Equal(t, reconcilerpb.State_RECONCILED, l.GetState())
+						assert.Equal(t, reverted.GetChangeSetId(), l.GetChangeSet().GetRevertsChangeSetId())
+						assert.NotEmpty(t, l.GetChangeSet().GetData())
+					case l.GetChangeSet().GetId() == reverted.GetChangeSetId():
+						revertedLogs++
+						assert.Equal(t, ingestionLogKey(l.GetDataType(), l.GetIngestionTs(), l.GetId()), key)
+						assert.Equal(t, reconcilerpb.State_REVERTED, l.GetState())
+						assert.Equal(t, reverted.GetRevertChangeSetId(), l.GetChangeSet().GetRevertedByChangeSetId())
+					}
+				}
+				assert.Equal(t, 1, revertLogs)
+				assert.NotZero(t, revertedLogs)
+			}
+			if len(resp.RevertedChangeSets) == 0 {
+				assert.Empty(t, written)
+			}
+
+			// updated objects are restored to their previous version, created ones deleted
+			for _, req := range applied {
+				switch change := req.ChangeSet[0]; change.ChangeType {
+				case changeset.ChangeTypeUpdate:
+					assert.Equal(t, intPtr(5), change.ObjectVersion)
+					assert.Equal(t, []string{"description"}, change.ClearedFields)
+				default:
+					assert.Equal(t, changeset.ChangeTypeDelete, change.ChangeType)
+					assert.Equal(t, intPtr(1), change.ObjectID)
+				}
+			}
+
+			mockRedisClient.AssertExpectations(t)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
 )
 
 const (
@@ -36,6 +37,10 @@ type Server struct {
 	grpcServer   *grpc.Server
 	redisClient  RedisClient
 	apiKeys      APIKeys
+	nbClient     netboxdiodeplugin.NetBoxAPI
+
+	// shared object state cache of the ingestion processors, invalidated by reverts
+	objectStateCache netboxdiodeplugin.ObjectStateCache
 }
 
 // NewServer creates a new reconciler server
@@ -63,8 +68,19 @@ func NewServer(ctx context.Context, logger *slog.Logger) (*Server, error) {
 		return nil, fmt.Errorf("failed to configure data sources: %v", err)
 	}
 
+	nbClient, err := netboxdiodeplugin.NewClient(logger, cfg.DiodeToNetBoxAPIKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create netbox diode plugin client: %v", err)
+	}
+
+	var objectStateCache netboxdiodeplugin.ObjectStateCache
+	if cfg.ObjectStateCacheEnabled && cfg.ObjectStateCacheRedisEnabled {
+		objectStateCache = netboxdiodeplugin.NewRedisObjectStateCache(redisClient, cfg.ObjectStateCacheTTL)
+	}
+
 	auth := newAuthUnaryInterceptor(logger, apiKeys)
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(auth))
+	streamAuth := newAuthStreamInterceptor(logger, apiKeys)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(auth), grpc.ChainStreamInterceptor(streamAuth))
 
 	component := &Server{
 		config:       cfg,
@@ -73,6 +89,9 @@ func NewServer(ctx context.Context, logger *slog.Logger) (*Server, error) {
 		grpcServer:   grpcServer,
 		redisClient:  redisClient,
 		apiKeys:      apiKeys,
+		nbClient:     nbClient,
+
+		objectStateCache: objectStateCache,
 	}
 
 	reconcilerpb.RegisterReconcilerServiceServer(grpcServer, component)
@@ -95,6 +114,20 @@ func newAuthUnaryInterceptor(logger *slog.Logger, apiKeys APIKeys) grpc.UnarySer
 	}
 }
 
+func newAuthStreamInterceptor(logger *slog.Logger, apiKeys APIKeys) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, ok := metadata.FromIncomingContext(ss.Context())
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, ErrMetadataNotFoundMsg)
+		}
+
+		if !isAuthenticated(logger, serverInfo.FullMethod, apiKeys, md["authorization"]) {
+			return status.Errorf(codes.Unauthenticated, ErrUnauthenticatedMsg)
+		}
+		return handler(srv, ss)
+	}
+}
+
 // Name returns the name of the server
 func (s *Server) Name() string {
 	return "reconciler-grpc-server"
@@ -143,6 +176,34 @@ func (s *Server) RetrieveIngestionLogs(ctx context.Context, in *reconcilerpb.Ret
 	return retrieveIngestionLogs(ctx, s.logger, s.redisClient, in)
 }
 
+// RevertChangeSet reverts applied change sets
+func (s *Server) RevertChangeSet(ctx context.Context, in *reconcilerpb.RevertChangeSetRequest) (*reconcilerpb.RevertChangeSetResponse, error) {
+	return revertChangeSets(ctx, s.logger, s.redisClient, s.nbClient, s.objectStateCache, in)
+}
+
+// WatchIngestionLogs streams the ingestion logs written from now on matching the filters of the request
+func (s *Server) WatchIngestionLogs(in *reconcilerpb.WatchIngestionLogsRequest, stream reconcilerpb.ReconcilerService_WatchIngestionLogsServer) error {
+	if _, err := buildQueryFilter(queryRequest(in)); err != nil {
+		return fmt.Errorf("failed to watch ingestion logs: %w", err)
+	}
+
+	ctx := stream.Context()
+
+	pubsub := s.redisClient.Subscribe(ctx, RedisIngestionLogsChannel)
+	defer func() {
+		_ = pubsub.Close()
+	}()
+
+	// wait for the subscription to be confirmed so no ingestion log written from now on is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to watch ingestion logs: %w", err)
+	}
+
+	return watchIngestionLogs(ctx, s.logger, pubsub.Channel(), in, func(ingestionLog *reconcilerpb.IngestionLog) error {
+		return stream.Send(&reconcilerpb.WatchIngestionLogsResponse{Log: ingestionLog})
+	})
+}
+
 func validateRetrieveIngestionDataSourcesRequest(in *reconcilerpb.RetrieveIngestionDataSourcesRequest) error {
 	if in.GetSdkName() == "" {
 		return fmt.Errorf("sdk name is empty")
@@ -170,7 +231,7 @@ func isAuthenticated(logger *slog.Logger, rpcMethod string, apiKeys APIKeys, aut
 			return false
 		}
 		return apiKey == ingesterToReconcilerAPIKey
-	case reconcilerpb.ReconcilerService_RetrieveIngestionLogs_FullMethodName:
+	case reconcilerpb.ReconcilerService_RetrieveIngestionLogs_FullMethodName, reconcilerpb.ReconcilerService_RevertChangeSet_FullMethodName, reconcilerpb.ReconcilerService_WatchIngestionLogs_FullMethodName:
 		netboxToDiode, ok := apiKeys["NETBOX_TO_DIODE"]
 		if !ok {
 			logger.Debug("missing NETBOX_TO_DIODE API key")
@@ -29,6 +29,12 @@ func (m *MockPipeliner) Do(ctx context.Context, args ...interface{}) *redis.Cmd
 	return calledArgs.Get(0).(*redis.Cmd)
 }
 
+// HGetAll is a mock of Pipeliner's HGetAll method.
+func (m *MockPipeliner) HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd {
+	calledArgs := m.Called(ctx, key)
+	return calledArgs.Get(0).(*redis.MapStringStringCmd)
+}
+
 // Exec is a mock of Pipeliner's Exec method.
 func (m *MockPipeliner) Exec(ctx context.Context) ([]redis.Cmder, error) {
 	args := m.Called(ctx)
@@ -107,6 +113,42 @@ func TestIsAuthenticated(t *testing.T) {
 			},
 			isAuthenticated: false,
 		},
+		{
+			name:          "revert change set with valid authorization",
+			rpcMethod:     reconcilerpb.ReconcilerService_RevertChangeSet_FullMethodName,
+			authorization: []string{"test"},
+			apiKeys: map[string]string{
+				"NETBOX_TO_DIODE": "test",
+			},
+			isAuthenticated: true,
+		},
+		{
+			name:          "revert change set with invalid authorization",
+			rpcMethod:     reconcilerpb.ReconcilerService_RevertChangeSet_FullMethodName,
+			authorization: []string{"test0"},
+			apiKeys: map[string]string{
+				"NETBOX_TO_DIODE": "test",
+			},
+			isAuthenticated: false,
+		},
+		{
+			name:          "watch ingestion logs with valid authorization",
+			rpcMethod:     reconcilerpb.ReconcilerService_WatchIngestionLogs_FullMethodName,
+			authorization: []string{"test"},
+			apiKeys: map[string]string{
+				"NETBOX_TO_DIODE": "test",
+			},
+			isAuthenticated: true,
+		},
+		{
+			name:          "watch ingestion logs with DIODE_TO_NETBOX authorization",
+			rpcMethod:     reconcilerpb.ReconcilerService_WatchIngestionLogs_FullMethodName,
+			authorization: []string{"test"},
+			apiKeys: map[string]string{
+				"DIODE_TO_NETBOX": "test",
+			},
+			isAuthenticated: false,
+		},
 		{
 			name:          "authorization for unknown rpc method",
 			rpcMethod:     "/diode.v1.ReconcilerService/UnknownMethod",
@@ -128,14 +170,13 @@ func TestIsAuthenticated(t *testing.T) {
 
 func TestRetrieveLogs(t *testing.T) {
 	tests := []struct {
-		name             string
-		in               reconcilerpb.RetrieveIngestionLogsRequest
-		result           interface{}
-		response         *reconcilerpb.RetrieveIngestionLogsResponse
-		queryFilter      string
-		queryLimitOffset int32
-		failCmd          bool
-		hasError         bool
+		name        string
+		in          reconcilerpb.RetrieveIngestionLogsRequest
+		result      interface{}
+		response    *reconcilerpb.RetrieveIngestionLogsResponse
+		queryFilter string
+		failCmd     bool
+		hasError    bool
 	}{
 		{
 			name: "valid request",
@@ -146,16 +187,18 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						"values": []interface{}{},
 					},
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.device","entity":{"device":{"name":"Conference_Room_AP_02","deviceType":{"model":"Cisco Aironet 3802","manufacturer":{"name":"Cisco"}},"role":{"name":"Wireless_AP"},"serial":"PQR456789012","site":{"name":"HQ"}}},"id":"2mC8GVBGFg6NyLsQxuS4IYMB6FI","ingestionTs":1725552654541975975,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"bc1052e3-656a-42f0-b364-27b385e02a0c","sdkName":"diode-sdk-python","sdkVersion":"0.0.1","state":2}`,
-							"ingestion_ts": "1725552654541976064",
+							"$":                `{"dataType":"dcim.device","entity":{"device":{"name":"Conference_Room_AP_02","deviceType":{"model":"Cisco Aironet 3802","manufacturer":{"name":"Cisco"}},"role":{"name":"Wireless_AP"},"serial":"PQR456789012","site":{"name":"HQ"}}},"id":"2mC8GVBGFg6NyLsQxuS4IYMB6FI","ingestionTs":1725552654541975975,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"bc1052e3-656a-42f0-b364-27b385e02a0c","sdkName":"diode-sdk-python","sdkVersion":"0.0.1","state":2}`,
+							"ingestion_ts":     "1725552654541976064",
+							"ingestion_log_id": "2mC8GVBGFg6NyLsQxuS4IYMB6FI",
 						},
 						"id":     "ingest-entity:dcim.device-1725552654541975975-2mC8GVBGFg6NyLsQxuS4IYMB6FI",
 						"values": []interface{}{},
@@ -218,12 +261,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Total: 2,
 				},
-				NextPageToken: "F/Jk/zc08gA=",
 			},
-			queryFilter:      "*",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "*",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "request with reconciliation error",
@@ -234,8 +275,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"ipam.ipaddress","entity":{"ip_address":{"address":"192.168.1.1","interface":null,"description":"Vendor: HUAWEI TECHNOLOGIES"}},"error":{"message":"failed to apply change set","code":400,"details":{"change_set_id":"6304c706-f955-4bcb-a1cc-514293d53d07","result":"failed","errors":[{"error":"address: Duplicate IP address found in global table: 192.168.1.1/32","change_id":"ff9e29b2-7a64-40ba-99a8-21f44768f60a"}]}},"id":"2mC8KCvHNasrYlfxSASk9hatfYC","ingestionTs":1725046967777525928,"producerAppName":"example-app","producerAppVersion":"0.1.0","request_id":"e03c4892-5b7e-4c39-b5e6-0225a264ab8b","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":3}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"ipam.ipaddress","entity":{"ip_address":{"address":"192.168.1.1","interface":null,"description":"Vendor: HUAWEI TECHNOLOGIES"}},"error":{"message":"failed to apply change set","code":400,"details":{"change_set_id":"6304c706-f955-4bcb-a1cc-514293d53d07","result":"failed","errors":[{"error":"address: Duplicate IP address found in global table: 192.168.1.1/32","change_id":"ff9e29b2-7a64-40ba-99a8-21f44768f60a"}]}},"id":"2mC8KCvHNasrYlfxSASk9hatfYC","ingestionTs":1725046967777525928,"producerAppName":"example-app","producerAppVersion":"0.1.0","request_id":"e03c4892-5b7e-4c39-b5e6-0225a264ab8b","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":3}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mC8KCvHNasrYlfxSASk9hatfYC",
 						},
 						"id":     "ingest-entity:ipam.ipaddress-1725046967777525928-2mC8KCvHNasrYlfxSASk9hatfYC",
 						"values": []interface{}{},
@@ -282,12 +324,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Total: 2,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "*",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "*",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "filter by new state",
@@ -298,8 +338,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mC8NYwfIKM5rFDibDBuytASSOi","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":1}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mC8NYwfIKM5rFDibDBuytASSOi","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":1}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mC8NYwfIKM5rFDibDBuytASSOi",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mC8NYwfIKM5rFDibDBuytASSOi",
 						"values": []interface{}{},
@@ -335,12 +376,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Queued: 1,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "@state:{QUEUED}",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "@state:{QUEUED}",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "filter by reconciled state",
@@ -351,8 +390,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						"values": []interface{}{},
@@ -389,12 +429,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Reconciled: 1,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "@state:{RECONCILED}",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "@state:{RECONCILED}",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "filter by failed state",
@@ -405,8 +443,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":3}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":3}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						"values": []interface{}{},
@@ -443,12 +482,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Failed: 1,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "@state:{FAILED}",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "@state:{FAILED}",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "filter by no changes state",
@@ -459,8 +496,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":4}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":4}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						"values": []interface{}{},
@@ -497,12 +535,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					NoChanges: 1,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "@state:{NO_CHANGES}",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "@state:{NO_CHANGES}",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "filter by data type",
@@ -513,8 +549,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						"values": []interface{}{},
@@ -551,12 +588,10 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Total: 1,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "@data_type:{dcim\\.interface}",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "@data_type:{dcim\\.interface}",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "filter by timestamp",
@@ -567,62 +602,9 @@ func TestRetrieveLogs(t *testing.T) {
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": "1725552914392208640",
-						},
-						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
-						"values": []interface{}{},
-					},
-				},
-				"total_results": 1,
-				"warning":       []interface{}{},
-			}),
-			response: &reconcilerpb.RetrieveIngestionLogsResponse{
-				Logs: []*reconcilerpb.IngestionLog{
-					{
-						Id:                 "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
-						DataType:           "dcim.interface",
-						State:              reconcilerpb.State_RECONCILED,
-						RequestId:          "req-id",
-						IngestionTs:        1725552914392208722,
-						ProducerAppName:    "diode-agent",
-						ProducerAppVersion: "0.0.1",
-						SdkName:            "diode-sdk-go",
-						SdkVersion:         "0.1.0",
-						Entity: &diodepb.Entity{
-							Entity: &diodepb.Entity_Interface{
-								Interface: &diodepb.Interface{
-									Device: &diodepb.Device{
-										Name: "my_dev",
-									},
-									Name: "Gig 2",
-								},
-							},
-						},
-						Error: nil,
-					},
-				},
-				Metrics: &reconcilerpb.IngestionMetrics{
-					Total: 1,
-				},
-				NextPageToken: "AAAFlw==",
-			},
-			queryFilter:      "@ingestion_ts:[1725552914392208639 inf]",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
-		},
-		{
-			name: "pagination check",
-			in:   reconcilerpb.RetrieveIngestionLogsRequest{PageToken: "AAAFlg=="},
-			result: interface{}(map[interface{}]interface{}{
-				"attributes": []interface{}{},
-				"format":     "STRING",
-				"results": []interface{}{
-					map[interface{}]interface{}{
-						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						"values": []interface{}{},
@@ -659,24 +641,23 @@ func TestRetrieveLogs(t *testing.T) {
 				Metrics: &reconcilerpb.IngestionMetrics{
 					Total: 1,
 				},
-				NextPageToken: "AAAFlw==",
 			},
-			queryFilter:      "*",
-			queryLimitOffset: 1430,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "@ingestion_ts:[1725552914392208639 inf]",
+			failCmd:     false,
+			hasError:    false,
 		},
 		{
 			name: "error parsing extra attributes",
-			in:   reconcilerpb.RetrieveIngestionLogsRequest{PageToken: "AAAFlg=="},
+			in:   reconcilerpb.RetrieveIngestionLogsRequest{},
 			result: interface{}(map[interface{}]interface{}{
 				"attributes": []interface{}{},
 				"format":     "STRING",
 				"results": []interface{}{
 					map[interface{}]interface{}{
 						"extra_attributes": map[interface{}]interface{}{
-							"$":            `"extra":is":"invalid"`,
-							"ingestion_ts": "1725552914392208640",
+							"$":                `"extra":is":"invalid"`,
+							"ingestion_ts":     "1725552914392208640",
+							"ingestion_log_id": "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
 						},
 						"id":     "ingest-entity:dcim.interface",
 						"values": []interface{}{},
@@ -685,84 +666,13 @@ func TestRetrieveLogs(t *testing.T) {
 				"total_results": 1,
 				"warning":       []interface{}{},
 			}),
-			queryFilter:      "*",
-			queryLimitOffset: 1430,
-			failCmd:          false,
-			hasError:         true,
-		},
-		{
-			name: "error decoding page token",
-			in:   reconcilerpb.RetrieveIngestionLogsRequest{PageToken: "invalid"},
-			result: interface{}(map[interface{}]interface{}{
-				"attributes": []interface{}{},
-				"format":     "STRING",
-				"results": []interface{}{
-					map[interface{}]interface{}{
-						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": "1725552914392208640",
-						},
-						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
-						"values": []interface{}{},
-					},
-				},
-				"total_results": 1,
-				"warning":       []interface{}{},
-			}),
-			response: &reconcilerpb.RetrieveIngestionLogsResponse{
-				Logs: []*reconcilerpb.IngestionLog{
-					{
-						Id:                 "2mAT7vZ38H4ttI0i5dBebwJbSnZ",
-						DataType:           "dcim.interface",
-						State:              reconcilerpb.State_RECONCILED,
-						RequestId:          "req-id",
-						IngestionTs:        1725552914392208722,
-						ProducerAppName:    "diode-agent",
-						ProducerAppVersion: "0.0.1",
-						SdkName:            "diode-sdk-go",
-						SdkVersion:         "0.1.0",
-						Entity: &diodepb.Entity{
-							Entity: &diodepb.Entity_Interface{
-								Interface: &diodepb.Interface{
-									Device: &diodepb.Device{
-										Name: "my_dev",
-									},
-									Name: "Gig 2",
-								},
-							},
-						},
-						Error: nil,
-					},
-				},
-				Metrics: &reconcilerpb.IngestionMetrics{
-					Total: 1,
-				},
-				NextPageToken: "AAAFlw==",
-			},
-			queryFilter:      "*",
-			queryLimitOffset: 0,
-			failCmd:          false,
-			hasError:         false,
+			queryFilter: "*",
+			failCmd:     false,
+			hasError:    true,
 		},
 		{
-			name: "error parsing response json",
-			in:   reconcilerpb.RetrieveIngestionLogsRequest{},
-			result: interface{}(map[interface{}]interface{}{
-				"attributes": []interface{}{},
-				"format":     "STRING",
-				"results": []interface{}{
-					map[interface{}]interface{}{
-						"extra_attributes": map[interface{}]interface{}{
-							"$":            `{"dataType":"dcim.interface","entity":{"interface":{"device":{"name":"my_dev"},"name":"Gig 2"}},"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","ingestionTs":1725552914392208722,"producerAppName":"diode-agent","producerAppVersion":"0.0.1","request_id":"req-id","sdkName":"diode-sdk-go","sdkVersion":"0.1.0","state":2}`,
-							"ingestion_ts": 123,
-						},
-						"id":     "ingest-entity:dcim.interface-1725552914392208722-2mAT7vZ38H4ttI0i5dBebwJbSnZ",
-						"values": []interface{}{},
-					},
-				},
-				"total_results": 1,
-				"warning":       []interface{}{},
-			}),
+			name:        "error decoding page token",
+			in:          reconcilerpb.RetrieveIngestionLogsRequest{PageToken: "invalid"},
 			queryFilter: "*",
 			failCmd:     false,
 			hasError:    true,
@@ -788,8 +698,14 @@ func TestRetrieveLogs(t *testing.T) {
 			if tt.failCmd {
 				cmd.SetErr(errors.New("error"))
 			}
-			mockRedisClient.On("Do", ctx, "FT.SEARCH", "ingest-entity", tt.queryFilter, "SORTBY", "id", "DESC", "LIMIT", tt.queryLimitOffset, int32(100)).
-				Return(cmd)
+			aggregateArgs := []interface{}{"FT.AGGREGATE", "ingest-entity", tt.queryFilter, "LOAD", 3, "@__key", "@ingestion_ts", "@ingestion_log_id", "SORTBY", 4, "@ingestion_ts", "DESC", "@ingestion_log_id", "DESC", "LIMIT", 0, int32(101)}
+			if tt.failCmd || tt.result == nil {
+				mockRedisClient.On("Do", append([]interface{}{ctx}, aggregateArgs...)...).Return(cmd).Maybe()
+			} else {
+				mockLogsPage(ctx, mockRedisClient, aggregateArgs, tt.result)
+			}
+			mockRedisClient.On("Do", ctx, "FT.SEARCH", "ingest-entity", tt.queryFilter, "LIMIT", 0, 0).
+				Return(cmd).Maybe()
 
 			server := &Server{
 				redisClient: mockRedisClient,
@@ -814,6 +730,7 @@ func TestRetrieveLogs(t *testing.T) {
 					assert.Equal(t, tt.response.Logs[i].Entity.String(), response.Logs[i].Entity.String())
 				}
 				require.Equal(t, tt.response.Metrics, response.Metrics)
+				require.Empty(t, response.NextPageToken)
 			}
 		})
 	}
@@ -862,11 +779,17 @@ func TestRetrieveIngestionLogsMetricsOnly(t *testing.T) {
 			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
 
 			expected := &reconcilerpb.IngestionMetrics{
-				Queued:     3,
-				Reconciled: 3,
-				Failed:     2,
-				NoChanges:  2,
-				Total:      10,
+				Queued:       3,
+				Reconciled:   3,
+				Failed:       2,
+				NoChanges:    2,
+				SkippedStale: 1,
+				Filtered:     1,
+				Reverted:     1,
+				Total:        10,
+
+				ObjectStateCacheHits:   42,
+				ObjectStateCacheMisses: 7,
 			}
 
 			mockRedisClient := new(mr.RedisClient)
@@ -936,6 +859,46 @@ func TestRetrieveIngestionLogsMetricsOnly(t *testing.T) {
 			}))
 			mockPipeliner.On("Do", ctx, []interface{}{"FT.SEARCH", "ingest-entity", "@state:{NO_CHANGES}", "LIMIT", 0, 0}).Return(cmdNoChanges)
 
+			cmdSkippedStale := redis.NewCmd(ctx)
+			cmdSkippedStale.SetVal(interface{}(map[interface{}]interface{}{
+				"attributes": []interface{}{},
+				"format":     "STRING",
+				"results": []interface{}{
+					map[interface{}]interface{}{},
+				},
+				"total_results": int64(expected.SkippedStale),
+				"warning":       []interface{}{},
+			}))
+			mockPipeliner.On("Do", ctx, []interface{}{"FT.SEARCH", "ingest-entity", "@state:{SKIPPED_STALE}", "LIMIT", 0, 0}).Return(cmdSkippedStale)
+
+			cmdFiltered := redis.NewCmd(ctx)
+			cmdFiltered.SetVal(interface{}(map[interface{}]interface{}{
+				"attributes": []interface{}{},
+				"format":     "STRING",
+				"results": []interface{}{
+					map[interface{}]interface{}{},
+				},
+				"total_results": int64(expected.Filtered),
+				"warning":       []interface{}{},
+			}))
+			mockPipeliner.On("Do", ctx, []interface{}{"FT.SEARCH", "ingest-entity", "@state:{FILTERED}", "LIMIT", 0, 0}).Return(cmdFiltered)
+
+			cmdReverted := redis.NewCmd(ctx)
+			cmdReverted.SetVal(interface{}(map[interface{}]interface{}{
+				"attributes": []interface{}{},
+				"format":     "STRING",
+				"results": []interface{}{
+					map[interface{}]interface{}{},
+				},
+				"total_results": int64(expected.Reverted),
+				"warning":       []interface{}{},
+			}))
+			mockPipeliner.On("Do", ctx, []interface{}{"FT.SEARCH", "ingest-entity", "@state:{REVERTED}", "LIMIT", 0, 0}).Return(cmdReverted)
+
+			cmdCacheStats := redis.NewMapStringStringCmd(ctx)
+			cmdCacheStats.SetVal(map[string]string{"hits": "42", "misses": "7"})
+			mockPipeliner.On("HGetAll", ctx, RedisObjectStateCacheStatsKey).Return(cmdCacheStats)
+
 			mockPipeliner.On("Exec", ctx).Return(tt.execError)
 			mockRedisClient.On("Pipeline").Return(mockPipeliner)
 
@@ -154,3 +154,30 @@ func TestRetrieveIngestionDataSources(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchIngestionLogs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	_, conn := startTestServer(ctx, t, s.Addr())
+	client := pb.NewReconcilerServiceClient(conn)
+
+	stream, err := client.WatchIngestionLogs(ctx, &pb.WatchIngestionLogsRequest{DataType: "dcim.site"})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return s.PubSubNumSub(reconciler.RedisIngestionLogsChannel)[reconciler.RedisIngestionLogsChannel] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	s.Publish(reconciler.RedisIngestionLogsChannel, `{"id":"2mC8GVBGFg6NyLsQxuS4IYMB6FI","dataType":"dcim.device","state":"QUEUED","ingestionTs":1725552654541975975}`)
+	s.Publish(reconciler.RedisIngestionLogsChannel, `{"id":"2mAT7vZ38H4ttI0i5dBebwJbSnZ","dataType":"dcim.site","state":"RECONCILED","ingestionTs":1725552914392208722}`)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "2mAT7vZ38H4ttI0i5dBebwJbSnZ", resp.GetLog().GetId())
+	require.Equal(t, pb.State_RECONCILED, resp.GetLog().GetState())
+	require.Equal(t, int64(1725552914392208722), resp.GetLog().GetIngestionTs())
+}
@@ -0,0 +1,233 @@
+package reconciler
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/ksuid"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+const (
+	// RedisSnapshotObjectsKeyPrefix is the key prefix for the objects of the last completed snapshot per producer,
+	// stream and scope
+	RedisSnapshotObjectsKeyPrefix = "diode.snapshot-objects"
+
+	// RedisSnapshotKeyPrefix is the key prefix for the state of snapshots being received
+	RedisSnapshotKeyPrefix = "diode.snapshot"
+
+	// snapshotExpiration is how long an incomplete snapshot is kept while waiting for its remaining batches
+	snapshotExpiration = 24 * time.Hour
+)
+
+// receiveSnapshotBatchScript records a received snapshot batch and returns the number of batches received, along with
+// whether the snapshot is claimed for completion. Only the first replica to receive all the batches claims it, so the
+// snapshot is completed once
+const receiveSnapshotBatchScript = `
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+
+local received = redis.call('HLEN', KEYS[1])
+if received < tonumber(ARGV[3]) then
+	return {received, 0}
+end
+
+if redis.call('SET', KEYS[2], ARGV[2], 'NX', 'PX', ARGV[4]) then
+	return {received, 1}
+end
+return {received, 0}
+`
+
+func snapshotObjectsKey(producerAppName, stream, scope string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", RedisSnapshotObjectsKeyPrefix, producerAppName, stream, scope)
+}
+
+func snapshotReceivedObjectsKey(snapshotID string) string {
+	return fmt.Sprintf("%s:%s:objects", RedisSnapshotKeyPrefix, snapshotID)
+}
+
+func snapshotReceivedBatchesKey(snapshotID string) string {
+	return fmt.Sprintf("%s:%s:batches", RedisSnapshotKeyPrefix, snapshotID)
+}
+
+func snapshotCompletedKey(snapshotID string) string {
+	return fmt.Sprintf("%s:%s:completed", RedisSnapshotKeyPrefix, snapshotID)
+}
+
+// markSnapshotObject records that the root object of an ingest entity is part of a snapshot
+func (p *IngestionProcessor) markSnapshotObject(ctx context.Context, snapshot *diodepb.Snapshot, ingestEntity changeset.IngestEntity) error {
+	obj, err := p.newRecordedObject(ingestEntity)
+	if err != nil {
+		return err
+	}
+
+	objJSON, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot object: %v", err)
+	}
+
+	key := snapshotReceivedObjectsKey(snapshot.GetId())
+	if err := p.redisClient.HSet(ctx, key, obj.field(), objJSON).Err(); err != nil {
+		return fmt.Errorf("failed to set snapshot object %s: %v", key, err)
+	}
+
+	if err := p.redisClient.Expire(ctx, key, snapshotExpiration).Err(); err != nil {
+		return fmt.Errorf("failed to set expiration of %s: %v", key, err)
+	}
+
+	return nil
+}
+
+// receiveSnapshotBatch records a received snapshot batch and, once all batches of the snapshot are received,
+// deletes the objects of the previous snapshot of the same producer, stream and scope absent from it. The completion
+// marker is kept until it expires, so batches received again don't complete the snapshot twice
+func (p *IngestionProcessor) receiveSnapshotBatch(ctx context.Context, ingestReq *diodepb.IngestRequest, ingestionTs int64) error {
+	snapshot := ingestReq.GetSnapshot()
+
+	batchesKey := snapshotReceivedBatchesKey(snapshot.GetId())
+	keys := []string{batchesKey, snapshotCompletedKey(snapshot.GetId())}
+	args := []interface{}{strconv.Itoa(int(snapshot.GetBatchIndex())), ingestReq.GetId(), snapshot.GetBatchCount(), snapshotExpiration.Milliseconds()}
+
+	res, err := p.redisClient.Eval(ctx, receiveSnapshotBatchScript, keys, args...).Int64Slice()
+	if err != nil {
+		return fmt.Errorf("failed to receive snapshot batch %s: %v", batchesKey, err)
+	}
+	if len(res) != 2 {
+		return fmt.Errorf("failed to receive snapshot batch %s: unexpected result %v", batchesKey, res)
+	}
+
+	received, claimed := res[0], res[1] == 1
+	if received < int64(snapshot.GetBatchCount()) {
+		p.logger.Debug("snapshot incomplete", "snapshot_id", snapshot.GetId(), "received_batches", received, "batch_count", snapshot.GetBatchCount())
+		return nil
+	}
+	if !claimed {
+		p.logger.Debug("snapshot completed by another replica", "snapshot_id", snapshot.GetId())
+		return nil
+	}
+
+	return p.completeSnapshot(ctx, ingestReq, ingestionTs)
+}
+
+func (p *IngestionProcessor) completeSnapshot(ctx context.Context, ingestReq *diodepb.IngestRequest, ingestionTs int64) error {
+	snapshot := ingestReq.GetSnapshot()
+
+	receivedObjectsKey := snapshotReceivedObjectsKey(snapshot.GetId())
+	receivedObjects, err := p.redisClient.HGetAll(ctx, receivedObjectsKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot objects %s: %v", receivedObjectsKey, err)
+	}
+
+	objectsKey := snapshotObjectsKey(ingestReq.GetProducerAppName(), ingestReq.GetStream(), snapshot.GetScope())
+	previousObjects, err := p.redisClient.HGetAll(ctx, objectsKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot objects %s: %v", objectsKey, err)
+	}
+
+	errs := make([]error, 0)
+
+	absentObjects := make([]RecordedObject, 0)
+	for field, v := range previousObjects {
+		if _, ok := receivedObjects[field]; ok {
+			continue
+		}
+
+		var obj RecordedObject
+		if err := json.Unmarshal([]byte(v), &obj); err != nil {
+			errs = append(errs, fmt.Errorf("failed to unmarshal snapshot object %s: %v", field, err))
+			continue
+		}
+		absentObjects = append(absentObjects, obj)
+	}
+
+	slices.SortStableFunc(absentObjects, func(a, b RecordedObject) int {
+		if c := changeset.DeletionOrder(a.ObjectType) - changeset.DeletionOrder(b.ObjectType); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.field(), b.field())
+	})
+
+	// objects failing to be deleted are kept so the deletion is retried with the next snapshot
+	nextObjects := make(map[string]any, len(receivedObjects))
+	for field, v := range receivedObjects {
+		nextObjects[field] = v
+	}
+
+	for _, obj := range absentObjects {
+		if err := p.deleteSnapshotObject(ctx, ingestReq, obj, ingestionTs); err != nil {
+			errs = append(errs, err)
+			nextObjects[obj.field()] = previousObjects[obj.field()]
+		}
+	}
+
+	p.logger.Debug("snapshot completed", "snapshot_id", snapshot.GetId(), "scope", snapshot.GetScope(), "objects", len(receivedObjects), "absent_objects", len(absentObjects))
+
+	if err := p.redisClient.Del(ctx, objectsKey, receivedObjectsKey, snapshotReceivedBatchesKey(snapshot.GetId())).Err(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to delete snapshot %s: %v", snapshot.GetId(), err))
+	}
+
+	if len(nextObjects) > 0 {
+		if err := p.redisClient.HSet(ctx, objectsKey, nextObjects).Err(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set snapshot objects %s: %v", objectsKey, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *IngestionProcessor) deleteSnapshotObject(ctx context.Context, ingestReq *diodepb.IngestRequest, obj RecordedObject, ingestionTs int64) error {
+	cs, err := changeset.PrepareDelete(obj.ObjectType, obj.QueryParams, p.nbClient)
+	if err != nil {
+		return fmt.Errorf("failed to prepare delete change set: %v", err)
+	}
+
+	if len(cs.ChangeSet) == 0 {
+		return nil
+	}
+
+	ingestionLogID := ksuid.New().String()
+	key := ingestionLogKey(obj.ObjectType, ingestionTs, ingestionLogID)
+
+	ingestionLog := &reconcilerpb.IngestionLog{
+		Id:                 ingestionLogID,
+		RequestId:          ingestReq.GetId(),
+		ProducerAppName:    ingestReq.GetProducerAppName(),
+		ProducerAppVersion: ingestReq.GetProducerAppVersion(),
+		SdkName:            ingestReq.GetSdkName(),
+		SdkVersion:         ingestReq.GetSdkVersion(),
+		DataType:           obj.ObjectType,
+		IngestionTs:        ingestionTs,
+		State:              reconcilerpb.State_RECONCILED,
+		ChangeSet:          &reconcilerpb.ChangeSet{Id: cs.ChangeSetID},
+	}
+
+	errs := make([]error, 0)
+
+	if err := p.applyChangeSet(ctx, cs); err != nil {
+		errs = append(errs, fmt.Errorf("failed to apply delete change set: %v", err))
+		ingestionLog.State = reconcilerpb.State_FAILED
+		ingestionLog.Error = extractIngestionError(err)
+	}
+
+	csCompressed, err := compressChangeSet(cs)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		ingestionLog.ChangeSet.Data = csCompressed
+	}
+
+	if _, err := p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
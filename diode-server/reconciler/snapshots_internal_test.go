@@ -0,0 +1,259 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	mnp "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+// miniredisClient stubs out RedisJSON commands which are not supported by miniredis
+type miniredisClient struct {
+	*redis.Client
+}
+
+func (c miniredisClient) Do(ctx context.Context, _ ...interface{}) *redis.Cmd {
+	return redis.NewCmd(ctx)
+}
+
+func vmIngestEntity(name string) changeset.IngestEntity {
+	return changeset.IngestEntity{
+		DataType: netbox.VirtualizationVirtualMachineObjectType,
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_VirtualMachine{
+				VirtualMachine: &diodepb.VirtualMachine{
+					Name: name,
+				},
+			},
+		},
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	mockNbClient := mnp.NewNetBoxAPI(t)
+
+	p := &IngestionProcessor{
+		nbClient:    mockNbClient,
+		redisClient: miniredisClient{redisClient},
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	ingest := func(snapshot *diodepb.Snapshot, names ...string) {
+		ingestReq := &diodepb.IngestRequest{
+			Id:              "req123",
+			Stream:          "latest",
+			ProducerAppName: "vcenter-discovery",
+			Snapshot:        snapshot,
+		}
+		for _, name := range names {
+			require.NoError(t, p.markSnapshotObject(ctx, snapshot, vmIngestEntity(name)))
+		}
+		require.NoError(t, p.receiveSnapshotBatch(ctx, ingestReq, 1720425600000000000))
+	}
+
+	objectsKey := "diode.snapshot-objects:vcenter-discovery:latest:cluster-01"
+
+	// first snapshot has nothing to compare against
+	ingest(&diodepb.Snapshot{Id: "snapshot-1", Scope: "cluster-01", BatchIndex: 0, BatchCount: 1}, "vm01", "vm02")
+
+	objects, err := redisClient.HGetAll(ctx, objectsKey).Result()
+	require.NoError(t, err)
+	assert.Len(t, objects, 2)
+
+	// second snapshot is received across two batches, vm02 is absent from it
+	snapshot := &diodepb.Snapshot{Id: "snapshot-2", Scope: "cluster-01", BatchIndex: 0, BatchCount: 2}
+	ingest(snapshot, "vm01")
+
+	objects, err = redisClient.HGetAll(ctx, objectsKey).Result()
+	require.NoError(t, err)
+	assert.Len(t, objects, 2, "objects are not replaced until the snapshot is complete")
+
+	mockNbClient.EXPECT().RetrieveObjectState(ctx, netboxdiodeplugin.RetrieveObjectStateQueryParams{
+		ObjectType: netbox.VirtualizationVirtualMachineObjectType,
+		Params:     map[string]string{"q": "vm02", "site__name": "undefined"},
+	}).Return(&netboxdiodeplugin.ObjectState{
+		ObjectID:   2,
+		ObjectType: netbox.VirtualizationVirtualMachineObjectType,
+		Object: &netbox.VirtualizationVirtualMachineDataWrapper{
+			VirtualMachine: &netbox.VirtualizationVirtualMachine{
+				ID:   2,
+				Name: "vm02",
+			},
+		},
+	}, nil).Once()
+	mockNbClient.EXPECT().ApplyChangeSet(ctx, mock.MatchedBy(func(req netboxdiodeplugin.ChangeSetRequest) bool {
+		return len(req.ChangeSet) == 1 &&
+			req.ChangeSet[0].ChangeType == netboxdiodeplugin.ChangeTypeDelete &&
+			req.ChangeSet[0].ObjectType == netbox.VirtualizationVirtualMachineObjectType &&
+			*req.ChangeSet[0].ObjectID == 2
+	})).Return(&netboxdiodeplugin.ChangeSetResponse{}, nil).Once()
+
+	snapshot = &diodepb.Snapshot{Id: "snapshot-2", Scope: "cluster-01", BatchIndex: 1, BatchCount: 2}
+	ingest(snapshot, "vm03")
+
+	objects, err = redisClient.HGetAll(ctx, objectsKey).Result()
+	require.NoError(t, err)
+	assert.Len(t, objects, 2)
+	assert.Contains(t, objects, "virtualization.virtualmachine?q=vm01&site__name=undefined")
+	assert.Contains(t, objects, "virtualization.virtualmachine?q=vm03&site__name=undefined")
+
+	assert.False(t, s.Exists("diode.snapshot:snapshot-2:objects"))
+	assert.False(t, s.Exists("diode.snapshot:snapshot-2:batches"))
+
+	// the last batch received again, e.g. by another replica, doesn't complete the snapshot twice
+	require.NoError(t, p.receiveSnapshotBatch(ctx, &diodepb.IngestRequest{
+		Id:              "req123",
+		Stream:          "latest",
+		ProducerAppName: "vcenter-discovery",
+		Snapshot:        &diodepb.Snapshot{Id: "snapshot-2", Scope: "cluster-01", BatchIndex: 1, BatchCount: 2},
+	}, 1720425600000000000))
+	require.NoError(t, p.receiveSnapshotBatch(ctx, &diodepb.IngestRequest{
+		Id:              "req123",
+		Stream:          "latest",
+		ProducerAppName: "vcenter-discovery",
+		Snapshot:        &diodepb.Snapshot{Id: "snapshot-2", Scope: "cluster-01", BatchIndex: 0, BatchCount: 2},
+	}, 1720425600000000000))
+
+	objects, err = redisClient.HGetAll(ctx, objectsKey).Result()
+	require.NoError(t, err)
+	assert.Len(t, objects, 2, "objects of the completed snapshot are kept")
+}
+
+func TestSnapshotCompletedOnce(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	// no NetBox calls are expected as the objects of the first snapshot are all part of the second one
+	mockNbClient := mnp.NewNetBoxAPI(t)
+
+	p := &IngestionProcessor{
+		nbClient:    mockNbClient,
+		redisClient: miniredisClient{redisClient},
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	snapshotReq := func(snapshotID string, batchIndex int32, batchCount int32) *diodepb.IngestRequest {
+		return &diodepb.IngestRequest{
+			Id:              fmt.Sprintf("%s-%d", snapshotID, batchIndex),
+			Stream:          "latest",
+			ProducerAppName: "vcenter-discovery",
+			Snapshot:        &diodepb.Snapshot{Id: snapshotID, Scope: "cluster-01", BatchIndex: batchIndex, BatchCount: batchCount},
+		}
+	}
+
+	first := snapshotReq("snapshot-1", 0, 1)
+	require.NoError(t, p.markSnapshotObject(ctx, first.GetSnapshot(), vmIngestEntity("vm01")))
+	require.NoError(t, p.receiveSnapshotBatch(ctx, first, 1720425600000000000))
+
+	// two replicas receive the last two batches of the second snapshot at once, both batches being recorded before
+	// either completes the snapshot
+	batch0, batch1 := snapshotReq("snapshot-2", 0, 2), snapshotReq("snapshot-2", 1, 2)
+	require.NoError(t, p.markSnapshotObject(ctx, batch0.GetSnapshot(), vmIngestEntity("vm01")))
+	require.NoError(t, p.markSnapshotObject(ctx, batch1.GetSnapshot(), vmIngestEntity("vm02")))
+	require.NoError(t, redisClient.HSet(ctx, "diode.snapshot:snapshot-2:batches", "0", batch0.GetId()).Err())
+
+	var wg sync.WaitGroup
+	for _, req := range []*diodepb.IngestRequest{batch0, batch1} {
+		wg.Add(1)
+		go func(req *diodepb.IngestRequest) {
+			defer wg.Done()
+			assert.NoError(t, p.receiveSnapshotBatch(ctx, req, 1720425660000000000))
+		}(req)
+	}
+	wg.Wait()
+
+	objects, err := redisClient.HGetAll(ctx, "diode.snapshot-objects:vcenter-discovery:latest:cluster-01").Result()
+	require.NoError(t, err)
+	assert.Len(t, objects, 2)
+	assert.True(t, s.Exists("diode.snapshot:snapshot-2:completed"))
+}
+
+func TestHandleStreamMessageKeepsFilteredSnapshotObject(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	// no NetBox calls are expected as the filtered object is neither reconciled nor deleted
+	mockNbClient := mnp.NewNetBoxAPI(t)
+
+	p := &IngestionProcessor{
+		nbClient:          mockNbClient,
+		redisClient:       miniredisClient{redisClient},
+		redisStreamClient: miniredisClient{redisClient},
+		logger:            slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	policies, err := parseFilteringPolicies([]byte(`
+policies:
+  - name: vm01
+    match:
+      name: ^vm01$
+`))
+	require.NoError(t, err)
+	p.filteringPolicies.Store(policies)
+
+	objectsKey := "diode.snapshot-objects:vcenter-discovery:latest:cluster-01"
+	ingestReq := &diodepb.IngestRequest{
+		Id:              "req123",
+		Stream:          "latest",
+		ProducerAppName: "vcenter-discovery",
+		Snapshot:        &diodepb.Snapshot{Id: "snapshot-1", Scope: "cluster-01", BatchIndex: 0, BatchCount: 1},
+	}
+	require.NoError(t, p.markSnapshotObject(ctx, ingestReq.GetSnapshot(), vmIngestEntity("vm01")))
+	require.NoError(t, p.receiveSnapshotBatch(ctx, ingestReq, 1720425600000000000))
+
+	// vm01 is filtered out of the second snapshot, yet still part of it
+	reqBytes, err := proto.Marshal(&diodepb.IngestRequest{
+		Id:              "req456",
+		Stream:          "latest",
+		ProducerAppName: "vcenter-discovery",
+		Snapshot:        &diodepb.Snapshot{Id: "snapshot-2", Scope: "cluster-01", BatchIndex: 0, BatchCount: 1},
+		Entities: []*diodepb.Entity{
+			{Entity: &diodepb.Entity_VirtualMachine{VirtualMachine: &diodepb.VirtualMachine{Name: "vm01"}}},
+		},
+	})
+	require.NoError(t, err)
+
+	err = p.handleStreamMessage(ctx, redis.XMessage{
+		ID: "1",
+		Values: map[string]interface{}{
+			"request":      string(reqBytes),
+			"ingestion_ts": "1720425660",
+		},
+	})
+	require.NoError(t, err)
+
+	objects, err := redisClient.HGetAll(ctx, objectsKey).Result()
+	require.NoError(t, err)
+	assert.Len(t, objects, 1)
+	assert.Contains(t, objects, "virtualization.virtualmachine?q=vm01&site__name=undefined")
+}
@@ -0,0 +1,299 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/segmentio/ksuid"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/reconcilerpb"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+const (
+	// RedisStaleObjectsKeyPrefix is the key prefix for the objects last seen per producer and stream
+	RedisStaleObjectsKeyPrefix = "diode.stale-objects"
+
+	// RedisDiscoveryCyclesKey is the key for the discovery cycles per producer and stream
+	RedisDiscoveryCyclesKey = "diode.discovery-cycles"
+
+	// RedisStaleObjectSweepLockKey is the key for the lock held by the replica sweeping stale objects
+	RedisStaleObjectSweepLockKey = "diode.stale-object-sweep-lock"
+)
+
+// advanceDiscoveryCycleScript records an ingest request of a producer and stream in its discovery cycle, starting a new
+// cycle when the producer has been idle for at least the discovery cycle gap, and returns the current cycle. The
+// timestamp of the request is stored as given, Lua numbers can't hold nanoseconds exactly
+const advanceDiscoveryCycleScript = `
+local cycle = 0
+local lastRequestTs = 0
+
+local res = redis.call('HGET', KEYS[1], ARGV[1])
+if res then
+	local dc = cjson.decode(res)
+	cycle = dc.cycle
+	lastRequestTs = dc.last_request_ts
+end
+
+if cycle == 0 or tonumber(ARGV[2]) - lastRequestTs >= tonumber(ARGV[3]) then
+	cycle = cycle + 1
+end
+
+local dc = string.format('{"producer_app_name":%s,"stream":%s,"cycle":%d,"last_request_ts":%s}',
+	cjson.encode(ARGV[4]), cjson.encode(ARGV[5]), cycle, ARGV[2])
+redis.call('HSET', KEYS[1], ARGV[1], dc)
+return cycle
+`
+
+// releaseLockScript deletes a lock if it's still held with the token it was taken with
+const releaseLockScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`
+
+// DiscoveryCycle tracks the discovery cycles of a producer and stream
+//
+// A new discovery cycle starts when an ingest request arrives after the producer has been idle for at least the
+// configured discovery cycle gap, so entities sent across several requests in quick succession belong to the same cycle
+type DiscoveryCycle struct {
+	ProducerAppName string `json:"producer_app_name"`
+	Stream          string `json:"stream"`
+	Cycle           int64  `json:"cycle"`
+	LastRequestTs   int64  `json:"last_request_ts"`
+}
+
+// RecordedObject identifies an ingested object by its object type and object state query parameters
+type RecordedObject struct {
+	ObjectType  string            `json:"object_type"`
+	QueryParams map[string]string `json:"query_params"`
+}
+
+func (o RecordedObject) field() string {
+	values := url.Values{}
+	for k, v := range o.QueryParams {
+		values.Set(k, v)
+	}
+	return fmt.Sprintf("%s?%s", o.ObjectType, values.Encode())
+}
+
+func (p *IngestionProcessor) newRecordedObject(ingestEntity changeset.IngestEntity) (RecordedObject, error) {
+	queryParams, err := changeset.ObjectStateQueryParams(ingestEntity, p.changeSetOptions(ingestEntity)...)
+	if err != nil {
+		return RecordedObject{}, fmt.Errorf("failed to get object state query params: %v", err)
+	}
+
+	return RecordedObject{
+		ObjectType:  ingestEntity.DataType,
+		QueryParams: queryParams,
+	}, nil
+}
+
+// LastSeenObject records when an object was last reported by a producer and stream
+type LastSeenObject struct {
+	RecordedObject
+
+	Cycle      int64 `json:"cycle"`
+	LastSeenTs int64 `json:"last_seen_ts"`
+}
+
+func discoveryCycleField(producerAppName, stream string) string {
+	return fmt.Sprintf("%s:%s", producerAppName, stream)
+}
+
+func staleObjectsKey(producerAppName, stream string) string {
+	return fmt.Sprintf("%s:%s:%s", RedisStaleObjectsKeyPrefix, producerAppName, stream)
+}
+
+// advanceDiscoveryCycle records an ingest request of a producer and stream and returns its current discovery cycle
+func (p *IngestionProcessor) advanceDiscoveryCycle(ctx context.Context, producerAppName, stream string, ingestionTs int64) (int64, error) {
+	field := discoveryCycleField(producerAppName, stream)
+
+	args := []interface{}{field, ingestionTs, int64(p.config.StaleObjectDiscoveryCycleGap), producerAppName, stream}
+	cycle, err := p.redisClient.Eval(ctx, advanceDiscoveryCycleScript, []string{RedisDiscoveryCyclesKey}, args...).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance discovery cycle %s: %v", field, err)
+	}
+
+	return cycle, nil
+}
+
+// markObjectSeen records that the root object of an ingest entity was reported by a producer and stream
+func (p *IngestionProcessor) markObjectSeen(ctx context.Context, producerAppName, stream string, cycle int64, ingestionTs int64, ingestEntity changeset.IngestEntity) error {
+	recordedObject, err := p.newRecordedObject(ingestEntity)
+	if err != nil {
+		return err
+	}
+
+	obj := LastSeenObject{
+		RecordedObject: recordedObject,
+		Cycle:          cycle,
+		LastSeenTs:     ingestionTs,
+	}
+
+	objJSON, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal last seen object: %v", err)
+	}
+
+	key := staleObjectsKey(producerAppName, stream)
+	if err := p.redisClient.HSet(ctx, key, obj.field(), objJSON).Err(); err != nil {
+		return fmt.Errorf("failed to set last seen object %s: %v", key, err)
+	}
+
+	return nil
+}
+
+func (p *IngestionProcessor) runStaleObjectSweeper(ctx context.Context) {
+	ticker := time.NewTicker(p.config.StaleObjectSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.sweepStaleObjectsLocked(ctx, time.Now()); err != nil {
+				p.logger.Warn("failed to sweep stale objects", "error", err)
+			}
+		}
+	}
+}
+
+// sweepStaleObjectsLocked sweeps stale objects holding the sweep lock, so that a single replica sweeps them at a time.
+// The lock expires after the sweep interval in case the replica holding it stops
+func (p *IngestionProcessor) sweepStaleObjectsLocked(ctx context.Context, now time.Time) error {
+	token := ksuid.New().String()
+
+	locked, err := p.redisClient.SetNX(ctx, RedisStaleObjectSweepLockKey, token, p.config.StaleObjectSweepInterval).Result()
+	if err != nil {
+		return fmt.Errorf("failed to take stale object sweep lock: %v", err)
+	}
+	if !locked {
+		p.logger.Debug("stale objects swept by another replica")
+		return nil
+	}
+
+	sweepErr := p.sweepStaleObjects(ctx, now)
+
+	if err := p.redisClient.Eval(ctx, releaseLockScript, []string{RedisStaleObjectSweepLockKey}, token).Err(); err != nil {
+		return errors.Join(sweepErr, fmt.Errorf("failed to release stale object sweep lock: %v", err))
+	}
+
+	return sweepErr
+}
+
+// sweepStaleObjects marks objects no longer reported by their producer and stream as stale
+func (p *IngestionProcessor) sweepStaleObjects(ctx context.Context, now time.Time) error {
+	action, err := changeset.ParseStaleAction(p.config.StaleObjectAction)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := p.redisClient.HGetAll(ctx, RedisDiscoveryCyclesKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get discovery cycles: %v", err)
+	}
+
+	errs := make([]error, 0)
+
+	for field, v := range cycles {
+		var dc DiscoveryCycle
+		if err := json.Unmarshal([]byte(v), &dc); err != nil {
+			errs = append(errs, fmt.Errorf("failed to unmarshal discovery cycle %s: %v", field, err))
+			continue
+		}
+
+		// only completed discovery cycles count as missed
+		completedCycle := dc.Cycle
+		if time.Duration(now.UnixNano()-dc.LastRequestTs) < p.config.StaleObjectDiscoveryCycleGap {
+			completedCycle--
+		}
+
+		key := staleObjectsKey(dc.ProducerAppName, dc.Stream)
+		objects, err := p.redisClient.HGetAll(ctx, key).Result()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get last seen objects %s: %v", key, err))
+			continue
+		}
+
+		for objField, objValue := range objects {
+			var obj LastSeenObject
+			if err := json.Unmarshal([]byte(objValue), &obj); err != nil {
+				errs = append(errs, fmt.Errorf("failed to unmarshal last seen object %s: %v", objField, err))
+				continue
+			}
+
+			missedCycles := completedCycle - obj.Cycle
+			age := time.Duration(now.UnixNano() - obj.LastSeenTs)
+
+			staleByCycles := p.config.StaleObjectMaxMissedCycles > 0 && missedCycles >= int64(p.config.StaleObjectMaxMissedCycles)
+			staleByAge := p.config.StaleObjectMaxAge > 0 && age >= p.config.StaleObjectMaxAge
+			if !staleByCycles && !staleByAge {
+				continue
+			}
+
+			p.logger.Debug("stale object found", "producer_app_name", dc.ProducerAppName, "stream", dc.Stream, "object", objField, "missed_cycles", missedCycles, "age", age)
+
+			if err := p.markObjectStale(ctx, dc.ProducerAppName, obj, action, now); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			if err := p.redisClient.HDel(ctx, key, objField).Err(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete last seen object %s: %v", objField, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *IngestionProcessor) markObjectStale(ctx context.Context, producerAppName string, obj LastSeenObject, action changeset.StaleAction, now time.Time) error {
+	cs, err := changeset.PrepareStale(obj.ObjectType, obj.QueryParams, action, p.config.StaleObjectTag, p.nbClient)
+	if err != nil {
+		return fmt.Errorf("failed to prepare stale object change set: %v", err)
+	}
+
+	if len(cs.ChangeSet) == 0 {
+		return nil
+	}
+
+	ingestionLogID := ksuid.New().String()
+	key := ingestionLogKey(obj.ObjectType, now.UnixNano(), ingestionLogID)
+
+	ingestionLog := &reconcilerpb.IngestionLog{
+		Id:              ingestionLogID,
+		ProducerAppName: producerAppName,
+		DataType:        obj.ObjectType,
+		IngestionTs:     now.UnixNano(),
+		State:           reconcilerpb.State_RECONCILED,
+		ChangeSet:       &reconcilerpb.ChangeSet{Id: cs.ChangeSetID},
+	}
+
+	errs := make([]error, 0)
+
+	if err := p.applyChangeSet(ctx, cs); err != nil {
+		errs = append(errs, fmt.Errorf("failed to apply stale object change set: %v", err))
+		ingestionLog.State = reconcilerpb.State_FAILED
+		ingestionLog.Error = extractIngestionError(err)
+	}
+
+	csCompressed, err := compressChangeSet(cs)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		ingestionLog.ChangeSet.Data = csCompressed
+	}
+
+	if _, err := p.writeIngestionLog(ctx, key, ingestionLog); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
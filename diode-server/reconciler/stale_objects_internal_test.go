@@ -0,0 +1,354 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	mnp "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+	mr "github.com/netboxlabs/diode/diode-server/reconciler/mocks"
+)
+
+func TestAdvanceDiscoveryCycle(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	p := &IngestionProcessor{
+		config:      Config{StaleObjectDiscoveryCycleGap: time.Minute},
+		redisClient: redisClient,
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	start := time.Date(2024, 7, 8, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		ingestionTs time.Time
+		stream      string
+		wantCycle   int64
+	}{
+		{
+			name:        "first request starts the first cycle",
+			ingestionTs: start,
+			stream:      "latest",
+			wantCycle:   1,
+		},
+		{
+			name:        "request within the cycle gap belongs to the same cycle",
+			ingestionTs: start.Add(30 * time.Second),
+			stream:      "latest",
+			wantCycle:   1,
+		},
+		{
+			name:        "request after the cycle gap starts a new cycle",
+			ingestionTs: start.Add(5 * time.Minute),
+			stream:      "latest",
+			wantCycle:   2,
+		},
+		{
+			name:        "cycles are tracked per stream",
+			ingestionTs: start.Add(5 * time.Minute),
+			stream:      "other",
+			wantCycle:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle, err := p.advanceDiscoveryCycle(ctx, "orb-agent", tt.stream, tt.ingestionTs.UnixNano())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCycle, cycle)
+		})
+	}
+
+	res, err := redisClient.HGet(ctx, RedisDiscoveryCyclesKey, "orb-agent:latest").Result()
+	require.NoError(t, err)
+
+	var dc DiscoveryCycle
+	require.NoError(t, json.Unmarshal([]byte(res), &dc))
+	assert.Equal(t, DiscoveryCycle{
+		ProducerAppName: "orb-agent",
+		Stream:          "latest",
+		Cycle:           2,
+		LastRequestTs:   start.Add(5 * time.Minute).UnixNano(),
+	}, dc)
+}
+
+func TestAdvanceDiscoveryCycleConcurrently(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	p := &IngestionProcessor{
+		config:      Config{StaleObjectDiscoveryCycleGap: time.Minute},
+		redisClient: redisClient,
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	ingestionTs := time.Date(2024, 7, 8, 8, 0, 0, 0, time.UTC).UnixNano()
+
+	// requests handled by several replicas at once belong to the same cycle
+	var wg sync.WaitGroup
+	cycles := make([]int64, 10)
+	for i := range cycles {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cycle, err := p.advanceDiscoveryCycle(ctx, `orb "agent"`, "latest", ingestionTs)
+			assert.NoError(t, err)
+			cycles[i] = cycle
+		}(i)
+	}
+	wg.Wait()
+
+	for _, cycle := range cycles {
+		assert.Equal(t, int64(1), cycle)
+	}
+
+	res, err := redisClient.HGet(ctx, RedisDiscoveryCyclesKey, `orb "agent":latest`).Result()
+	require.NoError(t, err)
+
+	var dc DiscoveryCycle
+	require.NoError(t, json.Unmarshal([]byte(res), &dc))
+	assert.Equal(t, DiscoveryCycle{ProducerAppName: `orb "agent"`, Stream: "latest", Cycle: 1, LastRequestTs: ingestionTs}, dc)
+}
+
+func TestMarkObjectSeen(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	p := &IngestionProcessor{
+		redisClient: redisClient,
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	ingestEntity := changeset.IngestEntity{
+		RequestID: "req123",
+		DataType:  netbox.DcimDeviceObjectType,
+		Entity: &diodepb.Entity{
+			Entity: &diodepb.Entity_Device{
+				Device: &diodepb.Device{
+					Name: "router01",
+					Site: &diodepb.Site{Name: "Site A"},
+				},
+			},
+		},
+	}
+
+	err := p.markObjectSeen(ctx, "orb-agent", "latest", 3, 1720425600000000000, ingestEntity)
+	require.NoError(t, err)
+
+	objects, err := redisClient.HGetAll(ctx, "diode.stale-objects:orb-agent:latest").Result()
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+
+	res, ok := objects["dcim.device?q=router01&site__name=Site+A"]
+	require.True(t, ok)
+
+	var obj LastSeenObject
+	require.NoError(t, json.Unmarshal([]byte(res), &obj))
+	assert.Equal(t, LastSeenObject{
+		RecordedObject: RecordedObject{
+			ObjectType:  netbox.DcimDeviceObjectType,
+			QueryParams: map[string]string{"q": "router01", "site__name": "Site A"},
+		},
+		Cycle:      3,
+		LastSeenTs: 1720425600000000000,
+	}, obj)
+}
+
+func TestSweepStaleObjects(t *testing.T) {
+	now := time.Date(2024, 7, 8, 8, 0, 0, 0, time.UTC)
+
+	deviceParams := map[string]string{"q": "router01", "site__name": "undefined"}
+
+	tests := []struct {
+		name           string
+		config         Config
+		discoveryCycle DiscoveryCycle
+		lastSeen       LastSeenObject
+		wantStale      bool
+		wantErr        bool
+	}{
+		{
+			name: "object seen in the last completed cycle - not stale",
+			config: Config{
+				StaleObjectMaxMissedCycles:   2,
+				StaleObjectAction:            "offline",
+				StaleObjectDiscoveryCycleGap: time.Minute,
+			},
+			discoveryCycle: DiscoveryCycle{Cycle: 5, LastRequestTs: now.Add(-time.Hour).UnixNano()},
+			lastSeen:       LastSeenObject{Cycle: 5, LastSeenTs: now.Add(-time.Hour).UnixNano()},
+			wantStale:      false,
+		},
+		{
+			name: "object missed completed cycles - stale",
+			config: Config{
+				StaleObjectMaxMissedCycles:   2,
+				StaleObjectAction:            "offline",
+				StaleObjectDiscoveryCycleGap: time.Minute,
+			},
+			discoveryCycle: DiscoveryCycle{Cycle: 5, LastRequestTs: now.Add(-time.Hour).UnixNano()},
+			lastSeen:       LastSeenObject{Cycle: 3, LastSeenTs: now.Add(-3 * time.Hour).UnixNano()},
+			wantStale:      true,
+		},
+		{
+			name: "object missed cycles but current cycle still in progress - not stale",
+			config: Config{
+				StaleObjectMaxMissedCycles:   2,
+				StaleObjectAction:            "offline",
+				StaleObjectDiscoveryCycleGap: time.Minute,
+			},
+			discoveryCycle: DiscoveryCycle{Cycle: 5, LastRequestTs: now.Add(-10 * time.Second).UnixNano()},
+			lastSeen:       LastSeenObject{Cycle: 3, LastSeenTs: now.Add(-3 * time.Hour).UnixNano()},
+			wantStale:      false,
+		},
+		{
+			name: "object older than max age - stale",
+			config: Config{
+				StaleObjectMaxAge:            24 * time.Hour,
+				StaleObjectAction:            "offline",
+				StaleObjectDiscoveryCycleGap: time.Minute,
+			},
+			discoveryCycle: DiscoveryCycle{Cycle: 1, LastRequestTs: now.Add(-48 * time.Hour).UnixNano()},
+			lastSeen:       LastSeenObject{Cycle: 1, LastSeenTs: now.Add(-48 * time.Hour).UnixNano()},
+			wantStale:      true,
+		},
+		{
+			name: "invalid stale action - error",
+			config: Config{
+				StaleObjectMaxMissedCycles: 2,
+				StaleObjectAction:          "delete",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRedisClient := mr.NewRedisClient(t)
+			mockNbClient := mnp.NewNetBoxAPI(t)
+
+			p := &IngestionProcessor{
+				config:      tt.config,
+				nbClient:    mockNbClient,
+				redisClient: mockRedisClient,
+				logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+			}
+
+			if !tt.wantErr {
+				tt.discoveryCycle.ProducerAppName = "orb-agent"
+				tt.discoveryCycle.Stream = "latest"
+				dcJSON, err := json.Marshal(tt.discoveryCycle)
+				require.NoError(t, err)
+
+				tt.lastSeen.ObjectType = netbox.DcimDeviceObjectType
+				tt.lastSeen.QueryParams = deviceParams
+				objJSON, err := json.Marshal(tt.lastSeen)
+				require.NoError(t, err)
+
+				objField := tt.lastSeen.field()
+
+				mockRedisClient.EXPECT().HGetAll(ctx, RedisDiscoveryCyclesKey).Return(redis.NewMapStringStringResult(map[string]string{
+					"orb-agent:latest": string(dcJSON),
+				}, nil))
+				mockRedisClient.EXPECT().HGetAll(ctx, "diode.stale-objects:orb-agent:latest").Return(redis.NewMapStringStringResult(map[string]string{
+					objField: string(objJSON),
+				}, nil))
+
+				if tt.wantStale {
+					mockNbClient.EXPECT().RetrieveObjectState(ctx, netboxdiodeplugin.RetrieveObjectStateQueryParams{
+						ObjectType: netbox.DcimDeviceObjectType,
+						Params:     deviceParams,
+					}).Return(&netboxdiodeplugin.ObjectState{
+						ObjectID:   1,
+						ObjectType: netbox.DcimDeviceObjectType,
+						Object: &netbox.DcimDeviceDataWrapper{
+							Device: &netbox.DcimDevice{
+								ID:     1,
+								Name:   "router01",
+								Status: (*netbox.DcimDeviceStatus)(strPtr(string(netbox.DcimDeviceStatusActive))),
+							},
+						},
+					}, nil)
+					mockNbClient.EXPECT().ApplyChangeSet(ctx, mock.MatchedBy(func(req netboxdiodeplugin.ChangeSetRequest) bool {
+						if len(req.ChangeSet) != 1 {
+							return false
+						}
+						change := req.ChangeSet[0]
+						data, ok := change.Data.(*changeset.StaleObjectData)
+						return ok && change.ChangeType == changeset.ChangeTypeUpdate && *change.ObjectID == 1 && *data.Status == "offline"
+					})).Return(&netboxdiodeplugin.ChangeSetResponse{}, nil)
+					mockRedisClient.EXPECT().Do(ctx, "JSON.SET", mock.Anything, "$", mock.Anything).Return(redis.NewCmd(ctx))
+					mockRedisClient.EXPECT().Publish(ctx, RedisIngestionLogsChannel, mock.Anything).Return(redis.NewIntCmd(ctx))
+					mockRedisClient.EXPECT().HDel(ctx, "diode.stale-objects:orb-agent:latest", objField).Return(redis.NewIntCmd(ctx))
+				}
+			}
+
+			err := p.sweepStaleObjects(ctx, now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSweepStaleObjectsLocked(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	defer s.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	defer redisClient.Close()
+
+	p := &IngestionProcessor{
+		config: Config{
+			StaleObjectAction:        "offline",
+			StaleObjectSweepInterval: time.Minute,
+		},
+		redisClient: redisClient,
+		logger:      slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false})),
+	}
+
+	// another replica sweeping, the discovery cycles are left alone
+	require.NoError(t, s.Set(RedisStaleObjectSweepLockKey, "other-replica"))
+	s.HSet(RedisDiscoveryCyclesKey, "orb-agent:latest", "invalid")
+
+	require.NoError(t, p.sweepStaleObjectsLocked(ctx, time.Now()))
+
+	lock, err := s.Get(RedisStaleObjectSweepLockKey)
+	require.NoError(t, err)
+	assert.Equal(t, "other-replica", lock)
+
+	// lock released by the other replica, the discovery cycles are swept and the lock released
+	s.Del(RedisStaleObjectSweepLockKey)
+
+	require.Error(t, p.sweepStaleObjectsLocked(ctx, time.Now()))
+	assert.False(t, s.Exists(RedisStaleObjectSweepLockKey))
+}
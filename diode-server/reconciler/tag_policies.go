@@ -0,0 +1,63 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+)
+
+// TagPoliciesConfig is the tag policies configuration
+//
+// Default holds the tag policy applied to all data sources, DataSources holds tag policies per producer app name
+// replacing the default one
+type TagPoliciesConfig struct {
+	Default     netbox.TagPolicy            `yaml:"default"`
+	DataSources map[string]netbox.TagPolicy `yaml:"data_sources"`
+}
+
+// LoadTagPoliciesConfig loads and validates a tag policies configuration file
+func LoadTagPoliciesConfig(path string) (*TagPoliciesConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag policies config: %v", err)
+	}
+
+	var cfg TagPoliciesConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tag policies config: %v", err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid tag policies config: %v", err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *TagPoliciesConfig) validate() error {
+	errs := make([]error, 0)
+
+	if err := c.Default.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("default: %w", err))
+	}
+
+	for producerAppName, policy := range c.DataSources {
+		if err := policy.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("data source %s: %w", producerAppName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Policy returns the tag policy of a data source
+func (c *TagPoliciesConfig) Policy(producerAppName string) netbox.TagPolicy {
+	if policy, ok := c.DataSources[producerAppName]; ok {
+		return policy
+	}
+	return c.Default
+}
@@ -0,0 +1,96 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestLoadTagPoliciesConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       string
+		wantPolicies map[string]netbox.TagPolicy
+		wantErr      bool
+	}{
+		{
+			name: "default and data source policies",
+			config: `
+default:
+  strategy: union
+data_sources:
+  orb-agent:
+    strategy: managed_prefix
+    prefix: "diode:"
+  netbox-discovery:
+    strategy: replace
+`,
+			wantPolicies: map[string]netbox.TagPolicy{
+				"orb-agent":        {Strategy: netbox.TagMergeStrategyManagedPrefix, Prefix: "diode:"},
+				"netbox-discovery": {Strategy: netbox.TagMergeStrategyReplace},
+				"other-producer":   {Strategy: netbox.TagMergeStrategyUnion},
+			},
+		},
+		{
+			name: "no default",
+			config: `
+data_sources:
+  orb-agent:
+    strategy: replace
+`,
+			wantPolicies: map[string]netbox.TagPolicy{
+				"orb-agent":      {Strategy: netbox.TagMergeStrategyReplace},
+				"other-producer": {},
+			},
+		},
+		{
+			name: "unknown strategy",
+			config: `
+default:
+  strategy: intersection
+`,
+			wantErr: true,
+		},
+		{
+			name: "managed prefix without prefix",
+			config: `
+data_sources:
+  orb-agent:
+    strategy: managed_prefix
+`,
+			wantErr: true,
+		},
+		{
+			name: "prefix of another strategy",
+			config: `
+default:
+  strategy: replace
+  prefix: "diode:"
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "tag_policies.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			cfg, err := reconciler.LoadTagPoliciesConfig(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for producerAppName, wantPolicy := range tt.wantPolicies {
+				assert.Equal(t, wantPolicy, cfg.Policy(producerAppName))
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+// prefetchedObjectStatesTTL is how long object states prefetched for a transaction are served, they are dropped along
+// with the transaction well before
+const prefetchedObjectStatesTTL = time.Hour
+
+// reconcileTransaction reconciles the entities of a transactional ingest request as a single change set
+func (p *IngestionProcessor) reconcileTransaction(ctx context.Context, ingestReq *diodepb.IngestRequest, entities []queuedEntity, discoveryCycle int64, ingestionTs int64) []error {
+	errs := make([]error, 0)
+
+	changeSets, err := p.applyTransaction(ctx, entities)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for i, entity := range entities {
+		errs = append(errs, p.completeEntity(ctx, ingestReq, entity, changeSets[i], err, discoveryCycle, ingestionTs)...)
+	}
+
+	return errs
+}
+
+// applyTransaction prepares the change sets of entities and applies them merged as a single change set, the change
+// set of each entity is returned with the ID of the merged change set, nil if the entity has no changes
+func (p *IngestionProcessor) applyTransaction(ctx context.Context, entities []queuedEntity) ([]*changeset.ChangeSet, error) {
+	// the transaction is re-planned when an object was modified in NetBox between preparing and applying it
+	for attempt := 0; ; attempt++ {
+		changeSets := make([]*changeset.ChangeSet, len(entities))
+
+		nbClient, err := p.prefetchObjectStates(ctx, entities)
+		if err != nil {
+			return changeSets, fmt.Errorf("failed to retrieve object states: %v", err)
+		}
+
+		for i, entity := range entities {
+			cs, err := p.prepareChangeSet(entity.ingestEntity, nbClient)
+			if err != nil {
+				return make([]*changeset.ChangeSet, len(entities)), fmt.Errorf("transaction aborted, %s: %w", entity.key, err)
+			}
+
+			if len(cs.ChangeSet) > 0 {
+				changeSets[i] = cs
+			}
+		}
+
+		transaction, err := changeset.Merge(changeSets...)
+		if err != nil {
+			return make([]*changeset.ChangeSet, len(entities)), fmt.Errorf("failed to merge change sets: %v", err)
+		}
+
+		if len(transaction.ChangeSet) == 0 {
+			p.logger.Debug("no changes to apply", "request_id", entities[0].ingestEntity.RequestID)
+			return changeSets, nil
+		}
+
+		for _, cs := range changeSets {
+			if cs != nil {
+				cs.ChangeSetID = transaction.ChangeSetID
+			}
+		}
+
+		err = p.applyChangeSet(ctx, transaction)
+		if err == nil {
+			return changeSets, nil
+		}
+
+		if !isObjectVersionConflict(err) || attempt >= p.config.ChangeSetConflictMaxRetries {
+			return changeSets, err
+		}
+
+		p.logger.Debug("object modified since transaction was prepared, re-planning", "request_id", entities[0].ingestEntity.RequestID, "change_set_id", transaction.ChangeSetID, "attempt", attempt+1)
+	}
+}
+
+// prefetchObjectStates retrieves the object states of the objects of all entities of a transaction in one round-trip,
+// the returned client serves them while the change sets of the entities are prepared
+func (p *IngestionProcessor) prefetchObjectStates(ctx context.Context, entities []queuedEntity) (netboxdiodeplugin.NetBoxAPI, error) {
+	seen := make(map[string]struct{})
+	params := make([]netboxdiodeplugin.RetrieveObjectStateQueryParams, 0)
+	for _, entity := range entities {
+		lookups, err := changeset.ObjectStateLookups(entity.ingestEntity, p.changeSetOptions(entity.ingestEntity)...)
+		if err != nil {
+			// left to the preparation of the entity to report
+			continue
+		}
+		for _, lookup := range lookups {
+			if _, ok := seen[lookup.Key()]; ok {
+				continue
+			}
+			seen[lookup.Key()] = struct{}{}
+			params = append(params, lookup)
+		}
+	}
+
+	nbClient := netboxdiodeplugin.NewCachedClient(p.logger, p.nbClient, prefetchedObjectStatesTTL, netboxdiodeplugin.NewScopedLRUObjectStateCache(len(params)))
+	if _, err := nbClient.RetrieveObjectStates(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return nbClient, nil
+}
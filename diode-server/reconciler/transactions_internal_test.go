@@ -0,0 +1,209 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/netbox"
+	"github.com/netboxlabs/diode/diode-server/netboxdiodeplugin"
+	mnp "github.com/netboxlabs/diode/diode-server/netboxdiodeplugin/mocks"
+	"github.com/netboxlabs/diode/diode-server/reconciler/changeset"
+)
+
+func TestApplyTransaction(t *testing.T) {
+	deviceEntity := func(name string) queuedEntity {
+		return queuedEntity{
+			key: "ingest-entity:dcim.device-1720425600-" + name,
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.device",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Device{
+						Device: &diodepb.Device{
+							Name: name,
+							Site: &diodepb.Site{Name: "Site A"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		applyErr      error
+		expectedError bool
+	}{
+		{
+			name:          "shared objects are created once in a single change set",
+			applyErr:      nil,
+			expectedError: false,
+		},
+		{
+			name:          "change set rejected - all entities fail",
+			applyErr:      errors.New("apply error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockNbClient := mnp.NewNetBoxAPI(t)
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+			p := &IngestionProcessor{
+				config:   Config{ChangeSetConflictMaxRetries: 3},
+				nbClient: mockNbClient,
+				logger:   logger,
+			}
+
+			// the objects of both entities, none existing in NetBox yet, are retrieved in a single batch
+			mockNbClient.EXPECT().RetrieveObjectStates(ctx, mock.MatchedBy(func(params []netboxdiodeplugin.RetrieveObjectStateQueryParams) bool {
+				return len(params) == 6
+			})).RunAndReturn(func(_ context.Context, params []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+				objStates := make([]*netboxdiodeplugin.ObjectState, 0, len(params))
+				for _, p := range params {
+					dw, err := netbox.NewDataWrapper(p.ObjectType)
+					if err != nil {
+						return nil, err
+					}
+					objStates = append(objStates, &netboxdiodeplugin.ObjectState{ObjectType: p.ObjectType, Object: dw})
+				}
+				return objStates, nil
+			}).Once()
+
+			var resp *netboxdiodeplugin.ChangeSetResponse
+			if tt.applyErr == nil {
+				resp = &netboxdiodeplugin.ChangeSetResponse{}
+			}
+			mockNbClient.EXPECT().ApplyChangeSet(ctx, mock.MatchedBy(func(req netboxdiodeplugin.ChangeSetRequest) bool {
+				creates := make(map[string]int)
+				for _, change := range req.ChangeSet {
+					creates[change.ObjectType]++
+				}
+				return creates["dcim.site"] == 1 && creates["dcim.device"] == 2
+			})).Return(resp, tt.applyErr).Once()
+
+			changeSets, err := p.applyTransaction(ctx, []queuedEntity{deviceEntity("router01"), deviceEntity("router02")})
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, changeSets, 2)
+			require.NotNil(t, changeSets[0])
+			require.NotNil(t, changeSets[1])
+			assert.Equal(t, changeSets[0].ChangeSetID, changeSets[1].ChangeSetID)
+		})
+	}
+}
+
+func TestApplyTransactionDeviceWithInterfaces(t *testing.T) {
+	interfaceEntity := func(name string) queuedEntity {
+		return queuedEntity{
+			key: "ingest-entity:dcim.interface-1720425600-" + name,
+			ingestEntity: changeset.IngestEntity{
+				RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+				DataType:  "dcim.interface",
+				Entity: &diodepb.Entity{
+					Entity: &diodepb.Entity_Interface{
+						Interface: &diodepb.Interface{
+							Name:   name,
+							Device: &diodepb.Device{Name: "r1", Site: &diodepb.Site{Name: "S"}},
+						},
+					},
+				},
+			},
+		}
+	}
+	deviceEntity := queuedEntity{
+		key: "ingest-entity:dcim.device-1720425600-r1",
+		ingestEntity: changeset.IngestEntity{
+			RequestID: "cfa0f129-125c-440d-9e41-e87583cd7d89",
+			DataType:  "dcim.device",
+			Entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Device{
+					Device: &diodepb.Device{Name: "r1", Serial: strPtr("SN1"), Site: &diodepb.Site{Name: "S"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		entities []queuedEntity
+	}{
+		{
+			name:     "device before its interfaces",
+			entities: []queuedEntity{deviceEntity, interfaceEntity("eth0"), interfaceEntity("eth1")},
+		},
+		{
+			name:     "device after its interfaces",
+			entities: []queuedEntity{interfaceEntity("eth0"), interfaceEntity("eth1"), deviceEntity},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockNbClient := mnp.NewNetBoxAPI(t)
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: false}))
+
+			p := &IngestionProcessor{
+				config:   Config{ChangeSetConflictMaxRetries: 3},
+				nbClient: mockNbClient,
+				logger:   logger,
+			}
+
+			// none of the objects exist in NetBox yet
+			mockNbClient.EXPECT().RetrieveObjectStates(ctx, mock.Anything).RunAndReturn(func(_ context.Context, params []netboxdiodeplugin.RetrieveObjectStateQueryParams) ([]*netboxdiodeplugin.ObjectState, error) {
+				objStates := make([]*netboxdiodeplugin.ObjectState, 0, len(params))
+				for _, p := range params {
+					dw, err := netbox.NewDataWrapper(p.ObjectType)
+					if err != nil {
+						return nil, err
+					}
+					objStates = append(objStates, &netboxdiodeplugin.ObjectState{ObjectType: p.ObjectType, Object: dw})
+				}
+				return objStates, nil
+			}).Once()
+
+			var applied netboxdiodeplugin.ChangeSetRequest
+			mockNbClient.EXPECT().ApplyChangeSet(ctx, mock.Anything).RunAndReturn(func(_ context.Context, req netboxdiodeplugin.ChangeSetRequest) (*netboxdiodeplugin.ChangeSetResponse, error) {
+				applied = req
+				return &netboxdiodeplugin.ChangeSetResponse{}, nil
+			}).Once()
+
+			_, err := p.applyTransaction(ctx, tt.entities)
+			require.NoError(t, err)
+
+			creates := make(map[string]int)
+			var device *netbox.DcimDevice
+			for i, change := range applied.ChangeSet {
+				assert.Equal(t, changeset.ChangeTypeCreate, change.ChangeType)
+				creates[change.ObjectType]++
+				switch change.ObjectType {
+				case netbox.DcimDeviceObjectType:
+					device = change.Data.(*netbox.DcimDevice)
+				case netbox.DcimInterfaceObjectType:
+					require.NotNil(t, device, "device created before interface %d", i)
+				}
+			}
+			assert.Equal(t, 1, creates[netbox.DcimSiteObjectType])
+			assert.Equal(t, 1, creates[netbox.DcimDeviceObjectType])
+			assert.Equal(t, 2, creates[netbox.DcimInterfaceObjectType])
+			require.NotNil(t, device)
+			assert.Equal(t, strPtr("SN1"), device.Serial)
+		})
+	}
+}
@@ -0,0 +1,629 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+)
+
+// TransformationAction is the action of a transformation rule on a field of ingested entities
+type TransformationAction string
+
+const (
+	// TransformationActionRename moves the value of the field to the field named by To
+	TransformationActionRename TransformationAction = "rename"
+
+	// TransformationActionMap replaces the value of the field per Mapping
+	TransformationActionMap TransformationAction = "map"
+
+	// TransformationActionDrop clears the field, or removes the element of a repeated field it belongs to, when its
+	// value is one of Values or Values is empty
+	TransformationActionDrop TransformationAction = "drop"
+
+	// TransformationActionDefault sets the field to the result of the Value expression when it is empty
+	TransformationActionDefault TransformationAction = "default"
+
+	// TransformationActionTemplate sets the field to the result of the Value expression
+	TransformationActionTemplate TransformationAction = "template"
+)
+
+// TransformationRulesConfig is the transformation configuration, its rules being applied in order to ingested entities
+// before reconciliation
+type TransformationRulesConfig struct {
+	Rules []TransformationRuleConfig `yaml:"rules"`
+}
+
+// TransformationRuleConfig is a transformation rule. ProducerAppName, Stream and DataType restrict the entities the
+// rule applies to, When is a CEL condition on the entity. Field, and To, are dotted paths of fields (named as in the
+// diode protobuf messages) of the object of the entity, e.g. site.name for a device. Value is a CEL expression.
+type TransformationRuleConfig struct {
+	Name            string               `yaml:"name"`
+	ProducerAppName string               `yaml:"producer_app_name"`
+	Stream          string               `yaml:"stream"`
+	DataType        string               `yaml:"data_type"`
+	When            string               `yaml:"when"`
+	Field           string               `yaml:"field"`
+	Action          TransformationAction `yaml:"action"`
+	To              string               `yaml:"to"`
+	Mapping         map[string]string    `yaml:"mapping"`
+	Values          []string             `yaml:"values"`
+	Value           string               `yaml:"value"`
+}
+
+// Transformations are the transformation rules applied to ingested entities before reconciliation
+type Transformations struct {
+	rules []*transformationRule
+}
+
+type transformationRule struct {
+	TransformationRuleConfig
+
+	field []string
+	to    []string
+	when  cel.Program
+	value cel.Program
+}
+
+// LoadTransformations loads a transformation rules file
+func LoadTransformations(path string) (*Transformations, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transformation rules: %v", err)
+	}
+
+	var cfg TransformationRulesConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal transformation rules: %v", err)
+	}
+
+	return NewTransformations(cfg)
+}
+
+// NewTransformations validates and compiles transformation rules
+func NewTransformations(cfg TransformationRulesConfig) (*Transformations, error) {
+	whenEnv, err := newEntityExpressionEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transformation rules environment: %v", err)
+	}
+	valueEnv, err := whenEnv.Extend(cel.Variable("value", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transformation rules environment: %v", err)
+	}
+
+	objectTypes := entityObjectTypes()
+
+	t := &Transformations{rules: make([]*transformationRule, 0, len(cfg.Rules))}
+	names := make(map[string]struct{})
+	errs := make([]error, 0)
+	for i, r := range cfg.Rules {
+		rule, err := newTransformationRule(r, whenEnv, valueEnv, objectTypes)
+		if err == nil {
+			if _, ok := names[r.Name]; ok {
+				err = fmt.Errorf("duplicate name %q", r.Name)
+			}
+			names[r.Name] = struct{}{}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %w", i, err))
+			continue
+		}
+		t.rules = append(t.rules, rule)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid transformation rules: %v", err)
+	}
+
+	return t, nil
+}
+
+func newTransformationRule(cfg TransformationRuleConfig, whenEnv, valueEnv *cel.Env, objectTypes map[string]protoreflect.MessageDescriptor) (*transformationRule, error) {
+	r := &transformationRule{TransformationRuleConfig: cfg}
+
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if cfg.Field == "" {
+		return nil, fmt.Errorf("field is required")
+	}
+	r.field = strings.Split(cfg.Field, ".")
+
+	switch cfg.Action {
+	case TransformationActionRename:
+		if cfg.To == "" {
+			return nil, fmt.Errorf("to is required by the %s action", cfg.Action)
+		}
+		r.to = strings.Split(cfg.To, ".")
+	case TransformationActionMap:
+		if len(cfg.Mapping) == 0 {
+			return nil, fmt.Errorf("mapping is required by the %s action", cfg.Action)
+		}
+	case TransformationActionDrop:
+	case TransformationActionDefault, TransformationActionTemplate:
+		if cfg.Value == "" {
+			return nil, fmt.Errorf("value is required by the %s action", cfg.Action)
+		}
+		prg, err := compileEntityExpression(valueEnv, cfg.Value, false)
+		if err != nil {
+			return nil, fmt.Errorf("value: %w", err)
+		}
+		r.value = prg
+	default:
+		return nil, fmt.Errorf("unknown action %q", cfg.Action)
+	}
+
+	if cfg.When != "" {
+		prg, err := compileEntityExpression(whenEnv, cfg.When, true)
+		if err != nil {
+			return nil, fmt.Errorf("when: %w", err)
+		}
+		r.when = prg
+	}
+
+	if cfg.DataType != "" {
+		desc, ok := objectTypes[cfg.DataType]
+		if !ok {
+			return nil, fmt.Errorf("unknown data type %q", cfg.DataType)
+		}
+		if err := r.validate(desc); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// newEntityExpressionEnv returns the environment of CEL expressions on ingested entities, the object of the entity
+// being bound to entity
+func newEntityExpressionEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Types(&diodepb.Entity{}),
+		cel.Variable("entity", cel.DynType),
+		cel.Variable("producer_app_name", cel.StringType),
+		cel.Variable("stream", cel.StringType),
+		ext.Strings(),
+	)
+}
+
+func compileEntityExpression(env *cel.Env, expr string, condition bool) (cel.Program, error) {
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if condition && ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression returns %s instead of bool", ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+// Apply returns the entity with the rules applying to the producer app and stream applied in order, along with the
+// names of the rules which changed it. The entity is cloned before being changed.
+func (t *Transformations) Apply(producerAppName string, stream string, dataType string, entity *diodepb.Entity) (*diodepb.Entity, []string, error) {
+	if t == nil {
+		return entity, nil, nil
+	}
+
+	var transformed *diodepb.Entity
+	var obj protoreflect.Message
+	var ingestedViolations map[string]struct{}
+	applied := make([]string, 0)
+
+	for _, r := range t.rules {
+		if (r.ProducerAppName != "" && r.ProducerAppName != producerAppName) ||
+			(r.Stream != "" && r.Stream != stream) ||
+			(r.DataType != "" && r.DataType != dataType) {
+			continue
+		}
+
+		if transformed == nil {
+			transformed = proto.Clone(entity).(*diodepb.Entity)
+			obj = entityObject(transformed)
+			if obj == nil {
+				return entity, nil, nil
+			}
+		}
+
+		// rules without data type only apply to entities having their fields
+		if r.validate(obj.Descriptor()) != nil {
+			continue
+		}
+
+		vars := map[string]any{
+			"entity":            obj.Interface(),
+			"producer_app_name": producerAppName,
+			"stream":            stream,
+		}
+
+		if r.when != nil {
+			out, _, err := r.when.Eval(vars)
+			if err != nil {
+				return entity, nil, fmt.Errorf("transformation rule %s: failed to evaluate condition: %v", r.Name, err)
+			}
+			if out != types.True {
+				continue
+			}
+		}
+
+		var changed bool
+		var err error
+		if r.Action == TransformationActionRename {
+			changed, err = r.rename(obj)
+		} else {
+			changed, _, err = r.transform(obj, r.field, false, vars)
+		}
+		if err != nil {
+			return entity, nil, fmt.Errorf("transformation rule %s: %v", r.Name, err)
+		}
+		if !changed {
+			continue
+		}
+
+		// ingested entities aren't required to meet all the constraints of the schema (e.g. slugs are derived), a rule
+		// only fails the entity when violating constraints the ingested entity met
+		if ingestedViolations == nil {
+			ingestedViolations = validationViolations(entity)
+		}
+		if violations := newViolations(ingestedViolations, validationViolations(transformed)); len(violations) > 0 {
+			return entity, nil, fmt.Errorf("transformation rule %s: invalid transformed entity: %s", r.Name, strings.Join(violations, "; "))
+		}
+		applied = append(applied, r.Name)
+	}
+
+	if len(applied) == 0 {
+		return entity, applied, nil
+	}
+
+	return transformed, applied, nil
+}
+
+// validationError is implemented by the validation errors of the diode protobuf messages
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// validationViolations returns the constraint violations of the schema of an entity, as their field paths and reasons
+func validationViolations(entity *diodepb.Entity) map[string]struct{} {
+	violations := make(map[string]struct{})
+	collectViolations(entity.ValidateAll(), "Entity", violations)
+	return violations
+}
+
+func collectViolations(err error, path string, violations map[string]struct{}) {
+	switch e := err.(type) {
+	case nil:
+	case interface{ AllErrors() []error }:
+		for _, err := range e.AllErrors() {
+			collectViolations(err, path, violations)
+		}
+	case validationError:
+		if e.Cause() != nil {
+			collectViolations(e.Cause(), path+"."+e.Field(), violations)
+			return
+		}
+		violations[fmt.Sprintf("invalid %s.%s: %s", path, e.Field(), e.Reason())] = struct{}{}
+	default:
+		violations[fmt.Sprintf("invalid %s: %v", path, err)] = struct{}{}
+	}
+}
+
+// newViolations returns the sorted violations not found in the given ones
+func newViolations(from map[string]struct{}, to map[string]struct{}) []string {
+	var out []string
+	for violation := range to {
+		if _, ok := from[violation]; !ok {
+			out = append(out, violation)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// validate checks the fields of the rule exist in the object and the action applies to them
+func (r *transformationRule) validate(desc protoreflect.MessageDescriptor) error {
+	fields, err := resolveFieldPath(desc, r.field)
+	if err != nil {
+		return err
+	}
+	field := fields[len(fields)-1]
+
+	switch r.Action {
+	case TransformationActionRename:
+		to, err := resolveFieldPath(desc, r.to)
+		if err != nil {
+			return err
+		}
+		if slices.Equal(fields, to) {
+			return fmt.Errorf("can't rename field %s to itself", r.Field)
+		}
+		for _, fd := range append(fields, to...) {
+			if fd.IsList() {
+				return fmt.Errorf("can't rename repeated field %s", fd.Name())
+			}
+		}
+		if field.Kind() != to[len(to)-1].Kind() || field.Message() != to[len(to)-1].Message() {
+			return fmt.Errorf("can't rename field %s to field %s of another type", r.Field, r.To)
+		}
+	case TransformationActionMap:
+		if field.Kind() != protoreflect.StringKind {
+			return fmt.Errorf("can't map field %s, not a string", r.Field)
+		}
+	case TransformationActionDrop:
+		if len(r.Values) > 0 && field.Kind() == protoreflect.MessageKind {
+			return fmt.Errorf("can't drop values of field %s, a message", r.Field)
+		}
+	case TransformationActionDefault, TransformationActionTemplate:
+		if field.IsList() {
+			return fmt.Errorf("can't set repeated field %s", r.Field)
+		}
+	}
+
+	return nil
+}
+
+// rename moves the value of the field to the field named by To
+func (r *transformationRule) rename(obj protoreflect.Message) (bool, error) {
+	from, fromField := parentMessage(obj, r.field, false)
+	if from == nil || !from.Has(fromField) {
+		return false, nil
+	}
+	v := from.Get(fromField)
+
+	to, toField := parentMessage(obj, r.to, true)
+	to.Set(toField, v)
+	from.Clear(fromField)
+
+	return true, nil
+}
+
+// transform applies the action of the rule to the field at path of msg, an element of a repeated field if inList, and
+// returns whether msg changed or, for drop actions within repeated fields, whether msg is to be removed
+func (r *transformationRule) transform(msg protoreflect.Message, path []string, inList bool, vars map[string]any) (bool, bool, error) {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+
+	if len(path) > 1 {
+		if !msg.Has(fd) {
+			if r.Action != TransformationActionDefault && r.Action != TransformationActionTemplate {
+				return false, false, nil
+			}
+		}
+
+		if !fd.IsList() {
+			return r.transform(msg.Mutable(fd).Message(), path[1:], inList, vars)
+		}
+
+		changed := false
+		list := msg.Get(fd).List()
+		kept := msg.NewField(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			elemChanged, remove, err := r.transform(list.Get(i).Message(), path[1:], true, vars)
+			if err != nil {
+				return false, false, err
+			}
+			changed = changed || elemChanged || remove
+			if !remove {
+				kept.Append(list.Get(i))
+			}
+		}
+		if kept.Len() != list.Len() {
+			msg.Set(fd, protoreflect.ValueOfList(kept))
+		}
+		return changed, false, nil
+	}
+
+	if fd.IsList() {
+		return r.transformList(msg, fd)
+	}
+
+	switch r.Action {
+	case TransformationActionMap:
+		mapped, ok := r.Mapping[msg.Get(fd).String()]
+		if !ok || !msg.Has(fd) || mapped == msg.Get(fd).String() {
+			return false, false, nil
+		}
+		msg.Set(fd, protoreflect.ValueOfString(mapped))
+		return true, false, nil
+	case TransformationActionDrop:
+		if !msg.Has(fd) || !r.dropsValue(msg.Get(fd)) {
+			return false, false, nil
+		}
+		if inList {
+			return false, true, nil
+		}
+		msg.Clear(fd)
+		return true, false, nil
+	case TransformationActionDefault:
+		if msg.Has(fd) {
+			return false, false, nil
+		}
+	}
+
+	if msg.Has(fd) {
+		vars["value"] = fieldValue(fd, msg.Get(fd))
+	} else {
+		vars["value"] = types.NullValue
+	}
+	defer delete(vars, "value")
+
+	out, _, err := r.value.Eval(vars)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to evaluate value: %v", err)
+	}
+	v, err := protoValue(fd, out)
+	if err != nil {
+		return false, false, fmt.Errorf("invalid value for field %s: %v", fd.Name(), err)
+	}
+	if msg.Has(fd) && msg.Get(fd).Equal(v) {
+		return false, false, nil
+	}
+	msg.Set(fd, v)
+	return true, false, nil
+}
+
+// transformList applies map and drop actions to the elements of a repeated field
+func (r *transformationRule) transformList(msg protoreflect.Message, fd protoreflect.FieldDescriptor) (bool, bool, error) {
+	if !msg.Has(fd) {
+		return false, false, nil
+	}
+
+	if r.Action == TransformationActionDrop && len(r.Values) == 0 {
+		msg.Clear(fd)
+		return true, false, nil
+	}
+
+	changed := false
+	list := msg.Get(fd).List()
+	kept := msg.NewField(fd).List()
+	for i := 0; i < list.Len(); i++ {
+		v := list.Get(i)
+		switch r.Action {
+		case TransformationActionMap:
+			if mapped, ok := r.Mapping[v.String()]; ok && mapped != v.String() {
+				v = protoreflect.ValueOfString(mapped)
+				changed = true
+			}
+		case TransformationActionDrop:
+			if r.dropsValue(v) {
+				changed = true
+				continue
+			}
+		}
+		kept.Append(v)
+	}
+	if changed {
+		msg.Set(fd, protoreflect.ValueOfList(kept))
+	}
+	return changed, false, nil
+}
+
+func (r *transformationRule) dropsValue(v protoreflect.Value) bool {
+	return len(r.Values) == 0 || slices.Contains(r.Values, v.String())
+}
+
+// resolveFieldPath returns the descriptors of the fields of a dotted field path
+func resolveFieldPath(desc protoreflect.MessageDescriptor, path []string) ([]protoreflect.FieldDescriptor, error) {
+	fields := make([]protoreflect.FieldDescriptor, 0, len(path))
+	for i, name := range path {
+		if desc == nil {
+			return nil, fmt.Errorf("unknown field %s", strings.Join(path[:i+1], "."))
+		}
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %s", strings.Join(path[:i+1], "."))
+		}
+		fields = append(fields, fd)
+		desc = fd.Message()
+	}
+	return fields, nil
+}
+
+// parentMessage returns the message holding the last field of a path and its descriptor, creating the messages on the
+// path if create, nil if one of them is not set otherwise
+func parentMessage(obj protoreflect.Message, path []string, create bool) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	msg := obj
+	for _, name := range path[:len(path)-1] {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if !create && !msg.Has(fd) {
+			return nil, nil
+		}
+		msg = msg.Mutable(fd).Message()
+	}
+	return msg, msg.Descriptor().Fields().ByName(protoreflect.Name(path[len(path)-1]))
+}
+
+// fieldValue returns the value of a field as bound to CEL expressions
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return v.Message().Interface()
+	case protoreflect.EnumKind:
+		return int64(v.Enum())
+	default:
+		return v.Interface()
+	}
+}
+
+// protoValue converts the result of a CEL expression to the value of a field
+func protoValue(fd protoreflect.FieldDescriptor, out ref.Val) (protoreflect.Value, error) {
+	var native any
+	var err error
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		native, err = out.ConvertToNative(reflect.TypeOf(""))
+	case protoreflect.BoolKind:
+		native, err = out.ConvertToNative(reflect.TypeOf(false))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		native, err = out.ConvertToNative(reflect.TypeOf(int32(0)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		native, err = out.ConvertToNative(reflect.TypeOf(int64(0)))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		native, err = out.ConvertToNative(reflect.TypeOf(uint32(0)))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		native, err = out.ConvertToNative(reflect.TypeOf(uint64(0)))
+	case protoreflect.FloatKind:
+		native, err = out.ConvertToNative(reflect.TypeOf(float32(0)))
+	case protoreflect.DoubleKind:
+		native, err = out.ConvertToNative(reflect.TypeOf(float64(0)))
+	case protoreflect.EnumKind:
+		var n any
+		n, err = out.ConvertToNative(reflect.TypeOf(int32(0)))
+		if err == nil {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n.(int32))), nil
+		}
+	case protoreflect.MessageKind:
+		m, ok := out.Value().(proto.Message)
+		if !ok || m.ProtoReflect().Descriptor().FullName() != fd.Message().FullName() {
+			return protoreflect.Value{}, fmt.Errorf("expected %s, got %s", fd.Message().FullName(), out.Type().TypeName())
+		}
+		return protoreflect.ValueOfMessage(m.ProtoReflect()), nil
+	default:
+		err = fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOf(native), nil
+}
+
+// entityObject returns the object of an entity, e.g. the device of a device entity
+func entityObject(entity *diodepb.Entity) protoreflect.Message {
+	m := entity.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("entity"))
+	if fd == nil {
+		return nil
+	}
+	return m.Mutable(fd).Message()
+}
+
+// entityObjectTypes returns the message descriptors of the objects of entities per data type
+func entityObjectTypes() map[string]protoreflect.MessageDescriptor {
+	objectTypes := make(map[string]protoreflect.MessageDescriptor)
+
+	fields := (&diodepb.Entity{}).ProtoReflect().Descriptor().Oneofs().ByName("entity").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		entity := &diodepb.Entity{}
+		m := entity.ProtoReflect()
+		m.Set(fields.Get(i), m.NewField(fields.Get(i)))
+
+		if dataType, err := extractObjectType(entity); err == nil {
+			objectTypes[dataType] = fields.Get(i).Message()
+		}
+	}
+
+	return objectTypes
+}
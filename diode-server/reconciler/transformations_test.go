@@ -0,0 +1,444 @@
+package reconciler_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/netboxlabs/diode/diode-server/gen/diode/v1/diodepb"
+	"github.com/netboxlabs/diode/diode-server/reconciler"
+)
+
+func TestTransformationsApply(t *testing.T) {
+	device := func() *diodepb.Entity {
+		return &diodepb.Entity{
+			Entity: &diodepb.Entity_Device{
+				Device: &diodepb.Device{
+					Name:   "router01",
+					Serial: strPtr("FOC123"),
+					Site:   &diodepb.Site{Name: "LON1"},
+					Role:   &diodepb.Role{Name: "sw"},
+					Tags: []*diodepb.Tag{
+						{Name: "managed"},
+						{Name: "junk"},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		rules           string
+		producerAppName string
+		stream          string
+		dataType        string
+		entity          *diodepb.Entity
+		want            *diodepb.Entity
+		wantApplied     []string
+		wantErr         bool
+	}{
+		{
+			name: "map, template and drop",
+			rules: `
+rules:
+  - name: role-names
+    data_type: dcim.device
+    field: role.name
+    action: map
+    mapping:
+      sw: access-switch
+  - name: lowercase-site-names
+    field: site.name
+    action: template
+    value: value.lowerAscii()
+  - name: junk-tags
+    field: tags.name
+    action: drop
+    values: [junk, tmp]
+`,
+			dataType: "dcim.device",
+			entity:   device(),
+			want: &diodepb.Entity{
+				Entity: &diodepb.Entity_Device{
+					Device: &diodepb.Device{
+						Name:   "router01",
+						Serial: strPtr("FOC123"),
+						Site:   &diodepb.Site{Name: "lon1"},
+						Role:   &diodepb.Role{Name: "access-switch"},
+						Tags: []*diodepb.Tag{
+							{Name: "managed"},
+						},
+					},
+				},
+			},
+			wantApplied: []string{"role-names", "lowercase-site-names", "junk-tags"},
+		},
+		{
+			name: "rename and default",
+			rules: `
+rules:
+  - name: serial-to-asset-tag
+    field: serial
+    action: rename
+    to: asset_tag
+  - name: description
+    field: description
+    action: default
+    value: entity.name + " (" + producer_app_name + ")"
+  - name: comments
+    field: comments
+    action: default
+    value: '"unused"'
+`,
+			producerAppName: "orb-agent",
+			dataType:        "dcim.device",
+			entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Device{
+					Device: &diodepb.Device{Name: "router01", Serial: strPtr("FOC123"), Comments: strPtr("kept")},
+				},
+			},
+			want: &diodepb.Entity{
+				Entity: &diodepb.Entity_Device{
+					Device: &diodepb.Device{
+						Name:        "router01",
+						AssetTag:    strPtr("FOC123"),
+						Description: strPtr("router01 (orb-agent)"),
+						Comments:    strPtr("kept"),
+					},
+				},
+			},
+			wantApplied: []string{"serial-to-asset-tag", "description"},
+		},
+		{
+			name: "condition",
+			rules: `
+rules:
+  - name: london-devices
+    when: entity.site.name.startsWith("LON") && stream == "lab"
+    field: site.name
+    action: template
+    value: '"London"'
+`,
+			stream:   "lab",
+			dataType: "dcim.device",
+			entity:   device(),
+			want: func() *diodepb.Entity {
+				e := device()
+				e.GetDevice().Site.Name = "London"
+				return e
+			}(),
+			wantApplied: []string{"london-devices"},
+		},
+		{
+			name: "condition not met",
+			rules: `
+rules:
+  - name: london-devices
+    when: entity.site.name.startsWith("LON") && stream == "lab"
+    field: site.name
+    action: template
+    value: '"London"'
+`,
+			stream:      "production",
+			dataType:    "dcim.device",
+			entity:      device(),
+			want:        device(),
+			wantApplied: []string{},
+		},
+		{
+			name: "other producer app, stream and data type",
+			rules: `
+rules:
+  - name: producer
+    producer_app_name: netbox-discovery
+    field: name
+    action: drop
+  - name: stream
+    stream: lab
+    field: name
+    action: drop
+  - name: data-type
+    data_type: virtualization.virtualmachine
+    field: name
+    action: drop
+`,
+			producerAppName: "orb-agent",
+			dataType:        "dcim.device",
+			entity:          device(),
+			want:            device(),
+			wantApplied:     []string{},
+		},
+		{
+			name: "field not in entity",
+			rules: `
+rules:
+  - name: site-names
+    field: site.name
+    action: template
+    value: '"London"'
+`,
+			dataType: "dcim.interface",
+			entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Interface{
+					Interface: &diodepb.Interface{Name: "Gi0/1", Device: &diodepb.Device{Name: "router01"}},
+				},
+			},
+			want: &diodepb.Entity{
+				Entity: &diodepb.Entity_Interface{
+					Interface: &diodepb.Interface{Name: "Gi0/1", Device: &diodepb.Device{Name: "router01"}},
+				},
+			},
+			wantApplied: []string{},
+		},
+		{
+			name: "value of another type",
+			rules: `
+rules:
+  - name: mtu
+    field: mtu
+    action: template
+    value: '"9000"'
+`,
+			dataType: "dcim.interface",
+			entity: &diodepb.Entity{
+				Entity: &diodepb.Entity_Interface{
+					Interface: &diodepb.Interface{Name: "Gi0/1"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid transformed entity",
+			rules: `
+rules:
+  - name: long-names
+    field: name
+    action: template
+    value: '"xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"'
+`,
+			dataType: "dcim.device",
+			entity:   device(),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "transformations.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.rules), 0o600))
+
+			transformations, err := reconciler.LoadTransformations(path)
+			require.NoError(t, err)
+
+			ingested := proto.Clone(tt.entity)
+
+			got, applied, err := transformations.Apply(tt.producerAppName, tt.stream, tt.dataType, tt.entity)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, proto.Equal(ingested, tt.entity), "ingested entity changed")
+				return
+			}
+			require.NoError(t, err)
+
+			assert.True(t, proto.Equal(tt.want, got), "got %v", got)
+			assert.Equal(t, tt.wantApplied, applied)
+			assert.True(t, proto.Equal(ingested, tt.entity), "ingested entity changed")
+		})
+	}
+}
+
+func TestTransformationsApplyInvalidEntity(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transformations.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - name: asset-tags
+    field: asset_tag
+    action: default
+    value: '"unknown"'
+  - name: long-names
+    field: name
+    action: template
+    value: value + "-" + value + "-" + value + "-" + value + "-" + value + "-" + value + "-" + value + "-" + value
+`), 0o600))
+
+	transformations, err := reconciler.LoadTransformations(path)
+	require.NoError(t, err)
+
+	// the ingested entity violates other constraints (e.g. no timestamp), left to the reconciliation
+	entity := &diodepb.Entity{
+		Entity: &diodepb.Entity_Device{
+			Device: &diodepb.Device{Name: "router01", Site: &diodepb.Site{Name: "LON1"}},
+		},
+	}
+
+	_, _, err = transformations.Apply("orb-agent", "", "dcim.device", entity)
+	require.Error(t, err)
+	assert.Equal(t, "transformation rule long-names: invalid transformed entity: invalid Entity.Device.Name: value length must be at most 64 runes", err.Error())
+}
+
+func TestLoadTransformations(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr bool
+	}{
+		{
+			name: "valid rules",
+			rules: `
+rules:
+  - name: role-names
+    data_type: dcim.device
+    field: role.name
+    action: map
+    mapping:
+      sw: access-switch
+  - name: mtu
+    data_type: dcim.interface
+    when: entity.mtu == 0
+    field: mtu
+    action: default
+    value: "1500"
+`,
+		},
+		{
+			name: "missing name",
+			rules: `
+rules:
+  - field: name
+    action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			rules: `
+rules:
+  - name: drop
+    field: name
+    action: drop
+  - name: drop
+    field: serial
+    action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown action",
+			rules: `
+rules:
+  - name: upper
+    field: name
+    action: upper
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing mapping",
+			rules: `
+rules:
+  - name: roles
+    field: role.name
+    action: map
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid expression",
+			rules: `
+rules:
+  - name: name
+    field: name
+    action: template
+    value: value.
+`,
+			wantErr: true,
+		},
+		{
+			name: "condition not returning bool",
+			rules: `
+rules:
+  - name: name
+    when: '"yes"'
+    field: name
+    action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown data type",
+			rules: `
+rules:
+  - name: name
+    data_type: dcim.rack
+    field: name
+    action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			rules: `
+rules:
+  - name: name
+    data_type: dcim.device
+    field: site.region
+    action: drop
+`,
+			wantErr: true,
+		},
+		{
+			name: "map of a field not a string",
+			rules: `
+rules:
+  - name: mtu
+    data_type: dcim.interface
+    field: mtu
+    action: map
+    mapping:
+      "1500": "9000"
+`,
+			wantErr: true,
+		},
+		{
+			name: "rename to a field of another type",
+			rules: `
+rules:
+  - name: site
+    data_type: dcim.device
+    field: site
+    action: rename
+    to: name
+`,
+			wantErr: true,
+		},
+		{
+			name: "rename to the same field",
+			rules: `
+rules:
+  - name: serial
+    data_type: dcim.device
+    field: serial
+    action: rename
+    to: serial
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "transformations.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.rules), 0o600))
+
+			_, err := reconciler.LoadTransformations(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}